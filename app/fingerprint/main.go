@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/joho/godotenv/autoload"
+
+	"github.com/Risuii/config"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/fingerprint"
+)
+
+// Imports a fingerprint device attendance log. Without -commit it only prints
+// what would be written:
+//
+//	go run ./app/fingerprint -file attlog.dat [-company 1] [-commit]
+func main() {
+	path := flag.String("file", "", "tab separated device log")
+	commit := flag.Bool("commit", false, "write the import instead of a dry run")
+	company := flag.Int64("company", tenant.Default, "company the device belongs to")
+	flag.Parse()
+
+	if *path == "" {
+		log.Fatal("-file is required")
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	cfg := config.New()
+
+	db, err := sql.Open("mysql", cfg.Database.DSN)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	fingerprintRepo := fingerprint.NewFingerprintRepositoryImpl(db)
+	fingerprintUseCase := fingerprint.NewFingerprintUseCase(fingerprintRepo, cfg.Fingerprint.Location)
+
+	report, err := fingerprintUseCase.Import(tenant.WithID(context.Background(), *company), file, !*commit)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.Fatal(err)
+	}
+}
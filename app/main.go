@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -14,10 +15,31 @@ import (
 
 	"github.com/Risuii/config"
 	"github.com/Risuii/config/bcrypt"
+	"github.com/Risuii/config/mailer"
 	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/absence"
 	"github.com/Risuii/internal/absensi"
 	"github.com/Risuii/internal/activity"
+	"github.com/Risuii/internal/attachment"
+	"github.com/Risuii/internal/company"
+	"github.com/Risuii/internal/digest"
+	"github.com/Risuii/internal/employee"
+	"github.com/Risuii/internal/fingerprint"
+	"github.com/Risuii/internal/invite"
+	"github.com/Risuii/internal/org"
+	"github.com/Risuii/internal/overtime"
+	"github.com/Risuii/internal/project"
+	"github.com/Risuii/internal/report"
+	"github.com/Risuii/internal/search"
+	"github.com/Risuii/internal/sso"
+	"github.com/Risuii/internal/template"
+	"github.com/Risuii/internal/timesheet"
 	"github.com/Risuii/internal/user"
+	"github.com/Risuii/models/digests"
+	"github.com/Risuii/models/identities"
+	"github.com/Risuii/models/invites"
+	"github.com/Risuii/models/users"
 )
 
 func main() {
@@ -32,18 +54,107 @@ func main() {
 	router := mux.NewRouter()
 	bcrypt := bcrypt.NewBcrypt(cfg.Bcrypt.HashCost)
 
-	userRepo := user.NewUserRepository(db, constant.TableEmployee)
-	userUseCase := user.NewUserUseCase(userRepo, bcrypt)
+	router.Use(tenant.Middleware)
 
-	activityRepo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
-	activityUseCase := activity.NewActivityUseCaseImpl(activityRepo)
+	mail := newMailer(cfg)
+	inviteSender := invite.NewSender(invites.Settings{
+		URL: cfg.Invite.URL,
+		TTL: cfg.Invite.TTL,
+	}, mail)
+
+	companyRepo := company.NewCompanyRepositoryImpl(db, constant.TableCompany)
+	companyUseCase := company.NewCompanyUseCase(companyRepo)
+
+	userRepo := user.NewUserRepository(db, constant.TableEmployee)
+	userUseCase := user.NewUserUseCase(userRepo, companyRepo, bcrypt, mail, users.Registration{
+		Enabled:   cfg.App.Registration,
+		VerifyURL: cfg.Verify.URL,
+		VerifyTTL: cfg.Verify.TTL,
+	})
 
 	absensiRepo := absensi.NewAbsensiRepositoryImpl(db, constant.TableAbsensi)
 	absensiUseCase := absensi.NewAbsensiUseCase(absensiRepo)
 
+	projectRepo := project.NewProjectRepositoryImpl(db, constant.TableProject)
+	projectUseCase := project.NewProjectUseCase(projectRepo)
+
+	orgRepo := org.NewOrgRepositoryImpl(db)
+	orgChain := org.NewChain(orgRepo)
+	orgUseCase := org.NewOrgUseCase(orgRepo, orgChain)
+
+	activityRepo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+	activityUseCase := activity.NewActivityUseCaseImpl(activityRepo, absensiRepo, projectRepo, orgChain)
+
+	attachmentRepo := attachment.NewAttachmentRepositoryImpl(db, constant.TableAttachment)
+	attachmentStorage := attachment.NewLocalStorage(cfg.Attachment.Dir)
+	attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepo, activityRepo, attachmentStorage, orgChain, cfg.Attachment.MaxSize)
+
+	templateRepo := template.NewTemplateRepositoryImpl(db, constant.TableTemplate)
+	templateUseCase := template.NewTemplateUseCase(templateRepo, activityUseCase, activityRepo, absensiRepo)
+
+	overtimeRepo := overtime.NewOvertimeRepositoryImpl(db, constant.TableOvertime)
+	overtimeUseCase := overtime.NewOvertimeUseCase(overtimeRepo, orgChain)
+
+	searchRepo := search.NewSearchRepositoryImpl(db, cfg.Search.FullText)
+	searchUseCase := search.NewSearchUseCase(searchRepo, orgChain)
+
+	reportRepo := report.NewReportRepositoryImpl(db)
+	reportUseCase := report.NewReportUseCase(reportRepo, orgChain)
+
+	employeeRepo := employee.NewEmployeeRepositoryImpl(db)
+	employeeUseCase := employee.NewEmployeeUseCase(employeeRepo, userRepo, orgRepo, bcrypt, validator, inviteSender)
+
+	inviteRepo := invite.NewInviteRepositoryImpl(db)
+	inviteUseCase := invite.NewInviteUseCase(inviteRepo, bcrypt)
+
+	digestRepo := digest.NewDigestRepositoryImpl(db)
+	digestUseCase := digest.NewDigestUseCase(digestRepo, reportUseCase, orgChain, digestNotifier(cfg), digests.Schedule{
+		At:        cfg.Digest.At,
+		WeeklyDay: cfg.Digest.WeeklyDay,
+	})
+
+	timesheetUseCase := timesheet.NewTimesheetUseCase(absensiRepo, activityRepo, orgChain)
+
+	fingerprintRepo := fingerprint.NewFingerprintRepositoryImpl(db)
+	fingerprintUseCase := fingerprint.NewFingerprintUseCase(fingerprintRepo, cfg.Fingerprint.Location)
+
+	absenceRepo := absence.NewAbsenceRepositoryImpl(db, cfg.Rabbitmq.RabbitCon)
+	absenceUseCase := absence.NewAbsenceUseCase(absenceRepo)
+
 	user.NewUserHandler(router, validator, userUseCase)
+	company.NewCompanyHandler(router, validator, companyUseCase)
+	employee.NewEmployeeHandler(router, validator, employeeUseCase)
+	invite.NewInviteHandler(router, validator, inviteUseCase)
 	activity.NewActivityHandler(router, validator, activityUseCase)
+	attachment.NewAttachmentHandler(router, attachmentUseCase)
+	template.NewTemplateHandler(router, validator, templateUseCase)
+	project.NewProjectHandler(router, validator, projectUseCase)
+	search.NewSearchHandler(router, validator, searchUseCase)
 	absensi.NewAbsensiHandler(router, validator, absensiUseCase)
+	overtime.NewOvertimeHandler(router, validator, overtimeUseCase)
+	org.NewOrgHandler(router, validator, orgUseCase)
+	report.NewReportHandler(router, validator, reportUseCase)
+	timesheet.NewTimesheetHandler(router, validator, timesheetUseCase)
+	fingerprint.NewFingerprintHandler(router, fingerprintUseCase)
+
+	if cfg.OIDC.Issuer != "" {
+		ssoSettings := identities.Settings{
+			Issuer:        cfg.OIDC.Issuer,
+			ClientID:      cfg.OIDC.ClientID,
+			ClientSecret:  cfg.OIDC.ClientSecret,
+			RedirectURL:   cfg.OIDC.RedirectURL,
+			Scopes:        cfg.OIDC.Scopes,
+			AutoProvision: cfg.OIDC.AutoProvision,
+			Company:       cfg.OIDC.Company,
+		}
+
+		ssoRepo := sso.NewSSORepositoryImpl(db)
+		ssoUseCase := sso.NewSSOUseCase(ssoRepo, userRepo, companyRepo, sso.NewProvider(ssoSettings), ssoSettings)
+		sso.NewSSOHandler(router, validator, ssoUseCase)
+	}
+
+	go absence.NewAbsenceJob(absenceUseCase, companyRepo, cfg.Job.AbsenceInterval).Run(context.Background())
+	go digest.NewDigestJob(digestUseCase, companyRepo, cfg.Job.DigestInterval).Run(context.Background())
 
 	server := &http.Server{
 		Addr:    fmt.Sprintf(":%s", cfg.App.Port),
@@ -56,3 +167,37 @@ func main() {
 	fmt.Println("PORT :", port)
 	log.Fatal(server.ListenAndServe())
 }
+
+func newMailer(cfg *config.Config) mailer.Mailer {
+	switch cfg.Mail.Driver {
+	case "log":
+		return mailer.NewLogMailer()
+	case "smtp":
+		return mailer.NewSMTPMailer(mailer.SMTPConfig{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+			From:     cfg.SMTP.From,
+		})
+	default:
+		return mailer.NewFileMailer(cfg.Mail.FileDir)
+	}
+}
+
+func digestNotifier(cfg *config.Config) digest.Notifier {
+	switch cfg.Digest.Notifier {
+	case "webhook":
+		return digest.NewWebhookNotifier(cfg.Digest.WebhookURL)
+	case "smtp":
+		return digest.NewSMTPNotifier(digest.SMTPConfig{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+			From:     cfg.SMTP.From,
+		})
+	default:
+		return digest.NewFileNotifier(cfg.Digest.FileDir)
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/joho/godotenv/autoload"
+
+	"github.com/Risuii/config"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/org"
+	"github.com/Risuii/internal/payroll"
+	"github.com/Risuii/internal/report"
+)
+
+// Generates the payroll import file for one month:
+//
+//	go run ./app/payroll -month 2023-01 [-company 1] [-out payroll.csv] [-mapping mapping.json]
+func main() {
+	month := flag.String("month", "", "period to export, formatted 2006-01")
+	out := flag.String("out", "", "output file, defaults to payroll_<month>.<ext>")
+	mapping := flag.String("mapping", "", "column mapping JSON, overrides PAYROLL_MAPPING_FILE")
+	company := flag.Int64("company", tenant.Default, "company to export")
+	flag.Parse()
+
+	period, err := time.Parse("2006-01", *month)
+	if err != nil {
+		log.Fatalf("invalid -month %q, expected 2006-01", *month)
+	}
+
+	cfg := config.New()
+
+	if *mapping == "" {
+		*mapping = cfg.Payroll.MappingFile
+	}
+
+	columns, err := payroll.LoadMapping(*mapping)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exporter, err := payroll.NewExporter(columns)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	db, err := sql.Open("mysql", cfg.Database.DSN)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	reportRepo := report.NewReportRepositoryImpl(db)
+	orgRepo := org.NewOrgRepositoryImpl(db)
+	reportUseCase := report.NewReportUseCase(reportRepo, org.NewChain(orgRepo))
+	payrollUseCase := payroll.NewPayrollUseCase(reportUseCase, exporter, cfg.Payroll.LateDeduction)
+
+	if *out == "" {
+		*out = fmt.Sprintf("payroll_%s.%s", *month, exporter.Extension())
+	}
+
+	file, err := os.Create(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	if err := payrollUseCase.Generate(tenant.WithID(context.Background(), *company), period, file); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println("payroll written to", *out)
+}
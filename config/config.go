@@ -6,6 +6,8 @@ import (
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/streadway/amqp"
@@ -13,7 +15,8 @@ import (
 
 type Config struct {
 	App struct {
-		Port string
+		Port         string
+		Registration bool
 	}
 	Database struct {
 		DSN string
@@ -24,6 +27,59 @@ type Config struct {
 	Rabbitmq struct {
 		RabbitCon *amqp.Connection
 	}
+	Job struct {
+		AbsenceInterval time.Duration
+		DigestInterval  time.Duration
+	}
+	Payroll struct {
+		MappingFile   string
+		LateDeduction float64
+	}
+	Fingerprint struct {
+		Location *time.Location
+	}
+	Search struct {
+		FullText bool
+	}
+	Attachment struct {
+		Dir     string
+		MaxSize int64
+	}
+	Digest struct {
+		Notifier   string
+		At         string
+		WeeklyDay  time.Weekday
+		FileDir    string
+		WebhookURL string
+	}
+	Invite struct {
+		URL string
+		TTL time.Duration
+	}
+	Verify struct {
+		URL string
+		TTL time.Duration
+	}
+	Mail struct {
+		Driver  string
+		FileDir string
+	}
+	OIDC struct {
+		Issuer        string
+		ClientID      string
+		ClientSecret  string
+		RedirectURL   string
+		Scopes        []string
+		AutoProvision bool
+		Company       string
+	}
+	SMTP struct {
+		Host     string
+		Port     string
+		Username string
+		Password string
+		From     string
+	}
 }
 
 func New() *Config {
@@ -32,6 +88,17 @@ func New() *Config {
 	c.loadDatabase()
 	c.loadBcrypt()
 	c.loadRabbitmq()
+	c.loadJob()
+	c.loadPayroll()
+	c.loadFingerprint()
+	c.loadSearch()
+	c.loadAttachment()
+	c.loadDigest()
+	c.loadSMTP()
+	c.loadInvite()
+	c.loadVerify()
+	c.loadMail()
+	c.loadOIDC()
 
 	return c
 }
@@ -40,6 +107,8 @@ func (c *Config) loadApp() *Config {
 	port := os.Getenv("PORT")
 
 	c.App.Port = port
+	// env value, "false" leaves adding employees to admins
+	c.App.Registration = os.Getenv("REGISTRATION_ENABLED") != "false"
 
 	return c
 }
@@ -107,3 +176,186 @@ func (c *Config) loadBcrypt() *Config {
 
 	return c
 }
+
+func (c *Config) loadJob() *Config {
+	// env value
+	interval, err := time.ParseDuration(os.Getenv("ABSENCE_JOB_INTERVAL"))
+	if err != nil || interval <= 0 {
+		interval = 5 * time.Minute
+	}
+
+	c.Job.AbsenceInterval = interval
+
+	digestInterval, err := time.ParseDuration(os.Getenv("DIGEST_JOB_INTERVAL"))
+	if err != nil || digestInterval <= 0 {
+		digestInterval = 5 * time.Minute
+	}
+
+	c.Job.DigestInterval = digestInterval
+
+	return c
+}
+
+func (c *Config) loadPayroll() *Config {
+	// env value
+	c.Payroll.MappingFile = os.Getenv("PAYROLL_MAPPING_FILE")
+	c.Payroll.LateDeduction, _ = strconv.ParseFloat(os.Getenv("PAYROLL_LATE_DEDUCTION"), 64)
+
+	return c
+}
+
+func (c *Config) loadFingerprint() *Config {
+	// env value
+	location, err := time.LoadLocation(os.Getenv("DEVICE_TIMEZONE"))
+	if err != nil {
+		log.Println("Invalid DEVICE_TIMEZONE, using local time")
+		location = time.Local
+	}
+
+	c.Fingerprint.Location = location
+
+	return c
+}
+
+func (c *Config) loadSearch() *Config {
+	// env value, "like" for databases without FULLTEXT support
+	c.Search.FullText = os.Getenv("SEARCH_MODE") != "like"
+
+	return c
+}
+
+func (c *Config) loadAttachment() *Config {
+	// env value, max size in megabytes
+	c.Attachment.Dir = os.Getenv("ATTACHMENT_DIR")
+	if c.Attachment.Dir == "" {
+		c.Attachment.Dir = "storage/attachments"
+	}
+
+	maxSize, err := strconv.ParseInt(os.Getenv("ATTACHMENT_MAX_SIZE_MB"), 10, 64)
+	if err != nil || maxSize <= 0 {
+		maxSize = 5
+	}
+	c.Attachment.MaxSize = maxSize << 20
+
+	return c
+}
+
+func (c *Config) loadDigest() *Config {
+	// env value, the notifier is one of "file", "webhook" or "smtp"
+	c.Digest.Notifier = strings.ToLower(os.Getenv("DIGEST_NOTIFIER"))
+	if c.Digest.Notifier == "" {
+		c.Digest.Notifier = "file"
+	}
+
+	c.Digest.At = os.Getenv("DIGEST_AT")
+	if _, err := time.Parse("15:04", c.Digest.At); err != nil {
+		c.Digest.At = "17:00"
+	}
+
+	c.Digest.WeeklyDay = time.Friday
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(os.Getenv("DIGEST_WEEKLY_DAY"), day.String()) {
+			c.Digest.WeeklyDay = day
+		}
+	}
+
+	c.Digest.FileDir = os.Getenv("DIGEST_FILE_DIR")
+	if c.Digest.FileDir == "" {
+		c.Digest.FileDir = "storage/digests"
+	}
+
+	c.Digest.WebhookURL = os.Getenv("DIGEST_WEBHOOK_URL")
+
+	return c
+}
+
+func (c *Config) loadSMTP() *Config {
+	// env value
+	c.SMTP.Host = os.Getenv("SMTP_HOST")
+	c.SMTP.Port = os.Getenv("SMTP_PORT")
+	if c.SMTP.Port == "" {
+		c.SMTP.Port = "587"
+	}
+	c.SMTP.Username = os.Getenv("SMTP_USERNAME")
+	c.SMTP.Password = os.Getenv("SMTP_PASSWORD")
+	c.SMTP.From = os.Getenv("SMTP_FROM")
+
+	return c
+}
+
+func (c *Config) loadInvite() *Config {
+	// env value, the token is appended as ?token=
+	c.Invite.URL = os.Getenv("INVITE_URL")
+	if c.Invite.URL == "" {
+		c.Invite.URL = c.localURL("/invite")
+	}
+
+	ttl, err := time.ParseDuration(os.Getenv("INVITE_TTL"))
+	if err != nil || ttl <= 0 {
+		ttl = 7 * 24 * time.Hour
+	}
+	c.Invite.TTL = ttl
+
+	return c
+}
+
+func (c *Config) loadVerify() *Config {
+	// env value, the token is appended as ?token=
+	c.Verify.URL = os.Getenv("VERIFY_URL")
+	if c.Verify.URL == "" {
+		c.Verify.URL = c.localURL("/verify")
+	}
+
+	ttl, err := time.ParseDuration(os.Getenv("VERIFY_TTL"))
+	if err != nil || ttl <= 0 {
+		ttl = 24 * time.Hour
+	}
+	c.Verify.TTL = ttl
+
+	return c
+}
+
+func (c *Config) loadMail() *Config {
+	// env value, the driver is one of "file", "log" or "smtp"
+	c.Mail.Driver = strings.ToLower(os.Getenv("MAIL_DRIVER"))
+	if c.Mail.Driver == "" {
+		c.Mail.Driver = "file"
+	}
+
+	c.Mail.FileDir = os.Getenv("MAIL_FILE_DIR")
+	if c.Mail.FileDir == "" {
+		c.Mail.FileDir = "storage/mails"
+	}
+
+	return c
+}
+
+func (c *Config) loadOIDC() *Config {
+	// env value, single sign-on is off while OIDC_ISSUER is empty
+	c.OIDC.Issuer = os.Getenv("OIDC_ISSUER")
+	c.OIDC.ClientID = os.Getenv("OIDC_CLIENT_ID")
+	c.OIDC.ClientSecret = os.Getenv("OIDC_CLIENT_SECRET")
+
+	c.OIDC.RedirectURL = os.Getenv("OIDC_REDIRECT_URL")
+	if c.OIDC.RedirectURL == "" {
+		c.OIDC.RedirectURL = c.localURL("/sso/callback")
+	}
+
+	// space separated like the scope parameter, openid is always requested
+	c.OIDC.Scopes = strings.Fields(os.Getenv("OIDC_SCOPES"))
+
+	c.OIDC.AutoProvision = os.Getenv("OIDC_AUTO_PROVISION") == "true"
+	c.OIDC.Company = os.Getenv("OIDC_COMPANY")
+
+	return c
+}
+
+// localURL points path at this server, used when no public URL is set.
+func (c *Config) localURL(path string) string {
+	port := c.App.Port
+	if port == "" {
+		port = "8080"
+	}
+
+	return fmt.Sprintf("http://localhost:%s%s", port, path)
+}
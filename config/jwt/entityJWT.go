@@ -9,7 +9,10 @@ var JWT_KEY = []byte("rahasia")
 type JWTclaim struct {
 	ID        int64
 	CheckinID int64
+	CompanyID int64
+	Timezone  string
 	Email     string
 	Name      string
+	Role      string
 	jwt.StandardClaims
 }
@@ -0,0 +1,39 @@
+package jwt
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/dgrijalva/jwt-go"
+
+	"github.com/Risuii/helpers/exception"
+)
+
+// Parse returns the claims of a token signed with JWT_KEY. A token that is
+// expired, tampered with or signed otherwise is rejected, its claims must
+// not be used.
+func Parse(tokenString string) (*JWTclaim, error) {
+	claims := &JWTclaim{}
+
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		return JWT_KEY, nil
+	})
+	if err != nil || !token.Valid {
+		return nil, exception.ErrUnauthorized
+	}
+
+	return claims, nil
+}
+
+// FromCookie returns the verified claims of the token in cookie name.
+func FromCookie(r *http.Request, name string) (*JWTclaim, error) {
+	c, err := r.Cookie(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(c.Value)
+}
@@ -0,0 +1,104 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type (
+	Message struct {
+		To      string
+		Name    string
+		Subject string
+		Body    string
+	}
+
+	// Mailer delivers a plain text message to a single recipient.
+	Mailer interface {
+		Send(ctx context.Context, msg Message) error
+	}
+
+	fileMailer struct {
+		dir string
+	}
+
+	logMailer struct{}
+
+	SMTPConfig struct {
+		Host     string
+		Port     string
+		Username string
+		Password string
+		From     string
+	}
+
+	smtpMailer struct {
+		cfg SMTPConfig
+	}
+)
+
+// NewFileMailer writes every message as a text file in dir, meant for local
+// runs without a mail server.
+func NewFileMailer(dir string) Mailer {
+	return &fileMailer{
+		dir: dir,
+	}
+}
+
+func (fm *fileMailer) Send(ctx context.Context, msg Message) error {
+	if err := os.MkdirAll(fm.dir, 0o750); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%s.txt", time.Now().Format("20060102-150405.000000000"), strings.NewReplacer("@", "_at_", "/", "_").Replace(msg.To))
+
+	return os.WriteFile(filepath.Join(fm.dir, name), []byte(render(msg)), 0o640)
+}
+
+// NewLogMailer prints every message to the standard logger.
+func NewLogMailer() Mailer {
+	return &logMailer{}
+}
+
+func (lm *logMailer) Send(ctx context.Context, msg Message) error {
+	log.Printf("mail to %s\n%s", msg.To, render(msg))
+	return nil
+}
+
+func NewSMTPMailer(cfg SMTPConfig) Mailer {
+	return &smtpMailer{
+		cfg: cfg,
+	}
+}
+
+func (sm *smtpMailer) Send(ctx context.Context, msg Message) error {
+	to := mail.Address{Name: msg.Name, Address: msg.To}
+	from := mail.Address{Address: sm.cfg.From}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from.String())
+	fmt.Fprintf(&b, "To: %s\r\n", to.String())
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	var auth smtp.Auth
+	if sm.cfg.Username != "" {
+		auth = smtp.PlainAuth("", sm.cfg.Username, sm.cfg.Password, sm.cfg.Host)
+	}
+
+	return smtp.SendMail(net.JoinHostPort(sm.cfg.Host, sm.cfg.Port), auth, sm.cfg.From, []string{msg.To}, []byte(b.String()))
+}
+
+func render(msg Message) string {
+	return fmt.Sprintf("To: %s\nSubject: %s\n\n%s\n", msg.To, msg.Subject, msg.Body)
+}
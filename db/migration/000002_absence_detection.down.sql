@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS `absensi`.`employee_leave`;
+DROP TABLE IF EXISTS `absensi`.`holiday`;
+
+ALTER TABLE `absensi`.`absen`
+  DROP COLUMN `status`,
+  DROP COLUMN `date`;
+
+ALTER TABLE `absensi`.`employee` DROP FOREIGN KEY `employee_ibfk_1`;
+ALTER TABLE `absensi`.`employee` DROP COLUMN `shift_id`;
+
+DROP TABLE IF EXISTS `absensi`.`shift`;
@@ -0,0 +1,36 @@
+CREATE TABLE `absensi`.`shift` (
+  `ID` INT NOT NULL AUTO_INCREMENT,
+  `name` VARCHAR(255) NOT NULL,
+  `start_time` TIME NOT NULL,
+  `end_time` TIME NOT NULL,
+  `grace_minutes` INT NOT NULL DEFAULT 15,
+  `work_days` VARCHAR(20) NOT NULL DEFAULT '1,2,3,4,5',
+  PRIMARY KEY (`ID`)
+);
+
+ALTER TABLE `absensi`.`employee`
+  ADD COLUMN `shift_id` INT NULL,
+  ADD FOREIGN KEY (`shift_id`) REFERENCES shift(`ID`);
+
+ALTER TABLE `absensi`.`absen`
+  ADD COLUMN `date` DATE NULL DEFAULT (curdate()),
+  ADD COLUMN `status` VARCHAR(20) NOT NULL DEFAULT 'present';
+
+CREATE TABLE `absensi`.`holiday` (
+  `ID` INT NOT NULL AUTO_INCREMENT,
+  `date` DATE NOT NULL,
+  `name` VARCHAR(255) NULL,
+  PRIMARY KEY (`ID`),
+  UNIQUE (`date`)
+);
+
+CREATE TABLE `absensi`.`employee_leave` (
+  `ID` INT NOT NULL AUTO_INCREMENT,
+  `userID` INT NOT NULL,
+  `start_date` DATE NOT NULL,
+  `end_date` DATE NOT NULL,
+  `type` VARCHAR(50) NULL,
+  `created_at` DATETIME NULL DEFAULT (now()),
+  PRIMARY KEY (`ID`),
+  FOREIGN KEY (`userID`) REFERENCES employee(`ID`)
+);
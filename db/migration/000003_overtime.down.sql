@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS `absensi`.`overtime`;
+
+ALTER TABLE `absensi`.`absen`
+  DROP COLUMN `approved_overtime_minutes`,
+  DROP COLUMN `overtime_minutes`;
+
+ALTER TABLE `absensi`.`employee` DROP COLUMN `role`;
@@ -0,0 +1,21 @@
+ALTER TABLE `absensi`.`employee`
+  ADD COLUMN `role` VARCHAR(20) NOT NULL DEFAULT 'employee';
+
+ALTER TABLE `absensi`.`absen`
+  ADD COLUMN `overtime_minutes` INT NOT NULL DEFAULT 0,
+  ADD COLUMN `approved_overtime_minutes` INT NOT NULL DEFAULT 0;
+
+CREATE TABLE `absensi`.`overtime` (
+  `ID` INT NOT NULL AUTO_INCREMENT,
+  `userID` INT NOT NULL,
+  `date` DATE NOT NULL,
+  `planned_hours` DECIMAL(4,2) NOT NULL,
+  `reason` VARCHAR(255) NULL,
+  `status` VARCHAR(20) NOT NULL DEFAULT 'pending',
+  `reviewed_by` INT NULL,
+  `reviewed_at` DATETIME NULL,
+  `created_at` DATETIME NULL DEFAULT (now()),
+  PRIMARY KEY (`ID`),
+  FOREIGN KEY (`userID`) REFERENCES employee(`ID`),
+  FOREIGN KEY (`reviewed_by`) REFERENCES employee(`ID`)
+);
@@ -0,0 +1,3 @@
+DROP INDEX `idx_activity_user_created` ON `absensi`.`activity`;
+DROP INDEX `idx_absen_user_date` ON `absensi`.`absen`;
+DROP INDEX `idx_absen_name_date` ON `absensi`.`absen`;
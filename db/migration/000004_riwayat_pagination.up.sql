@@ -0,0 +1,5 @@
+UPDATE `absensi`.`absen` SET `date` = DATE(`checkin`) WHERE `checkin` IS NOT NULL;
+
+CREATE INDEX `idx_absen_name_date` ON `absensi`.`absen` (`name`, `date`);
+CREATE INDEX `idx_absen_user_date` ON `absensi`.`absen` (`userID`, `date`);
+CREATE INDEX `idx_activity_user_created` ON `absensi`.`activity` (`userID`, `created_at`);
@@ -0,0 +1,3 @@
+ALTER TABLE `absensi`.`employee`
+  DROP INDEX `idx_employee_code`,
+  DROP COLUMN `code`;
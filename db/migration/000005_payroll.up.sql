@@ -0,0 +1,3 @@
+ALTER TABLE `absensi`.`employee`
+  ADD COLUMN `code` VARCHAR(50) NULL,
+  ADD UNIQUE INDEX `idx_employee_code` (`code`);
@@ -0,0 +1,6 @@
+ALTER TABLE `absensi`.`absen`
+  DROP COLUMN `source`;
+
+ALTER TABLE `absensi`.`employee`
+  DROP INDEX `idx_employee_device_user`,
+  DROP COLUMN `device_user_id`;
@@ -0,0 +1,6 @@
+ALTER TABLE `absensi`.`employee`
+  ADD COLUMN `device_user_id` VARCHAR(50) NULL,
+  ADD UNIQUE INDEX `idx_employee_device_user` (`device_user_id`);
+
+ALTER TABLE `absensi`.`absen`
+  ADD COLUMN `source` VARCHAR(20) NOT NULL DEFAULT 'app';
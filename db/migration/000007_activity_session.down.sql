@@ -0,0 +1,5 @@
+ALTER TABLE `absensi`.`activity`
+  DROP FOREIGN KEY `fk_activity_absen`;
+
+ALTER TABLE `absensi`.`activity`
+  DROP COLUMN `absenID`;
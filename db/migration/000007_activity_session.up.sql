@@ -0,0 +1,3 @@
+ALTER TABLE `absensi`.`activity`
+  ADD COLUMN `absenID` INT NULL,
+  ADD CONSTRAINT `fk_activity_absen` FOREIGN KEY (`absenID`) REFERENCES absen(`ID`);
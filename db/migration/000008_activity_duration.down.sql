@@ -0,0 +1,4 @@
+ALTER TABLE `absensi`.`activity`
+  DROP COLUMN `started_at`,
+  DROP COLUMN `ended_at`,
+  DROP COLUMN `duration_minutes`;
@@ -0,0 +1,4 @@
+ALTER TABLE `absensi`.`activity`
+  ADD COLUMN `started_at` DATETIME NULL,
+  ADD COLUMN `ended_at` DATETIME NULL,
+  ADD COLUMN `duration_minutes` INT NOT NULL DEFAULT 0;
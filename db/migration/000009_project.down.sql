@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS `absensi`.`activity_tag`;
+
+ALTER TABLE `absensi`.`activity`
+  DROP FOREIGN KEY `fk_activity_project`;
+
+ALTER TABLE `absensi`.`activity`
+  DROP COLUMN `projectID`;
+
+DROP TABLE IF EXISTS `absensi`.`project`;
@@ -0,0 +1,22 @@
+CREATE TABLE `absensi`.`project` (
+  `ID` INT NOT NULL AUTO_INCREMENT,
+  `code` VARCHAR(20) NOT NULL,
+  `name` VARCHAR(100) NOT NULL,
+  `client` VARCHAR(100) NULL,
+  `active` TINYINT(1) NOT NULL DEFAULT 1,
+  `created_at` DATETIME NULL DEFAULT (now()),
+  PRIMARY KEY (`ID`),
+  UNIQUE INDEX `idx_project_code` (`code`)
+);
+
+ALTER TABLE `absensi`.`activity`
+  ADD COLUMN `projectID` INT NULL,
+  ADD CONSTRAINT `fk_activity_project` FOREIGN KEY (`projectID`) REFERENCES project(`ID`);
+
+CREATE TABLE `absensi`.`activity_tag` (
+  `activityID` INT NOT NULL,
+  `tag` VARCHAR(30) NOT NULL,
+  PRIMARY KEY (`activityID`, `tag`),
+  INDEX `idx_activity_tag` (`tag`),
+  FOREIGN KEY (`activityID`) REFERENCES activity(`ID`) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+ALTER TABLE `absensi`.`activity`
+  DROP INDEX `idx_activity_deskripsi`;
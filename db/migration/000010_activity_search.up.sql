@@ -0,0 +1,2 @@
+ALTER TABLE `absensi`.`activity`
+  ADD FULLTEXT INDEX `idx_activity_deskripsi` (`deskripsi`);
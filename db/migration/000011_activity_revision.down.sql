@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS `absensi`.`activity_revision`;
+
+ALTER TABLE `absensi`.`activity`
+  DROP COLUMN `deleted_at`;
@@ -0,0 +1,14 @@
+ALTER TABLE `absensi`.`activity`
+  ADD COLUMN `deleted_at` DATETIME NULL;
+
+CREATE TABLE `absensi`.`activity_revision` (
+  `ID` INT NOT NULL AUTO_INCREMENT,
+  `activityID` INT NOT NULL,
+  `deskripsi` VARCHAR(255) NULL,
+  `edited_by` INT NOT NULL,
+  `edited_at` DATETIME NOT NULL,
+  PRIMARY KEY (`ID`),
+  INDEX `idx_activity_revision_activity` (`activityID`),
+  FOREIGN KEY (`activityID`) REFERENCES activity(`ID`),
+  FOREIGN KEY (`edited_by`) REFERENCES employee(`ID`)
+);
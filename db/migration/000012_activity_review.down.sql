@@ -0,0 +1,9 @@
+ALTER TABLE `absensi`.`activity`
+  DROP FOREIGN KEY `fk_activity_reviewer`;
+
+ALTER TABLE `absensi`.`activity`
+  DROP INDEX `idx_activity_status`,
+  DROP COLUMN `reviewed_at`,
+  DROP COLUMN `reviewed_by`,
+  DROP COLUMN `review_comment`,
+  DROP COLUMN `status`;
@@ -0,0 +1,7 @@
+ALTER TABLE `absensi`.`activity`
+  ADD COLUMN `status` VARCHAR(20) NOT NULL DEFAULT 'pending',
+  ADD COLUMN `review_comment` VARCHAR(255) NULL,
+  ADD COLUMN `reviewed_by` INT NULL,
+  ADD COLUMN `reviewed_at` DATETIME NULL,
+  ADD INDEX `idx_activity_status` (`status`),
+  ADD CONSTRAINT `fk_activity_reviewer` FOREIGN KEY (`reviewed_by`) REFERENCES employee(`ID`);
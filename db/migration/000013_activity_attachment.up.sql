@@ -0,0 +1,15 @@
+CREATE TABLE `absensi`.`activity_attachment` (
+  `ID` INT NOT NULL AUTO_INCREMENT,
+  `activityID` INT NOT NULL,
+  `file_name` VARCHAR(255) NOT NULL,
+  `content_type` VARCHAR(100) NOT NULL,
+  `size` BIGINT NOT NULL,
+  `storage_key` VARCHAR(255) NOT NULL,
+  `uploaded_by` INT NOT NULL,
+  `created_at` DATETIME NULL DEFAULT (now()),
+  PRIMARY KEY (`ID`),
+  UNIQUE INDEX `idx_activity_attachment_key` (`storage_key`),
+  INDEX `idx_activity_attachment_activity` (`activityID`),
+  FOREIGN KEY (`activityID`) REFERENCES activity(`ID`),
+  FOREIGN KEY (`uploaded_by`) REFERENCES employee(`ID`)
+);
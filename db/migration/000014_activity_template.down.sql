@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `absensi`.`activity_template`;
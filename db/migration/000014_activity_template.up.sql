@@ -0,0 +1,17 @@
+CREATE TABLE `absensi`.`activity_template` (
+  `ID` INT NOT NULL AUTO_INCREMENT,
+  `userID` INT NOT NULL,
+  `name` VARCHAR(100) NOT NULL,
+  `projectID` INT NULL,
+  `deskripsi` VARCHAR(255) NOT NULL,
+  `tags` VARCHAR(400) NOT NULL DEFAULT '',
+  `duration_minutes` INT NOT NULL DEFAULT 0,
+  `recurrence` VARCHAR(20) NOT NULL DEFAULT '',
+  `days` TINYINT NOT NULL DEFAULT 0,
+  `created_at` DATETIME NULL DEFAULT (now()),
+  `update_at` DATETIME NULL DEFAULT (now()),
+  PRIMARY KEY (`ID`),
+  INDEX `idx_activity_template_user` (`userID`),
+  FOREIGN KEY (`userID`) REFERENCES employee(`ID`),
+  FOREIGN KEY (`projectID`) REFERENCES project(`ID`)
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `absensi`.`digest_log`;
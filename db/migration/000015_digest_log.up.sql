@@ -0,0 +1,10 @@
+CREATE TABLE `absensi`.`digest_log` (
+  `ID` INT NOT NULL AUTO_INCREMENT,
+  `period` VARCHAR(10) NOT NULL,
+  `period_start` DATE NOT NULL,
+  `recipientID` INT NOT NULL,
+  `sent_at` DATETIME NULL DEFAULT (now()),
+  PRIMARY KEY (`ID`),
+  UNIQUE INDEX `idx_digest_log_sent` (`period`, `period_start`, `recipientID`),
+  FOREIGN KEY (`recipientID`) REFERENCES employee(`ID`)
+);
@@ -0,0 +1,10 @@
+ALTER TABLE `absensi`.`employee`
+  DROP FOREIGN KEY `fk_employee_manager`,
+  DROP FOREIGN KEY `fk_employee_team`;
+
+ALTER TABLE `absensi`.`employee`
+  DROP COLUMN `manager_id`,
+  DROP COLUMN `team_id`;
+
+DROP TABLE IF EXISTS `absensi`.`team`;
+DROP TABLE IF EXISTS `absensi`.`department`;
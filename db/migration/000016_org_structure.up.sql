@@ -0,0 +1,23 @@
+CREATE TABLE `absensi`.`department` (
+  `ID` INT NOT NULL AUTO_INCREMENT,
+  `name` VARCHAR(100) NOT NULL,
+  `created_at` DATETIME NULL DEFAULT (now()),
+  PRIMARY KEY (`ID`),
+  UNIQUE INDEX `idx_department_name` (`name`)
+);
+
+CREATE TABLE `absensi`.`team` (
+  `ID` INT NOT NULL AUTO_INCREMENT,
+  `departmentID` INT NOT NULL,
+  `name` VARCHAR(100) NOT NULL,
+  `created_at` DATETIME NULL DEFAULT (now()),
+  PRIMARY KEY (`ID`),
+  UNIQUE INDEX `idx_team_name` (`departmentID`, `name`),
+  FOREIGN KEY (`departmentID`) REFERENCES department(`ID`)
+);
+
+ALTER TABLE `absensi`.`employee`
+  ADD COLUMN `team_id` INT NULL,
+  ADD COLUMN `manager_id` INT NULL,
+  ADD CONSTRAINT `fk_employee_team` FOREIGN KEY (`team_id`) REFERENCES team(`ID`),
+  ADD CONSTRAINT `fk_employee_manager` FOREIGN KEY (`manager_id`) REFERENCES employee(`ID`);
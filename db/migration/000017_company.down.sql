@@ -0,0 +1,51 @@
+ALTER TABLE `absensi`.`team`
+  DROP FOREIGN KEY `fk_team_company`;
+ALTER TABLE `absensi`.`team`
+  DROP COLUMN `company_id`;
+
+ALTER TABLE `absensi`.`department`
+  DROP FOREIGN KEY `fk_department_company`;
+ALTER TABLE `absensi`.`department`
+  DROP INDEX `idx_department_name`,
+  ADD UNIQUE INDEX `idx_department_name` (`name`),
+  DROP COLUMN `company_id`;
+
+ALTER TABLE `absensi`.`holiday`
+  DROP FOREIGN KEY `fk_holiday_company`;
+ALTER TABLE `absensi`.`holiday`
+  DROP INDEX `idx_holiday_date`,
+  ADD UNIQUE INDEX `date` (`date`),
+  DROP COLUMN `company_id`;
+
+ALTER TABLE `absensi`.`shift`
+  DROP FOREIGN KEY `fk_shift_company`;
+ALTER TABLE `absensi`.`shift`
+  DROP COLUMN `company_id`;
+
+ALTER TABLE `absensi`.`project`
+  DROP FOREIGN KEY `fk_project_company`;
+ALTER TABLE `absensi`.`project`
+  DROP INDEX `idx_project_code`,
+  ADD UNIQUE INDEX `idx_project_code` (`code`),
+  DROP COLUMN `company_id`;
+
+ALTER TABLE `absensi`.`activity`
+  DROP FOREIGN KEY `fk_activity_company`;
+ALTER TABLE `absensi`.`activity`
+  DROP COLUMN `company_id`;
+
+ALTER TABLE `absensi`.`absen`
+  DROP FOREIGN KEY `fk_absen_company`;
+ALTER TABLE `absensi`.`absen`
+  DROP COLUMN `company_id`;
+
+ALTER TABLE `absensi`.`employee`
+  DROP FOREIGN KEY `fk_employee_company`;
+ALTER TABLE `absensi`.`employee`
+  DROP INDEX `idx_employee_device_user`,
+  ADD UNIQUE INDEX `idx_employee_device_user` (`device_user_id`),
+  DROP INDEX `idx_employee_code`,
+  ADD UNIQUE INDEX `idx_employee_code` (`code`),
+  DROP COLUMN `company_id`;
+
+DROP TABLE IF EXISTS `absensi`.`company`;
@@ -0,0 +1,54 @@
+CREATE TABLE `absensi`.`company` (
+  `ID` INT NOT NULL AUTO_INCREMENT,
+  `code` VARCHAR(20) NOT NULL,
+  `name` VARCHAR(100) NOT NULL,
+  `timezone` VARCHAR(64) NOT NULL DEFAULT 'Asia/Jakarta',
+  `created_at` DATETIME NULL DEFAULT (now()),
+  PRIMARY KEY (`ID`),
+  UNIQUE INDEX `idx_company_code` (`code`)
+);
+
+-- existing data belongs to the default company
+INSERT INTO `absensi`.`company` (`ID`, `code`, `name`) VALUES (1, 'default', 'Default');
+
+ALTER TABLE `absensi`.`employee`
+  ADD COLUMN `company_id` INT NOT NULL DEFAULT 1,
+  ADD CONSTRAINT `fk_employee_company` FOREIGN KEY (`company_id`) REFERENCES company(`ID`),
+  DROP INDEX `idx_employee_code`,
+  ADD UNIQUE INDEX `idx_employee_code` (`company_id`, `code`),
+  DROP INDEX `idx_employee_device_user`,
+  ADD UNIQUE INDEX `idx_employee_device_user` (`company_id`, `device_user_id`);
+
+ALTER TABLE `absensi`.`absen`
+  ADD COLUMN `company_id` INT NOT NULL DEFAULT 1,
+  ADD CONSTRAINT `fk_absen_company` FOREIGN KEY (`company_id`) REFERENCES company(`ID`);
+
+ALTER TABLE `absensi`.`activity`
+  ADD COLUMN `company_id` INT NOT NULL DEFAULT 1,
+  ADD CONSTRAINT `fk_activity_company` FOREIGN KEY (`company_id`) REFERENCES company(`ID`);
+
+ALTER TABLE `absensi`.`project`
+  ADD COLUMN `company_id` INT NOT NULL DEFAULT 1,
+  ADD CONSTRAINT `fk_project_company` FOREIGN KEY (`company_id`) REFERENCES company(`ID`),
+  DROP INDEX `idx_project_code`,
+  ADD UNIQUE INDEX `idx_project_code` (`company_id`, `code`);
+
+ALTER TABLE `absensi`.`shift`
+  ADD COLUMN `company_id` INT NOT NULL DEFAULT 1,
+  ADD CONSTRAINT `fk_shift_company` FOREIGN KEY (`company_id`) REFERENCES company(`ID`);
+
+ALTER TABLE `absensi`.`holiday`
+  ADD COLUMN `company_id` INT NOT NULL DEFAULT 1,
+  ADD CONSTRAINT `fk_holiday_company` FOREIGN KEY (`company_id`) REFERENCES company(`ID`),
+  DROP INDEX `date`,
+  ADD UNIQUE INDEX `idx_holiday_date` (`company_id`, `date`);
+
+ALTER TABLE `absensi`.`department`
+  ADD COLUMN `company_id` INT NOT NULL DEFAULT 1,
+  ADD CONSTRAINT `fk_department_company` FOREIGN KEY (`company_id`) REFERENCES company(`ID`),
+  DROP INDEX `idx_department_name`,
+  ADD UNIQUE INDEX `idx_department_name` (`company_id`, `name`);
+
+ALTER TABLE `absensi`.`team`
+  ADD COLUMN `company_id` INT NOT NULL DEFAULT 1,
+  ADD CONSTRAINT `fk_team_company` FOREIGN KEY (`company_id`) REFERENCES company(`ID`);
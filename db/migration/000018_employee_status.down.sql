@@ -0,0 +1,2 @@
+ALTER TABLE `absensi`.`employee`
+  DROP COLUMN `status`;
@@ -0,0 +1,2 @@
+ALTER TABLE `absensi`.`employee`
+  ADD COLUMN `status` VARCHAR(20) NOT NULL DEFAULT 'active';
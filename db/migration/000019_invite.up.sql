@@ -0,0 +1,11 @@
+CREATE TABLE `absensi`.`invite` (
+  `ID` INT NOT NULL AUTO_INCREMENT,
+  `userID` INT NOT NULL,
+  `token_hash` CHAR(64) NOT NULL,
+  `expires_at` DATETIME NOT NULL,
+  `used_at` DATETIME NULL,
+  `created_at` DATETIME NULL DEFAULT (now()),
+  PRIMARY KEY (`ID`),
+  UNIQUE INDEX `idx_invite_token` (`token_hash`),
+  FOREIGN KEY (`userID`) REFERENCES employee(`ID`)
+);
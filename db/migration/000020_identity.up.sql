@@ -0,0 +1,10 @@
+CREATE TABLE `absensi`.`identity` (
+  `ID` INT NOT NULL AUTO_INCREMENT,
+  `userID` INT NOT NULL,
+  `issuer` VARCHAR(255) NOT NULL,
+  `subject` VARCHAR(255) NOT NULL,
+  `created_at` DATETIME NULL DEFAULT (now()),
+  PRIMARY KEY (`ID`),
+  UNIQUE INDEX `idx_identity_subject` (`issuer`, `subject`),
+  FOREIGN KEY (`userID`) REFERENCES employee(`ID`)
+);
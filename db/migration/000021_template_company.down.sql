@@ -0,0 +1,4 @@
+ALTER TABLE `absensi`.`activity_template`
+  DROP FOREIGN KEY `fk_activity_template_company`;
+ALTER TABLE `absensi`.`activity_template`
+  DROP COLUMN `company_id`;
@@ -0,0 +1,8 @@
+ALTER TABLE `absensi`.`activity_template`
+  ADD COLUMN `company_id` INT NOT NULL DEFAULT 1,
+  ADD CONSTRAINT `fk_activity_template_company` FOREIGN KEY (`company_id`) REFERENCES company(`ID`);
+
+-- templates belong to the company of their owner
+UPDATE `absensi`.`activity_template` t
+  JOIN `absensi`.`employee` e ON e.`ID` = t.`userID`
+  SET t.`company_id` = e.`company_id`;
@@ -0,0 +1,6 @@
+package constant
+
+const (
+	QueueAbsensi = "Absensi"
+	QueueAbsent  = "Absent"
+)
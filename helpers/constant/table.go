@@ -1,7 +1,22 @@
 package constant
 
 const (
-	TableEmployee = "employee"
-	TableActivity = "activity"
-	TableAbsensi  = "absen"
+	TableEmployee   = "employee"
+	TableActivity   = "activity"
+	TableAbsensi    = "absen"
+	TableShift      = "shift"
+	TableHoliday    = "holiday"
+	TableLeave      = "employee_leave"
+	TableOvertime   = "overtime"
+	TableProject    = "project"
+	TableTag        = "activity_tag"
+	TableRevision   = "activity_revision"
+	TableAttachment = "activity_attachment"
+	TableTemplate   = "activity_template"
+	TableDigestLog  = "digest_log"
+	TableDepartment = "department"
+	TableTeam       = "team"
+	TableCompany    = "company"
+	TableInvite     = "invite"
+	TableIdentity   = "identity"
 )
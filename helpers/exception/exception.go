@@ -8,6 +8,7 @@ var (
 	ErrNotFound            = fmt.Errorf("not found error")
 	ErrBadRequest          = fmt.Errorf("bad request")
 	ErrUnauthorized        = fmt.Errorf("unauthorized")
+	ErrForbidden           = fmt.Errorf("forbidden")
 	ErrNotPremium          = fmt.Errorf("not premium user")
 	ErrUnprocessableEntity = fmt.Errorf("error UnprocessableEntity")
 )
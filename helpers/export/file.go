@@ -0,0 +1,51 @@
+package export
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// FileWriter sends a file download. Content-Type and Content-Disposition are
+// only written with the first byte, so a handler can still answer with a JSON
+// error as long as Started reports false.
+type FileWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	filename    string
+	started     bool
+}
+
+func NewFileWriter(w http.ResponseWriter, contentType string, filename string) *FileWriter {
+	return &FileWriter{
+		w:           w,
+		contentType: contentType,
+		filename:    filename,
+	}
+}
+
+// SetFile replaces the content type and filename for a download that only
+// knows them after it started, it has no effect once a byte was written.
+func (fw *FileWriter) SetFile(contentType string, filename string) {
+	if fw.started {
+		return
+	}
+
+	fw.contentType = contentType
+	fw.filename = filename
+}
+
+func (fw *FileWriter) Write(p []byte) (int, error) {
+	if !fw.started {
+		fw.started = true
+		fw.w.Header().Set("Content-Type", fw.contentType)
+		fw.w.Header().Set("X-Content-Type-Options", "nosniff")
+		fw.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fw.filename))
+		fw.w.WriteHeader(http.StatusOK)
+	}
+
+	return fw.w.Write(p)
+}
+
+func (fw *FileWriter) Started() bool {
+	return fw.started
+}
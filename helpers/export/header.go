@@ -0,0 +1,52 @@
+package export
+
+var headers = map[string]map[string]string{
+	"id": {
+		"id":                "ID",
+		"userID":            "ID Karyawan",
+		"name":              "Nama",
+		"date":              "Tanggal",
+		"checkin":           "Jam Masuk",
+		"checkout":          "Jam Keluar",
+		"status":            "Status",
+		"overtime_minutes":  "Lembur (Menit)",
+		"approved_overtime": "Lembur Disetujui (Menit)",
+		"description":       "Deskripsi",
+		"created_at":        "Dibuat",
+		"update_at":         "Diubah",
+	},
+	"en": {
+		"id":                "ID",
+		"userID":            "Employee ID",
+		"name":              "Name",
+		"date":              "Date",
+		"checkin":           "Check In",
+		"checkout":          "Check Out",
+		"status":            "Status",
+		"overtime_minutes":  "Overtime (Minutes)",
+		"approved_overtime": "Approved Overtime (Minutes)",
+		"description":       "Description",
+		"created_at":        "Created At",
+		"update_at":         "Updated At",
+	},
+}
+
+// Header returns the column titles for keys in lang, falling back to
+// Indonesian for an unknown language and to the key for an unknown column.
+func Header(lang string, keys ...string) []interface{} {
+	titles, ok := headers[lang]
+	if !ok {
+		titles = headers["id"]
+	}
+
+	row := make([]interface{}, len(keys))
+	for i, key := range keys {
+		title, ok := titles[key]
+		if !ok {
+			title = key
+		}
+		row[i] = title
+	}
+
+	return row
+}
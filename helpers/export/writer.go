@@ -0,0 +1,105 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const sheetName = "Sheet1"
+
+// Writer writes a table one row at a time so an export never has to hold the
+// whole result in memory. Flush must be called once after the last row.
+type Writer interface {
+	Write(row []interface{}) error
+	Flush() error
+}
+
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case "csv":
+		return &csvWriter{writer: csv.NewWriter(w)}, nil
+	case "xlsx":
+		file := excelize.NewFile()
+		stream, err := file.NewStreamWriter(sheetName)
+		if err != nil {
+			return nil, err
+		}
+		return &xlsxWriter{out: w, file: file, stream: stream}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported export format %q", format)
+}
+
+func ContentType(format string) string {
+	if format == "xlsx" {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+
+	return "text/csv"
+}
+
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+func (cw *csvWriter) Write(row []interface{}) error {
+	record := make([]string, len(row))
+	for i, v := range row {
+		if text, ok := v.(string); ok {
+			record[i] = EscapeFormula(text)
+			continue
+		}
+		record[i] = fmt.Sprint(v)
+	}
+
+	return cw.writer.Write(record)
+}
+
+// EscapeFormula quotes text that a spreadsheet would otherwise run as a
+// formula, numbers are written as they are so negative values stay numeric.
+func EscapeFormula(text string) string {
+	if text != "" && strings.ContainsRune("=+-@\t\r", rune(text[0])) {
+		return "'" + text
+	}
+
+	return text
+}
+
+func (cw *csvWriter) Flush() error {
+	cw.writer.Flush()
+	return cw.writer.Error()
+}
+
+// xlsxWriter uses the excelize stream writer, which spills rows to a
+// temporary file once the sheet grows past its in-memory buffer.
+type xlsxWriter struct {
+	out    io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	row    int
+}
+
+func (xw *xlsxWriter) Write(row []interface{}) error {
+	xw.row++
+
+	cell, err := excelize.CoordinatesToCellName(1, xw.row)
+	if err != nil {
+		return err
+	}
+
+	return xw.stream.SetRow(cell, row)
+}
+
+func (xw *xlsxWriter) Flush() error {
+	defer xw.file.Close()
+
+	if err := xw.stream.Flush(); err != nil {
+		return err
+	}
+
+	return xw.file.Write(xw.out)
+}
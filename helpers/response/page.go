@@ -0,0 +1,8 @@
+package response
+
+type Page struct {
+	Limit      int    `json:"limit"`
+	Sort       string `json:"sort"`
+	Total      int64  `json:"total"`
+	NextCursor int64  `json:"next_cursor,omitempty"`
+}
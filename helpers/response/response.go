@@ -14,6 +14,7 @@ type ResponseImpl struct {
 	err    error
 	Status string      `json:"status"`
 	Data   interface{} `json:"data"`
+	Page   *Page       `json:"page,omitempty"`
 }
 
 func Success(status string, data interface{}) (resp Response) {
@@ -24,6 +25,15 @@ func Success(status string, data interface{}) (resp Response) {
 	}
 }
 
+func SuccessWithPage(status string, data interface{}, page Page) (resp Response) {
+	return &ResponseImpl{
+		err:    nil,
+		Status: status,
+		Data:   data,
+		Page:   &page,
+	}
+}
+
 func Error(status string, err error) (resp Response) {
 	return &ResponseImpl{
 		err:    err,
@@ -32,6 +42,16 @@ func Error(status string, err error) (resp Response) {
 	}
 }
 
+// ErrorWithData is an error response that still tells the client what went
+// wrong, e.g. which items of a bulk request were rejected.
+func ErrorWithData(status string, err error, data interface{}) (resp Response) {
+	return &ResponseImpl{
+		err:    err,
+		Status: status,
+		Data:   data,
+	}
+}
+
 func (r *ResponseImpl) getStatusCode(status string) (statusCode int) {
 	switch status {
 	case StatusOK:
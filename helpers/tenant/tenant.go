@@ -0,0 +1,78 @@
+// Package tenant carries the company of the current request through the
+// context, repositories scope their queries with ID and dates are taken in
+// the company's timezone with Now.
+package tenant
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Risuii/config/jwt"
+)
+
+// Default is the company existing data was moved to when tenants were added.
+const Default int64 = 1
+
+type (
+	key         struct{}
+	locationKey struct{}
+)
+
+func WithID(ctx context.Context, id int64) context.Context {
+	return context.WithValue(ctx, key{}, id)
+}
+
+// ID returns the company of ctx, zero matches no company at all.
+func ID(ctx context.Context) int64 {
+	id, _ := ctx.Value(key{}).(int64)
+	return id
+}
+
+func WithLocation(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, locationKey{}, loc)
+}
+
+// Location returns the timezone of the company in ctx, the server's own when
+// none was set.
+func Location(ctx context.Context) *time.Location {
+	if loc, ok := ctx.Value(locationKey{}).(*time.Location); ok {
+		return loc
+	}
+
+	return time.Local
+}
+
+// Now is the current time in the company's timezone.
+func Now(ctx context.Context) time.Time {
+	return time.Now().In(Location(ctx))
+}
+
+// Middleware puts the company and timezone of the token cookie, or of the
+// checkin token cookie, in the request context. The token must be valid, a request without
+// one runs without a company and can not read any tenant data.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, name := range []string{"token", "checkin-token"} {
+			c, err := r.Cookie(name)
+			if err != nil {
+				continue
+			}
+
+			claims, err := jwt.Parse(c.Value)
+			if err != nil {
+				continue
+			}
+
+			ctx := WithID(r.Context(), claims.CompanyID)
+			if loc, err := time.LoadLocation(claims.Timezone); err == nil && claims.Timezone != "" {
+				ctx = WithLocation(ctx, loc)
+			}
+
+			r = r.WithContext(ctx)
+			break
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,53 @@
+package absence
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/company"
+)
+
+type AbsenceJob struct {
+	UseCase   AbsenceUseCase
+	Companies company.CompanyRepository
+	Interval  time.Duration
+}
+
+func NewAbsenceJob(usecase AbsenceUseCase, companies company.CompanyRepository, interval time.Duration) *AbsenceJob {
+	return &AbsenceJob{
+		UseCase:   usecase,
+		Companies: companies,
+		Interval:  interval,
+	}
+}
+
+// Run checks every company for absent employees on every tick until ctx is
+// cancelled.
+func (job *AbsenceJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			err := company.Each(ctx, job.Companies, now, func(ctx context.Context, now time.Time) {
+				events, err := job.UseCase.Detect(ctx, now)
+				if err != nil {
+					log.Println(err)
+					return
+				}
+
+				if len(events) > 0 {
+					log.Printf("Marked %d employee absent in company %d", len(events), tenant.ID(ctx))
+				}
+			})
+			if err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
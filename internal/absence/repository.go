@@ -0,0 +1,239 @@
+package absence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/streadway/amqp"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/models/absences"
+	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/shifts"
+)
+
+type (
+	AbsenceRepository interface {
+		FindShifts(ctx context.Context) ([]shifts.Shift, error)
+		IsHoliday(ctx context.Context, date string) (bool, error)
+		FindAbsentees(ctx context.Context, shiftID int64, date string) ([]absences.Absentee, error)
+		MarkAbsent(ctx context.Context, params absensis.Absensi) (int64, error)
+		Publish(ctx context.Context, params absences.Event) error
+	}
+
+	absenceRepositoryImpl struct {
+		db     *sql.DB
+		rabbit *amqp.Connection
+	}
+)
+
+func NewAbsenceRepositoryImpl(db *sql.DB, rabbit *amqp.Connection) AbsenceRepository {
+	return &absenceRepositoryImpl{
+		db:     db,
+		rabbit: rabbit,
+	}
+}
+
+func (ar *absenceRepositoryImpl) FindShifts(ctx context.Context) ([]shifts.Shift, error) {
+	shift := []shifts.Shift{}
+
+	query := fmt.Sprintf(`SELECT id, name, start_time, end_time, grace_minutes, work_days FROM %s WHERE company_id = ?`, constant.TableShift)
+	rows, err := ar.db.QueryContext(ctx, query, tenant.ID(ctx))
+	if err != nil {
+		log.Println(err)
+		return shift, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var s shifts.Shift
+		if err := rows.Scan(
+			&s.ID,
+			&s.Name,
+			&s.StartTime,
+			&s.EndTime,
+			&s.GraceMinutes,
+			&s.WorkDays,
+		); err != nil {
+			log.Println(err)
+			return shift, exception.ErrInternalServer
+		}
+		shift = append(shift, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return shift, exception.ErrInternalServer
+	}
+
+	return shift, nil
+}
+
+func (ar *absenceRepositoryImpl) IsHoliday(ctx context.Context, date string) (bool, error) {
+	var total int
+
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE company_id = ? AND date = ?`, constant.TableHoliday)
+	stmt, err := ar.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return false, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	if err := stmt.QueryRowContext(ctx, tenant.ID(ctx), date).Scan(&total); err != nil {
+		log.Println(err)
+		return false, exception.ErrInternalServer
+	}
+
+	return total > 0, nil
+}
+
+func (ar *absenceRepositoryImpl) FindAbsentees(ctx context.Context, shiftID int64, date string) ([]absences.Absentee, error) {
+	absentee := []absences.Absentee{}
+
+	query := fmt.Sprintf(`SELECT e.id, e.name, e.email, e.shift_id FROM %s e
+		WHERE e.company_id = ? AND e.shift_id = ? AND e.status = 'active'
+		AND NOT EXISTS (SELECT 1 FROM %s a WHERE a.userID = e.id AND (a.date = ? OR DATE(a.checkin) = ?))
+		AND NOT EXISTS (SELECT 1 FROM %s l WHERE l.userID = e.id AND ? BETWEEN l.start_date AND l.end_date)`,
+		constant.TableEmployee, constant.TableAbsensi, constant.TableLeave)
+	stmt, err := ar.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return absentee, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, tenant.ID(ctx), shiftID, date, date, date)
+	if err != nil {
+		log.Println(err)
+		return absentee, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var a absences.Absentee
+		if err := rows.Scan(
+			&a.UserID,
+			&a.Name,
+			&a.Email,
+			&a.ShiftID,
+		); err != nil {
+			log.Println(err)
+			return absentee, exception.ErrInternalServer
+		}
+		absentee = append(absentee, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return absentee, exception.ErrInternalServer
+	}
+
+	return absentee, nil
+}
+
+// MarkAbsent writes the absent row unless the employee already has a row for
+// that day, which is reported as conflicted. A run racing another one or a
+// late checkin never ends up with both.
+func (ar *absenceRepositoryImpl) MarkAbsent(ctx context.Context, params absensis.Absensi) (int64, error) {
+	query := fmt.Sprintf(`INSERT INTO %[1]s (userID, name, date, status, company_id) SELECT ?, ?, ?, ?, ? FROM DUAL
+		WHERE NOT EXISTS (SELECT 1 FROM %[1]s WHERE userID = ? AND (date = ? OR DATE(checkin) = ?))`, constant.TableAbsensi)
+	stmt, err := ar.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	date := params.Date.Format("2006-01-02")
+
+	result, err := stmt.ExecContext(
+		ctx,
+		params.UserID,
+		params.Name,
+		date,
+		params.Status,
+		tenant.ID(ctx),
+		params.UserID,
+		date,
+		date,
+	)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	if affected < 1 {
+		return 0, exception.ErrConflicted
+	}
+
+	ID, err := result.LastInsertId()
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	return ID, nil
+}
+
+func (ar *absenceRepositoryImpl) Publish(ctx context.Context, params absences.Event) error {
+	if ar.rabbit == nil {
+		log.Println("rabbitmq connection is not available")
+		return exception.ErrInternalServer
+	}
+
+	ch, err := ar.rabbit.Channel()
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer ch.Close()
+
+	q, err := ch.QueueDeclare(
+		constant.QueueAbsent,
+		false,
+		false,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	data, _ := json.Marshal(params)
+
+	if err := ch.Publish(
+		"",
+		q.Name,
+		false,
+		false,
+		amqp.Publishing{
+			ContentType: "application/json",
+			Body:        data,
+		},
+	); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	return nil
+}
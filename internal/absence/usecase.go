@@ -0,0 +1,106 @@
+package absence
+
+import (
+	"context"
+	"time"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/models/absences"
+	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/shifts"
+)
+
+type (
+	AbsenceUseCase interface {
+		Detect(ctx context.Context, now time.Time) ([]absences.Event, error)
+	}
+
+	absenceUseCaseImpl struct {
+		repository AbsenceRepository
+	}
+)
+
+func NewAbsenceUseCase(repo AbsenceRepository) AbsenceUseCase {
+	return &absenceUseCaseImpl{
+		repository: repo,
+	}
+}
+
+// Detect records an absent row for every employee whose shift grace period
+// has passed on the day of now without a checkin, leave or holiday. The event
+// is only published once the row is written, an employee who got a row in the
+// meantime is skipped so nobody is notified twice or wrongly.
+func (au *absenceUseCaseImpl) Detect(ctx context.Context, now time.Time) ([]absences.Event, error) {
+	events := []absences.Event{}
+	date := now.Format("2006-01-02")
+
+	holiday, err := au.repository.IsHoliday(ctx, date)
+	if err != nil {
+		return events, exception.ErrInternalServer
+	}
+
+	if holiday {
+		return events, nil
+	}
+
+	shift, err := au.repository.FindShifts(ctx)
+	if err != nil {
+		return events, exception.ErrInternalServer
+	}
+
+	for _, s := range shift {
+		if !s.IsWorkDay(now) || now.Before(graceDeadline(s, now)) {
+			continue
+		}
+
+		absentee, err := au.repository.FindAbsentees(ctx, s.ID, date)
+		if err != nil {
+			return events, exception.ErrInternalServer
+		}
+
+		for _, a := range absentee {
+			absent := absensis.Absensi{
+				UserID: a.UserID,
+				Name:   a.Name,
+				Date:   now,
+				Status: absensis.StatusAbsent,
+			}
+
+			_, err := au.repository.MarkAbsent(ctx, absent)
+			if err == exception.ErrConflicted {
+				continue
+			}
+			if err != nil {
+				return events, exception.ErrInternalServer
+			}
+
+			event := absences.Event{
+				UserID:  a.UserID,
+				Name:    a.Name,
+				Email:   a.Email,
+				ShiftID: a.ShiftID,
+				Date:    now,
+				Status:  absensis.StatusAbsent,
+			}
+
+			if err := au.repository.Publish(ctx, event); err != nil {
+				return events, exception.ErrInternalServer
+			}
+
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+func graceDeadline(s shifts.Shift, now time.Time) time.Time {
+	start, err := time.Parse("15:04:05", s.StartTime)
+	if err != nil {
+		start, _ = time.Parse("15:04", s.StartTime)
+	}
+
+	deadline := time.Date(now.Year(), now.Month(), now.Day(), start.Hour(), start.Minute(), start.Second(), 0, now.Location())
+
+	return deadline.Add(time.Duration(s.GraceMinutes) * time.Minute)
+}
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"net/http"
 
-	newJWT "github.com/dgrijalva/jwt-go"
 	"github.com/go-playground/validator/v10"
 	"github.com/gorilla/mux"
 
@@ -37,21 +36,19 @@ func (handler *AbsensiHandler) Checkin(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	c, err := r.Cookie("token")
+	claims, err := jwt.FromCookie(r, "token")
 	if err != nil {
 		res = response.Error(response.StatusUnauthorized, exception.ErrUnauthorized)
 		res.JSON(w)
 		return
 	}
 
-	tokenString := c.Value
-	claims := &jwt.JWTclaim{}
-
-	newJWT.ParseWithClaims(tokenString, claims, func(t *newJWT.Token) (interface{}, error) {
-		return jwt.JWT_KEY, nil
-	})
+	status := absensis.StatusPresent
+	if r.URL.Query().Get("mode") == absensis.StatusWFH {
+		status = absensis.StatusWFH
+	}
 
-	res, token := handler.UseCase.Checkin(ctx, claims.ID, claims.Name)
+	res, token := handler.UseCase.Checkin(ctx, claims.ID, claims.Name, status)
 
 	http.SetCookie(w, &http.Cookie{
 		Name:     "checkin-token",
@@ -75,20 +72,13 @@ func (handler *AbsensiHandler) Checkout(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	c, err := r.Cookie("checkin-token")
+	claims, err := jwt.FromCookie(r, "checkin-token")
 	if err != nil {
 		res = response.Error(response.StatusUnauthorized, exception.ErrUnauthorized)
 		res.JSON(w)
 		return
 	}
 
-	tokenString := c.Value
-	claims := &jwt.JWTclaim{}
-
-	newJWT.ParseWithClaims(tokenString, claims, func(t *newJWT.Token) (interface{}, error) {
-		return jwt.JWT_KEY, nil
-	})
-
 	res = handler.UseCase.Checkout(ctx, claims.CheckinID)
 
 	http.SetCookie(w, &http.Cookie{
@@ -108,7 +98,7 @@ func (handler *AbsensiHandler) Riwayat(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	_, err := r.Cookie("token")
+	claims, err := jwt.FromCookie(r, "token")
 	if err != nil {
 		res = response.Error(response.StatusUnauthorized, err)
 		res.JSON(w)
@@ -127,7 +117,7 @@ func (handler *AbsensiHandler) Riwayat(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res = handler.UseCase.Riwayat(ctx, userInput)
+	res = handler.UseCase.Riwayat(ctx, claims.ID, claims.Role, userInput)
 
 	res.JSON(w)
 }
@@ -6,19 +6,29 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/streadway/amqp"
 
 	"github.com/Risuii/config"
+	"github.com/Risuii/helpers/constant"
 	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
 	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/overtimes"
+	"github.com/Risuii/models/paginations"
+	"github.com/Risuii/models/shifts"
 )
 
 type (
 	AbsensiRepository interface {
 		Checkin(ctx context.Context, params absensis.Absensi) (int64, error)
 		Checkout(ctx context.Context, checkinID int64, params absensis.Absensi) error
-		Riwayat(ctx context.Context, name string) ([]absensis.Absensi, error)
+		Riwayat(ctx context.Context, params absensis.Riwayat) ([]absensis.Absensi, error)
+		CountRiwayat(ctx context.Context, params absensis.Riwayat) (int64, error)
+		FindByID(ctx context.Context, id int64) (absensis.Absensi, error)
+		FindShift(ctx context.Context, userID int64) (shifts.Shift, error)
+		FindApprovedOvertime(ctx context.Context, userID int64, date string) (float64, error)
 		SendMsg(ctx context.Context, params absensis.Absensi) error
 		ReceiveMsg() (absensis.Absensi, error)
 	}
@@ -38,7 +48,7 @@ func NewAbsensiRepositoryImpl(db *sql.DB, tableName string) AbsensiRepository {
 
 func (ur *absensiRepositoryImpl) Checkin(ctx context.Context, params absensis.Absensi) (int64, error) {
 
-	query := fmt.Sprintf(`INSERT INTO %s (userID, name, checkin) VALUES (?, ?, ?)`, ur.tableName)
+	query := fmt.Sprintf(`INSERT INTO %s (userID, name, checkin, date, status, company_id) VALUES (?, ?, ?, ?, ?, ?)`, ur.tableName)
 	stmt, err := ur.db.PrepareContext(ctx, query)
 	if err != nil {
 		log.Println(err)
@@ -52,6 +62,9 @@ func (ur *absensiRepositoryImpl) Checkin(ctx context.Context, params absensis.Ab
 		params.UserID,
 		params.Name,
 		params.Checkin,
+		params.Checkin.Format("2006-01-02"),
+		params.Status,
+		tenant.ID(ctx),
 	)
 
 	if err != nil {
@@ -70,7 +83,7 @@ func (ur *absensiRepositoryImpl) Checkin(ctx context.Context, params absensis.Ab
 }
 
 func (ur *absensiRepositoryImpl) Checkout(ctx context.Context, checkinID int64, params absensis.Absensi) error {
-	query := fmt.Sprintf(`UPDATE %s SET checkout = ? WHERE id = %d`, ur.tableName, checkinID)
+	query := fmt.Sprintf(`UPDATE %s SET checkout = ?, overtime_minutes = ?, approved_overtime_minutes = ? WHERE id = ? AND company_id = ?`, ur.tableName)
 	stmt, err := ur.db.PrepareContext(ctx, query)
 	if err != nil {
 		log.Println(err)
@@ -82,6 +95,10 @@ func (ur *absensiRepositoryImpl) Checkout(ctx context.Context, checkinID int64,
 	result, err := stmt.ExecContext(
 		ctx,
 		params.Checkout,
+		params.OvertimeMinutes,
+		params.ApprovedOvertimeMinutes,
+		checkinID,
+		tenant.ID(ctx),
 	)
 
 	if err != nil {
@@ -98,10 +115,29 @@ func (ur *absensiRepositoryImpl) Checkout(ctx context.Context, checkinID int64,
 	return nil
 }
 
-func (ur *absensiRepositoryImpl) Riwayat(ctx context.Context, name string) ([]absensis.Absensi, error) {
+func (ur *absensiRepositoryImpl) Riwayat(ctx context.Context, params absensis.Riwayat) ([]absensis.Absensi, error) {
 	absensi := []absensis.Absensi{}
 
-	rows, err := ur.db.Query(fmt.Sprintf(`SELECT id, userID, name, checkin, checkout FROM %s WHERE name = '%s'`, ur.tableName, name))
+	where, args := riwayatFilter(ctx, params)
+
+	if params.Cursor > 0 {
+		if params.Sort == paginations.SortDesc {
+			where += " AND id < ?"
+		} else {
+			where += " AND id > ?"
+		}
+		args = append(args, params.Cursor)
+	}
+
+	order := "ASC"
+	if params.Sort == paginations.SortDesc {
+		order = "DESC"
+	}
+
+	args = append(args, params.Limit)
+
+	query := fmt.Sprintf(`SELECT id, userID, name, checkin, checkout, date, status, overtime_minutes, approved_overtime_minutes FROM %s WHERE %s ORDER BY id %s LIMIT ?`, ur.tableName, where, order)
+	rows, err := ur.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		log.Println(err)
 		return absensi, exception.ErrInternalServer
@@ -111,20 +147,31 @@ func (ur *absensiRepositoryImpl) Riwayat(ctx context.Context, name string) ([]ab
 
 	for rows.Next() {
 		var c absensis.Absensi
-		var checkout sql.NullTime
+		var checkin, checkout, date sql.NullTime
 		if err := rows.Scan(
 			&c.ID,
 			&c.UserID,
 			&c.Name,
-			&c.Checkin,
+			&checkin,
 			&checkout,
+			&date,
+			&c.Status,
+			&c.OvertimeMinutes,
+			&c.ApprovedOvertimeMinutes,
 		); err != nil {
 			log.Println(err)
 			return absensi, exception.ErrInternalServer
 		}
+		if checkin.Valid {
+			c.Checkin = checkin.Time
+		}
 		if checkout.Valid {
 			c.Checkout = checkout.Time
 		}
+		if date.Valid {
+			c.Date = date.Time
+		}
+		c.UnapprovedOvertimeMinutes = c.OvertimeMinutes - c.ApprovedOvertimeMinutes
 		absensi = append(absensi, c)
 	}
 
@@ -136,6 +183,128 @@ func (ur *absensiRepositoryImpl) Riwayat(ctx context.Context, name string) ([]ab
 	return absensi, nil
 }
 
+func (ur *absensiRepositoryImpl) CountRiwayat(ctx context.Context, params absensis.Riwayat) (int64, error) {
+	var total int64
+
+	where, args := riwayatFilter(ctx, params)
+
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s`, ur.tableName, where)
+	if err := ur.db.QueryRowContext(ctx, query, args...).Scan(&total); err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	return total, nil
+}
+
+func riwayatFilter(ctx context.Context, params absensis.Riwayat) (string, []interface{}) {
+	where := []string{"userID = ?", "company_id = ?"}
+	args := []interface{}{params.UserID, tenant.ID(ctx)}
+
+	if params.From != "" {
+		where = append(where, "date >= ?")
+		args = append(args, params.From)
+	}
+
+	if params.To != "" {
+		where = append(where, "date <= ?")
+		args = append(args, params.To)
+	}
+
+	return strings.Join(where, " AND "), args
+}
+
+func (ur *absensiRepositoryImpl) FindByID(ctx context.Context, id int64) (absensis.Absensi, error) {
+	var absensi absensis.Absensi
+	var checkin, checkout sql.NullTime
+
+	query := fmt.Sprintf(`SELECT id, userID, name, checkin, checkout FROM %s WHERE id = ? AND company_id = ?`, ur.tableName)
+	stmt, err := ur.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return absensi, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	err = stmt.QueryRowContext(ctx, id, tenant.ID(ctx)).Scan(
+		&absensi.ID,
+		&absensi.UserID,
+		&absensi.Name,
+		&checkin,
+		&checkout,
+	)
+	if err == sql.ErrNoRows {
+		return absensis.Absensi{}, exception.ErrNotFound
+	}
+
+	if err != nil {
+		log.Println(err)
+		return absensis.Absensi{}, exception.ErrInternalServer
+	}
+
+	if checkin.Valid {
+		absensi.Checkin = checkin.Time
+	}
+	if checkout.Valid {
+		absensi.Checkout = checkout.Time
+	}
+
+	return absensi, nil
+}
+
+func (ur *absensiRepositoryImpl) FindShift(ctx context.Context, userID int64) (shifts.Shift, error) {
+	var shift shifts.Shift
+
+	query := fmt.Sprintf(`SELECT s.id, s.name, s.start_time, s.end_time, s.grace_minutes, s.work_days FROM %s s JOIN %s e ON e.shift_id = s.id WHERE e.id = ? AND e.company_id = ?`, constant.TableShift, constant.TableEmployee)
+	stmt, err := ur.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return shift, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	err = stmt.QueryRowContext(ctx, userID, tenant.ID(ctx)).Scan(
+		&shift.ID,
+		&shift.Name,
+		&shift.StartTime,
+		&shift.EndTime,
+		&shift.GraceMinutes,
+		&shift.WorkDays,
+	)
+	if err == sql.ErrNoRows {
+		return shifts.Shift{}, exception.ErrNotFound
+	}
+
+	if err != nil {
+		log.Println(err)
+		return shifts.Shift{}, exception.ErrInternalServer
+	}
+
+	return shift, nil
+}
+
+func (ur *absensiRepositoryImpl) FindApprovedOvertime(ctx context.Context, userID int64, date string) (float64, error) {
+	var hours float64
+
+	query := fmt.Sprintf(`SELECT COALESCE(SUM(planned_hours), 0) FROM %s WHERE userID = ? AND date = ? AND status = ?`, constant.TableOvertime)
+	stmt, err := ur.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	if err := stmt.QueryRowContext(ctx, userID, date, overtimes.StatusApproved).Scan(&hours); err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	return hours, nil
+}
+
 func (ur *absensiRepositoryImpl) SendMsg(ctx context.Context, params absensis.Absensi) error {
 	cfg := config.New()
 
@@ -148,7 +317,7 @@ func (ur *absensiRepositoryImpl) SendMsg(ctx context.Context, params absensis.Ab
 	defer ch.Close()
 
 	q, err := ch.QueueDeclare(
-		"Absensi",
+		constant.QueueAbsensi,
 		false,
 		false,
 		false,
@@ -194,7 +363,7 @@ func (ur *absensiRepositoryImpl) ReceiveMsg() (absensis.Absensi, error) {
 	defer ch.Close()
 
 	msg, err := ch.Consume(
-		"Absensi",
+		constant.QueueAbsensi,
 		"",
 		true,
 		false,
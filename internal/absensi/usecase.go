@@ -9,15 +9,18 @@ import (
 	"github.com/Risuii/config/jwt"
 	"github.com/Risuii/helpers/exception"
 	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/helpers/tenant"
 	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/shifts"
 	"github.com/Risuii/models/token"
+	"github.com/Risuii/models/users"
 )
 
 type (
 	AbsensiUseCase interface {
-		Checkin(ctx context.Context, userID int64, name string) (response.Response, token.Token)
+		Checkin(ctx context.Context, userID int64, name string, status string) (response.Response, token.Token)
 		Checkout(ctx context.Context, checkinID int64) response.Response
-		Riwayat(ctx context.Context, params absensis.Riwayat) response.Response
+		Riwayat(ctx context.Context, userID int64, role string, params absensis.Riwayat) response.Response
 	}
 
 	absensiUseCaseImpl struct {
@@ -31,11 +34,15 @@ func NewAbsensiUseCase(repo AbsensiRepository) AbsensiUseCase {
 	}
 }
 
-func (au *absensiUseCaseImpl) Checkin(ctx context.Context, userID int64, name string) (response.Response, token.Token) {
+func (au *absensiUseCaseImpl) Checkin(ctx context.Context, userID int64, name string, status string) (response.Response, token.Token) {
+	now := tenant.Now(ctx)
+
 	checkin := absensis.Absensi{
 		UserID:  userID,
 		Name:    name,
-		Checkin: time.Now(),
+		Checkin: now,
+		Date:    now,
+		Status:  status,
 	}
 
 	err := au.repository.SendMsg(ctx, checkin)
@@ -57,6 +64,8 @@ func (au *absensiUseCaseImpl) Checkin(ctx context.Context, userID int64, name st
 	claims := &jwt.JWTclaim{
 		ID:        userID,
 		CheckinID: ID,
+		CompanyID: tenant.ID(ctx),
+		Timezone:  tenant.Location(ctx).String(),
 		StandardClaims: newJWT.StandardClaims{
 			IssuedAt:  time.Now().Unix(),
 			ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
@@ -80,11 +89,46 @@ func (au *absensiUseCaseImpl) Checkin(ctx context.Context, userID int64, name st
 }
 
 func (au *absensiUseCaseImpl) Checkout(ctx context.Context, checkinID int64) response.Response {
+	absensi, err := au.repository.FindByID(ctx, checkinID)
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
 	checkin := absensis.Absensi{
-		Checkout: time.Now(),
+		Checkout: tenant.Now(ctx),
 	}
 
-	err := au.repository.Checkout(ctx, checkinID, checkin)
+	shift, err := au.repository.FindShift(ctx, absensi.UserID)
+	if err != nil && err != exception.ErrNotFound {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if err == nil {
+		// the checkin is read back in the database location, shifts and
+		// overtime dates are in the company's
+		checkedIn := absensi.Checkin.In(tenant.Location(ctx))
+
+		overtime := overtimeMinutes(shift, checkedIn, checkin.Checkout)
+		if overtime > 0 {
+			hours, err := au.repository.FindApprovedOvertime(ctx, absensi.UserID, checkedIn.Format("2006-01-02"))
+			if err != nil {
+				return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+			}
+
+			approved := int(hours * 60)
+			if approved > overtime {
+				approved = overtime
+			}
+
+			checkin.OvertimeMinutes = overtime
+			checkin.ApprovedOvertimeMinutes = approved
+		}
+	}
+
+	err = au.repository.Checkout(ctx, checkinID, checkin)
 	if err == exception.ErrNotFound {
 		return response.Error(response.StatusNotFound, exception.ErrNotFound)
 	}
@@ -97,9 +141,20 @@ func (au *absensiUseCaseImpl) Checkout(ctx context.Context, checkinID int64) res
 	return response.Success(response.StatusOK, msg)
 }
 
-func (au *absensiUseCaseImpl) Riwayat(ctx context.Context, params absensis.Riwayat) response.Response {
+// Riwayat returns the attendance history of the authenticated user. Only
+// admins may read another employee's history by passing their ID.
+func (au *absensiUseCaseImpl) Riwayat(ctx context.Context, userID int64, role string, params absensis.Riwayat) response.Response {
+	if params.UserID != 0 && params.UserID != userID && role != users.RoleAdmin {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	if params.UserID == 0 {
+		params.UserID = userID
+	}
+
+	params.Pagination = params.Pagination.Normalize()
 
-	absensi, err := au.repository.Riwayat(ctx, params.Name)
+	absensi, err := au.repository.Riwayat(ctx, params)
 
 	if err == exception.ErrNotFound {
 		return response.Error(response.StatusNotFound, exception.ErrNotFound)
@@ -109,5 +164,42 @@ func (au *absensiUseCaseImpl) Riwayat(ctx context.Context, params absensis.Riway
 		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
 	}
 
-	return response.Success(response.StatusOK, absensi)
+	total, err := au.repository.CountRiwayat(ctx, params)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	page := response.Page{
+		Limit: params.Limit,
+		Sort:  params.Sort,
+		Total: total,
+	}
+
+	if len(absensi) == params.Limit {
+		page.NextCursor = absensi[len(absensi)-1].ID
+	}
+
+	return response.SuccessWithPage(response.StatusOK, absensi, page)
+}
+
+// overtimeMinutes returns how long checkout is past the end of the shift the
+// employee checked in for. Shifts ending before they start run past midnight.
+func overtimeMinutes(shift shifts.Shift, checkin time.Time, checkout time.Time) int {
+	start, errStart := time.Parse("15:04:05", shift.StartTime)
+	end, errEnd := time.Parse("15:04:05", shift.EndTime)
+	if errStart != nil || errEnd != nil {
+		return 0
+	}
+
+	shiftEnd := time.Date(checkin.Year(), checkin.Month(), checkin.Day(), end.Hour(), end.Minute(), end.Second(), 0, checkin.Location())
+	if !end.After(start) {
+		shiftEnd = shiftEnd.Add(24 * time.Hour)
+	}
+
+	minutes := int(checkout.Sub(shiftEnd).Minutes())
+	if minutes < 0 {
+		return 0
+	}
+
+	return minutes
 }
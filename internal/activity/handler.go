@@ -6,11 +6,11 @@ import (
 
 	"net/http"
 
-	newJWT "github.com/dgrijalva/jwt-go"
 	"github.com/go-playground/validator/v10"
 	"github.com/gorilla/mux"
 
 	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/exception"
 	"github.com/Risuii/helpers/response"
 	"github.com/Risuii/models/activitys"
 )
@@ -29,29 +29,29 @@ func NewActivityHandler(router *mux.Router, validate *validator.Validate, usecas
 	api := router.PathPrefix("/account").Subrouter()
 
 	api.HandleFunc("/activity", handler.AddActivity).Methods(http.MethodPost)
+	api.HandleFunc("/activity/bulk", handler.AddActivities).Methods(http.MethodPost)
+	api.HandleFunc("/activity/standup", handler.Standup).Methods(http.MethodPost)
+	api.HandleFunc("/activity/standup", handler.FindStandup).Methods(http.MethodGet)
+	api.HandleFunc("/activity/pending", handler.Pending).Methods(http.MethodGet)
+	api.HandleFunc("/activity/review", handler.Review).Methods(http.MethodPatch)
 	api.HandleFunc("/activity/{id}", handler.UpdateActivity).Methods(http.MethodPatch)
 	api.HandleFunc("/activity/{id}", handler.DeleteActivity).Methods(http.MethodDelete)
 	api.HandleFunc("/activity/riwayat", handler.ReadActivity).Methods(http.MethodGet)
+	api.HandleFunc("/activity/{id}/restore", handler.RestoreActivity).Methods(http.MethodPost)
+	api.HandleFunc("/activity/{id}/history", handler.History).Methods(http.MethodGet)
 }
 
 func (handler *ActivityHandler) AddActivity(w http.ResponseWriter, r *http.Request) {
 	var res response.Response
 	var userInput activitys.Activity
 
-	c, err := r.Cookie("checkin-token")
+	claims, err := jwt.FromCookie(r, "checkin-token")
 	if err != nil {
 		res = response.Error(response.StatusUnauthorized, err)
 		res.JSON(w)
 		return
 	}
 
-	tokenString := c.Value
-	claims := &jwt.JWTclaim{}
-
-	newJWT.ParseWithClaims(tokenString, claims, func(t *newJWT.Token) (interface{}, error) {
-		return jwt.JWT_KEY, nil
-	})
-
 	ctx := r.Context()
 
 	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
@@ -67,7 +67,7 @@ func (handler *ActivityHandler) AddActivity(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	res = handler.UseCase.AddActivity(ctx, claims.ID, userInput)
+	res = handler.UseCase.AddActivity(ctx, claims.ID, claims.CheckinID, userInput)
 
 	res.JSON(w)
 }
@@ -78,20 +78,13 @@ func (handler *ActivityHandler) UpdateActivity(w http.ResponseWriter, r *http.Re
 
 	ctx := r.Context()
 
-	c, err := r.Cookie("checkin-token")
+	claims, err := jwt.FromCookie(r, "checkin-token")
 	if err != nil {
 		res = response.Error(response.StatusUnauthorized, err)
 		res.JSON(w)
 		return
 	}
 
-	tokenString := c.Value
-	claims := &jwt.JWTclaim{}
-
-	newJWT.ParseWithClaims(tokenString, claims, func(t *newJWT.Token) (interface{}, error) {
-		return jwt.JWT_KEY, nil
-	})
-
 	params := mux.Vars(r)
 	id, _ := strconv.ParseInt(params["id"], 10, 64)
 
@@ -118,26 +111,25 @@ func (handler *ActivityHandler) ReadActivity(w http.ResponseWriter, r *http.Requ
 	var userInput activitys.DateReq
 
 	ctx := r.Context()
-	c, err := r.Cookie("token")
+	claims, err := jwt.FromCookie(r, "token")
 	if err != nil {
 		res = response.Error(response.StatusUnauthorized, err)
 		res.JSON(w)
 		return
 	}
 
-	tokenString := c.Value
-	claims := &jwt.JWTclaim{}
-
-	newJWT.ParseWithClaims(tokenString, claims, func(t *newJWT.Token) (interface{}, error) {
-		return jwt.JWT_KEY, nil
-	})
-
 	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
 		res = response.Error(response.StatusUnprocessableEntity, err)
 		res.JSON(w)
 		return
 	}
 
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
 	res = handler.UseCase.Riwayat(ctx, claims.ID, userInput)
 
 	res.JSON(w)
@@ -147,24 +139,227 @@ func (handler *ActivityHandler) DeleteActivity(w http.ResponseWriter, r *http.Re
 	var res response.Response
 	ctx := r.Context()
 
-	c, err := r.Cookie("checkin-token")
+	claims, err := jwt.FromCookie(r, "checkin-token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.ParseInt(params["id"], 10, 64)
+
+	res = handler.UseCase.DeleteActivity(ctx, id, claims.ID)
+
+	res.JSON(w)
+}
+
+func (handler *ActivityHandler) RestoreActivity(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
 	if err != nil {
 		res = response.Error(response.StatusUnauthorized, err)
 		res.JSON(w)
 		return
 	}
 
-	tokenString := c.Value
-	claims := &jwt.JWTclaim{}
+	params := mux.Vars(r)
+	id, _ := strconv.ParseInt(params["id"], 10, 64)
+
+	res = handler.UseCase.RestoreActivity(ctx, id, claims.ID)
+
+	res.JSON(w)
+}
+
+func (handler *ActivityHandler) History(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	ctx := r.Context()
 
-	newJWT.ParseWithClaims(tokenString, claims, func(t *newJWT.Token) (interface{}, error) {
-		return jwt.JWT_KEY, nil
-	})
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
 
 	params := mux.Vars(r)
 	id, _ := strconv.ParseInt(params["id"], 10, 64)
 
-	res = handler.UseCase.DeleteActivity(ctx, id, claims.ID)
+	res = handler.UseCase.History(ctx, id, claims.ID, claims.Role)
+
+	res.JSON(w)
+}
+
+func (handler *ActivityHandler) Pending(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput activitys.PendingReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Pending(ctx, claims.ID, claims.Role, userInput)
+
+	res.JSON(w)
+}
+
+func (handler *ActivityHandler) Review(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput activitys.ReviewReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Review(ctx, claims.ID, claims.Role, userInput)
+
+	res.JSON(w)
+}
+
+// AddActivities validates every activity on its own, the errors of all
+// invalid items are returned together.
+func (handler *ActivityHandler) AddActivities(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput activitys.BulkReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "checkin-token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	errs := []activitys.ItemError{}
+	for i, activity := range userInput.Activities {
+		if err := handler.Validate.StructCtx(ctx, activity); err != nil {
+			errs = append(errs, activitys.ItemError{
+				Index:  i,
+				Status: response.StatusBadRequest,
+				Reason: err.Error(),
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		res = response.ErrorWithData(response.StatusBadRequest, exception.ErrBadRequest, activitys.BulkResult{
+			Activities: []activitys.Activity{},
+			Errors:     errs,
+		})
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.AddActivities(ctx, claims.ID, claims.CheckinID, userInput.Activities)
+
+	res.JSON(w)
+}
+
+func (handler *ActivityHandler) Standup(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput activitys.StandupReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "checkin-token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Standup(ctx, claims.ID, claims.CheckinID, userInput)
+
+	res.JSON(w)
+}
+
+func (handler *ActivityHandler) FindStandup(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput activitys.StandupFilter
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.FindStandup(ctx, claims.ID, userInput)
 
 	res.JSON(w)
 }
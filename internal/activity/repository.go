@@ -5,19 +5,36 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/Risuii/helpers/constant"
 	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
 	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/paginations"
 )
 
 type (
 	ActivityRepository interface {
 		AddActivity(ctx context.Context, userID int64, params activitys.Activity) (int64, error)
-		UpdateActivity(ctx context.Context, id int64, params activitys.Activity) error
+		AddActivities(ctx context.Context, userID int64, params []activitys.Activity) ([]int64, error)
+		UpdateActivity(ctx context.Context, id int64, params activitys.Activity, revision activitys.Revision) error
 		FindByID(ctx context.Context, id int64) (activitys.Activity, error)
-		Delete(ctx context.Context, id int64) error
+		Delete(ctx context.Context, id int64, deletedAt time.Time) error
+		Restore(ctx context.Context, id int64) error
+		Revisions(ctx context.Context, activityID int64) ([]activitys.Revision, error)
 		Riwayat(ctx context.Context, userID int64, params activitys.DateReq) ([]activitys.Activity, error)
+		CountRiwayat(ctx context.Context, userID int64, params activitys.DateReq) (int64, error)
+		FindBySession(ctx context.Context, absenID int64) ([]activitys.Activity, error)
+		DailyTotals(ctx context.Context, userID int64, params activitys.DateReq) ([]activitys.DailyTotal, error)
+		ProjectTotals(ctx context.Context, userID int64, params activitys.DateReq) ([]activitys.ProjectTotal, error)
+		SetTags(ctx context.Context, activityID int64, tags []string) error
+		FindTags(ctx context.Context, activityIDs []int64) (map[int64][]string, error)
+		FindByIDs(ctx context.Context, ids []int64) ([]activitys.Activity, error)
+		Pending(ctx context.Context, params activitys.PendingReq) ([]activitys.Activity, error)
+		CountPending(ctx context.Context, params activitys.PendingReq) (int64, error)
+		Review(ctx context.Context, ids []int64, params activitys.Activity) error
 	}
 
 	activityRepositoryImpl struct {
@@ -34,7 +51,7 @@ func NewActivityRepositoryImpl(db *sql.DB, tableName string) ActivityRepository
 }
 
 func (ar *activityRepositoryImpl) AddActivity(ctx context.Context, userID int64, params activitys.Activity) (int64, error) {
-	query := fmt.Sprintf(`INSERT INTO %s (userID, deskripsi, created_at) VALUES (?, ?, ?)`, ar.TableName)
+	query := fmt.Sprintf(`INSERT INTO %s (userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, company_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, ar.TableName)
 	stmt, err := ar.DB.PrepareContext(ctx, query)
 	if err != nil {
 		log.Println(err)
@@ -45,8 +62,14 @@ func (ar *activityRepositoryImpl) AddActivity(ctx context.Context, userID int64,
 	result, err := stmt.ExecContext(
 		ctx,
 		userID,
+		params.AbsenID,
+		nullID(params.ProjectID),
 		params.Description,
+		nullTime(params.StartedAt),
+		nullTime(params.EndedAt),
+		params.Duration,
 		params.CreatedAt,
+		tenant.ID(ctx),
 	)
 
 	if err != nil {
@@ -64,20 +87,96 @@ func (ar *activityRepositoryImpl) AddActivity(ctx context.Context, userID int64,
 	return ID, nil
 }
 
-func (ar *activityRepositoryImpl) UpdateActivity(ctx context.Context, id int64, params activitys.Activity) error {
-	query := fmt.Sprintf(`UPDATE %s SET deskripsi = ?, update_at = ? WHERE id = %d`, ar.TableName, id)
-	stmt, err := ar.DB.PrepareContext(ctx, query)
+// AddActivities inserts the activities together with their tags in one
+// transaction and returns their IDs in the same order.
+func (ar *activityRepositoryImpl) AddActivities(ctx context.Context, userID int64, params []activitys.Activity) ([]int64, error) {
+	tx, err := ar.DB.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println(err)
+		return nil, exception.ErrInternalServer
+	}
+
+	defer tx.Rollback()
+
+	activityQuery := fmt.Sprintf(`INSERT INTO %s (userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, company_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, ar.TableName)
+	tagQuery := fmt.Sprintf(`INSERT INTO %s (activityID, tag) VALUES (?, ?)`, constant.TableTag)
+
+	ids := make([]int64, 0, len(params))
+	for _, activity := range params {
+		result, err := tx.ExecContext(
+			ctx,
+			activityQuery,
+			userID,
+			activity.AbsenID,
+			nullID(activity.ProjectID),
+			activity.Description,
+			nullTime(activity.StartedAt),
+			nullTime(activity.EndedAt),
+			activity.Duration,
+			activity.CreatedAt,
+			tenant.ID(ctx),
+		)
+		if err != nil {
+			log.Println(err)
+			return nil, exception.ErrInternalServer
+		}
+
+		ID, err := result.LastInsertId()
+		if err != nil {
+			log.Println(err)
+			return nil, exception.ErrInternalServer
+		}
+
+		for _, tag := range activity.Tags {
+			if _, err := tx.ExecContext(ctx, tagQuery, ID, tag); err != nil {
+				log.Println(err)
+				return nil, exception.ErrInternalServer
+			}
+		}
+
+		ids = append(ids, ID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, exception.ErrInternalServer
+	}
+
+	return ids, nil
+}
+
+// UpdateActivity stores the revision holding the previous description, the
+// new values and the new tags in one transaction. Deleted and approved
+// activities can't be updated, a rejected one goes back to pending.
+func (ar *activityRepositoryImpl) UpdateActivity(ctx context.Context, id int64, params activitys.Activity, revision activitys.Revision) error {
+	tx, err := ar.DB.BeginTx(ctx, nil)
 	if err != nil {
 		log.Println(err)
 		return exception.ErrInternalServer
 	}
 
-	defer stmt.Close()
+	defer tx.Rollback()
 
-	result, err := stmt.ExecContext(
+	query := fmt.Sprintf(`INSERT INTO %s (activityID, deskripsi, edited_by, edited_at) VALUES (?, ?, ?, ?)`, constant.TableRevision)
+	if _, err := tx.ExecContext(ctx, query, id, revision.Description, revision.EditedBy, revision.EditedAt); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	query = fmt.Sprintf(`UPDATE %s SET projectID = ?, deskripsi = ?, started_at = ?, ended_at = ?, duration_minutes = ?, update_at = ?, status = ?, review_comment = NULL, reviewed_by = NULL, reviewed_at = NULL WHERE id = ? AND company_id = ? AND deleted_at IS NULL AND status <> ?`, ar.TableName)
+	result, err := tx.ExecContext(
 		ctx,
+		query,
+		nullID(params.ProjectID),
 		params.Description,
+		nullTime(params.StartedAt),
+		nullTime(params.EndedAt),
+		params.Duration,
 		params.UpdateAt,
+		activitys.StatusPending,
+		id,
+		tenant.ID(ctx),
+		activitys.StatusApproved,
 	)
 
 	if err != nil {
@@ -91,13 +190,22 @@ func (ar *activityRepositoryImpl) UpdateActivity(ctx context.Context, id int64,
 		return exception.ErrNotFound
 	}
 
+	if err := replaceTags(ctx, tx, id, params.Tags); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
 	return nil
 }
 
 func (ar *activityRepositoryImpl) FindByID(ctx context.Context, id int64) (activitys.Activity, error) {
 	activity := activitys.Activity{}
 
-	query := fmt.Sprintf(`SELECT id, userID, deskripsi, created_at, update_at FROM %s WHERE id = ?`, ar.TableName)
+	query := fmt.Sprintf(`SELECT id, userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, update_at, deleted_at, status, review_comment, reviewed_by, reviewed_at FROM %s WHERE id = ? AND company_id = ?`, ar.TableName)
 	stmt, err := ar.DB.PrepareContext(ctx, query)
 	if err != nil {
 		log.Println(err)
@@ -106,15 +214,12 @@ func (ar *activityRepositoryImpl) FindByID(ctx context.Context, id int64) (activ
 
 	defer stmt.Close()
 
-	row := stmt.QueryRowContext(ctx, id)
+	row := stmt.QueryRowContext(ctx, id, tenant.ID(ctx))
 
-	err = row.Scan(
-		&activity.ID,
-		&activity.UserID,
-		&activity.Description,
-		&activity.CreatedAt,
-		&activity.UpdateAt,
-	)
+	activity, err = scanActivity(row)
+	if err == sql.ErrNoRows {
+		return activitys.Activity{}, exception.ErrNotFound
+	}
 
 	if err != nil {
 		log.Println(err)
@@ -124,8 +229,10 @@ func (ar *activityRepositoryImpl) FindByID(ctx context.Context, id int64) (activ
 	return activity, nil
 }
 
-func (ar *activityRepositoryImpl) Delete(ctx context.Context, id int64) error {
-	query := fmt.Sprintf(`DELETE FROM %s WHERE id = %d`, ar.TableName, id)
+// Delete only marks the activity as deleted, it stays restorable and keeps
+// its revisions. Approved activities can't be deleted.
+func (ar *activityRepositoryImpl) Delete(ctx context.Context, id int64, deletedAt time.Time) error {
+	query := fmt.Sprintf(`UPDATE %s SET deleted_at = ? WHERE id = ? AND company_id = ? AND deleted_at IS NULL AND status <> ?`, ar.TableName)
 	stmt, err := ar.DB.PrepareContext(ctx, query)
 	if err != nil {
 		log.Println(err)
@@ -135,6 +242,10 @@ func (ar *activityRepositoryImpl) Delete(ctx context.Context, id int64) error {
 
 	result, err := stmt.ExecContext(
 		ctx,
+		deletedAt,
+		id,
+		tenant.ID(ctx),
+		activitys.StatusApproved,
 	)
 	if err != nil {
 		log.Println(err)
@@ -149,71 +260,576 @@ func (ar *activityRepositoryImpl) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
+func (ar *activityRepositoryImpl) Restore(ctx context.Context, id int64) error {
+	query := fmt.Sprintf(`UPDATE %s SET deleted_at = NULL WHERE id = ? AND company_id = ? AND deleted_at IS NOT NULL`, ar.TableName)
+	stmt, err := ar.DB.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		id,
+		tenant.ID(ctx),
+	)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected < 1 {
+		return exception.ErrNotFound
+	}
+
+	return nil
+}
+
+// Revisions returns the previous descriptions of the activity, oldest first.
+func (ar *activityRepositoryImpl) Revisions(ctx context.Context, activityID int64) ([]activitys.Revision, error) {
+	revision := []activitys.Revision{}
+
+	query := fmt.Sprintf(`SELECT id, activityID, deskripsi, edited_by, edited_at FROM %s WHERE activityID = ? ORDER BY edited_at, id`, constant.TableRevision)
+	rows, err := ar.DB.QueryContext(ctx, query, activityID)
+	if err != nil {
+		log.Println(err)
+		return revision, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var r activitys.Revision
+		var description sql.NullString
+		if err := rows.Scan(
+			&r.ID,
+			&r.ActivityID,
+			&description,
+			&r.EditedBy,
+			&r.EditedAt,
+		); err != nil {
+			log.Println(err)
+			return revision, exception.ErrInternalServer
+		}
+		r.Description = description.String
+		revision = append(revision, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return revision, exception.ErrInternalServer
+	}
+
+	return revision, nil
+}
+
 func (ar *activityRepositoryImpl) Riwayat(ctx context.Context, userID int64, params activitys.DateReq) ([]activitys.Activity, error) {
 	activity := []activitys.Activity{}
 
-	if params.To == "" {
-		now := time.Now()
-		rows, err := ar.DB.Query(fmt.Sprintf(`SELECT id, userID, deskripsi, created_at, update_at FROM '%s' WHERE DATE(created_at) BETWEEN '%s' AND '%s' ORDER BY created_at asc`, ar.TableName, params.From, now.Format("2006-01-02")))
+	where, args := riwayatFilter(ctx, userID, params)
+
+	if params.Cursor > 0 {
+		if params.Sort == paginations.SortDesc {
+			where += " AND id < ?"
+		} else {
+			where += " AND id > ?"
+		}
+		args = append(args, params.Cursor)
+	}
+
+	order := "ASC"
+	if params.Sort == paginations.SortDesc {
+		order = "DESC"
+	}
+
+	args = append(args, params.Limit)
+
+	query := fmt.Sprintf(`SELECT id, userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, update_at, deleted_at, status, review_comment, reviewed_by, reviewed_at FROM %s WHERE %s ORDER BY id %s LIMIT ?`, ar.TableName, where, order)
+	rows, err := ar.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return activity, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		c, err := scanActivity(rows)
 		if err != nil {
 			log.Println(err)
 			return activity, exception.ErrInternalServer
 		}
+		activity = append(activity, c)
+	}
 
-		defer rows.Close()
-
-		for rows.Next() {
-			var c activitys.Activity
-			if err := rows.Scan(
-				&c.ID,
-				&c.UserID,
-				&c.Description,
-				&c.CreatedAt,
-				&c.UpdateAt,
-			); err != nil {
-				log.Println(err)
-				return activity, exception.ErrInternalServer
-			}
-			activity = append(activity, c)
-		}
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return activity, exception.ErrInternalServer
+	}
+
+	return activity, nil
+}
+
+func (ar *activityRepositoryImpl) CountRiwayat(ctx context.Context, userID int64, params activitys.DateReq) (int64, error) {
+	var total int64
+
+	where, args := riwayatFilter(ctx, userID, params)
+
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s`, ar.TableName, where)
+	if err := ar.DB.QueryRowContext(ctx, query, args...).Scan(&total); err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	return total, nil
+}
+
+// FindBySession returns every activity logged during the absen session,
+// which new time slots are checked against for overlaps.
+func (ar *activityRepositoryImpl) FindBySession(ctx context.Context, absenID int64) ([]activitys.Activity, error) {
+	activity := []activitys.Activity{}
+
+	query := fmt.Sprintf(`SELECT id, userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, update_at, deleted_at, status, review_comment, reviewed_by, reviewed_at FROM %s WHERE absenID = ? AND company_id = ? AND deleted_at IS NULL ORDER BY started_at`, ar.TableName)
+	rows, err := ar.DB.QueryContext(ctx, query, absenID, tenant.ID(ctx))
+	if err != nil {
+		log.Println(err)
+		return activity, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
 
-		if err = rows.Err(); err != nil {
+	for rows.Next() {
+		c, err := scanActivity(rows)
+		if err != nil {
 			log.Println(err)
 			return activity, exception.ErrInternalServer
 		}
+		activity = append(activity, c)
+	}
 
-		return activity, nil
-	} else if params.To != "" {
-		rows, err := ar.DB.Query(fmt.Sprintf(`SELECT id, userID, deskripsi, created_at, update_at FROM %s WHERE DATE(created_at) BETWEEN '%s' AND '%s' ORDER BY created_at asc`, ar.TableName, params.From, params.To))
-		if err != nil {
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return activity, exception.ErrInternalServer
+	}
+
+	return activity, nil
+}
+
+// DailyTotals sums the tracked minutes per day over the same filter as
+// Riwayat. Timed activities count on the day they started.
+func (ar *activityRepositoryImpl) DailyTotals(ctx context.Context, userID int64, params activitys.DateReq) ([]activitys.DailyTotal, error) {
+	totals := []activitys.DailyTotal{}
+
+	where, args := riwayatFilter(ctx, userID, params)
+
+	query := fmt.Sprintf(`SELECT DATE(COALESCE(started_at, created_at)) AS day, SUM(duration_minutes), COUNT(*) FROM %s WHERE %s GROUP BY day ORDER BY day`, ar.TableName, where)
+	rows, err := ar.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return totals, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var t activitys.DailyTotal
+		var day time.Time
+		if err := rows.Scan(
+			&day,
+			&t.Minutes,
+			&t.Activities,
+		); err != nil {
 			log.Println(err)
-			return activity, exception.ErrInternalServer
+			return totals, exception.ErrInternalServer
 		}
+		t.Date = day.Format("2006-01-02")
+		totals = append(totals, t)
+	}
 
-		defer rows.Close()
-
-		for rows.Next() {
-			var c activitys.Activity
-			if err := rows.Scan(
-				&c.ID,
-				&c.UserID,
-				&c.Description,
-				&c.CreatedAt,
-				&c.UpdateAt,
-			); err != nil {
-				log.Println(err)
-				return activity, exception.ErrInternalServer
-			}
-			activity = append(activity, c)
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return totals, exception.ErrInternalServer
+	}
+
+	return totals, nil
+}
+
+// ProjectTotals sums the tracked minutes per project over the same filter as
+// Riwayat, the basis for billing clients.
+func (ar *activityRepositoryImpl) ProjectTotals(ctx context.Context, userID int64, params activitys.DateReq) ([]activitys.ProjectTotal, error) {
+	totals := []activitys.ProjectTotal{}
+
+	where, args := riwayatFilter(ctx, userID, params)
+
+	query := fmt.Sprintf(`SELECT t.projectID, p.code, p.name, t.minutes, t.activities FROM (SELECT projectID, SUM(duration_minutes) AS minutes, COUNT(*) AS activities FROM %s WHERE %s GROUP BY projectID) t LEFT JOIN %s p ON p.id = t.projectID ORDER BY p.code`, ar.TableName, where, constant.TableProject)
+	rows, err := ar.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return totals, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var t activitys.ProjectTotal
+		var projectID sql.NullInt64
+		var code, name sql.NullString
+		if err := rows.Scan(
+			&projectID,
+			&code,
+			&name,
+			&t.Minutes,
+			&t.Activities,
+		); err != nil {
+			log.Println(err)
+			return totals, exception.ErrInternalServer
 		}
+		t.ProjectID = projectID.Int64
+		t.Code = code.String
+		t.Name = name.String
+		totals = append(totals, t)
+	}
 
-		if err = rows.Err(); err != nil {
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return totals, exception.ErrInternalServer
+	}
+
+	return totals, nil
+}
+
+// SetTags replaces the tags of the activity.
+func (ar *activityRepositoryImpl) SetTags(ctx context.Context, activityID int64, tags []string) error {
+	tx, err := ar.DB.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer tx.Rollback()
+
+	if err := replaceTags(ctx, tx, activityID, tags); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	return nil
+}
+
+func replaceTags(ctx context.Context, tx *sql.Tx, activityID int64, tags []string) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE activityID = ?`, constant.TableTag)
+	if _, err := tx.ExecContext(ctx, query, activityID); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	query = fmt.Sprintf(`INSERT INTO %s (activityID, tag) VALUES (?, ?)`, constant.TableTag)
+	for _, tag := range tags {
+		if _, err := tx.ExecContext(ctx, query, activityID, tag); err != nil {
+			log.Println(err)
+			return exception.ErrInternalServer
+		}
+	}
+
+	return nil
+}
+
+// FindTags returns the tags of each of the activities, activities without
+// tags are left out of the map.
+func (ar *activityRepositoryImpl) FindTags(ctx context.Context, activityIDs []int64) (map[int64][]string, error) {
+	tags := map[int64][]string{}
+	if len(activityIDs) == 0 {
+		return tags, nil
+	}
+
+	placeholder, args := inClause(activityIDs)
+
+	query := fmt.Sprintf(`SELECT activityID, tag FROM %s WHERE activityID IN (%s) ORDER BY activityID, tag`, constant.TableTag, placeholder)
+	rows, err := ar.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return tags, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var activityID int64
+		var tag string
+		if err := rows.Scan(&activityID, &tag); err != nil {
+			log.Println(err)
+			return tags, exception.ErrInternalServer
+		}
+		tags[activityID] = append(tags[activityID], tag)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return tags, exception.ErrInternalServer
+	}
+
+	return tags, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// FindByIDs returns the activities that still exist, deleted ones included,
+// missing IDs are left out.
+func (ar *activityRepositoryImpl) FindByIDs(ctx context.Context, ids []int64) ([]activitys.Activity, error) {
+	if len(ids) == 0 {
+		return []activitys.Activity{}, nil
+	}
+
+	placeholder, args := inClause(ids)
+
+	query := fmt.Sprintf(`SELECT id, userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, update_at, deleted_at, status, review_comment, reviewed_by, reviewed_at FROM %s WHERE id IN (%s) AND company_id = ? ORDER BY id`, ar.TableName, placeholder)
+
+	return ar.findAll(ctx, query, append(args, tenant.ID(ctx))...)
+}
+
+// Pending returns the activities waiting for a review, oldest first unless
+// the pagination asks otherwise.
+func (ar *activityRepositoryImpl) Pending(ctx context.Context, params activitys.PendingReq) ([]activitys.Activity, error) {
+	where, args := pendingFilter(ctx, params)
+
+	if params.Cursor > 0 {
+		if params.Sort == paginations.SortDesc {
+			where += " AND id < ?"
+		} else {
+			where += " AND id > ?"
+		}
+		args = append(args, params.Cursor)
+	}
+
+	order := "ASC"
+	if params.Sort == paginations.SortDesc {
+		order = "DESC"
+	}
+
+	args = append(args, params.Limit)
+
+	query := fmt.Sprintf(`SELECT id, userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, update_at, deleted_at, status, review_comment, reviewed_by, reviewed_at FROM %s WHERE %s ORDER BY id %s LIMIT ?`, ar.TableName, where, order)
+
+	return ar.findAll(ctx, query, args...)
+}
+
+func (ar *activityRepositoryImpl) CountPending(ctx context.Context, params activitys.PendingReq) (int64, error) {
+	var total int64
+
+	where, args := pendingFilter(ctx, params)
+
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s`, ar.TableName, where)
+	if err := ar.DB.QueryRowContext(ctx, query, args...).Scan(&total); err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	return total, nil
+}
+
+// Review sets the status of every activity in ids at once. Either all of them
+// are still pending and get reviewed, or none is changed and ErrConflicted is
+// returned.
+func (ar *activityRepositoryImpl) Review(ctx context.Context, ids []int64, params activitys.Activity) error {
+	tx, err := ar.DB.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer tx.Rollback()
+
+	placeholder, idArgs := inClause(ids)
+
+	args := []interface{}{
+		params.Status,
+		params.ReviewComment,
+		params.ReviewedBy,
+		params.ReviewedAt,
+	}
+	args = append(args, idArgs...)
+	args = append(args, tenant.ID(ctx), activitys.StatusPending)
+
+	query := fmt.Sprintf(`UPDATE %s SET status = ?, review_comment = ?, reviewed_by = ?, reviewed_at = ? WHERE id IN (%s) AND company_id = ? AND status = ? AND deleted_at IS NULL`, ar.TableName, placeholder)
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected != int64(len(ids)) {
+		return exception.ErrConflicted
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	return nil
+}
+
+func (ar *activityRepositoryImpl) findAll(ctx context.Context, query string, args ...interface{}) ([]activitys.Activity, error) {
+	activity := []activitys.Activity{}
+
+	rows, err := ar.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return activity, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		c, err := scanActivity(rows)
+		if err != nil {
 			log.Println(err)
 			return activity, exception.ErrInternalServer
 		}
+		activity = append(activity, c)
+	}
 
-		return activity, nil
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return activity, exception.ErrInternalServer
 	}
 
 	return activity, nil
 }
+
+func scanActivity(row scanner) (activitys.Activity, error) {
+	var a activitys.Activity
+	var absenID, projectID sql.NullInt64
+	var startedAt, endedAt, deletedAt, reviewedAt sql.NullTime
+	var reviewComment sql.NullString
+	var reviewedBy sql.NullInt64
+
+	err := row.Scan(
+		&a.ID,
+		&a.UserID,
+		&absenID,
+		&projectID,
+		&a.Description,
+		&startedAt,
+		&endedAt,
+		&a.Duration,
+		&a.CreatedAt,
+		&a.UpdateAt,
+		&deletedAt,
+		&a.Status,
+		&reviewComment,
+		&reviewedBy,
+		&reviewedAt,
+	)
+	if err != nil {
+		return activitys.Activity{}, err
+	}
+
+	a.AbsenID = absenID.Int64
+	a.ProjectID = projectID.Int64
+	a.StartedAt = startedAt.Time
+	a.EndedAt = endedAt.Time
+	a.DeletedAt = deletedAt.Time
+	a.ReviewComment = reviewComment.String
+	a.ReviewedBy = reviewedBy.Int64
+	a.ReviewedAt = reviewedAt.Time
+
+	return a, nil
+}
+
+func nullID(id int64) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
+func nullTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
+
+func inClause(ids []int64) (string, []interface{}) {
+	args := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	return strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", "), args
+}
+
+func riwayatFilter(ctx context.Context, userID int64, params activitys.DateReq) (string, []interface{}) {
+	where := []string{"userID = ?", "company_id = ?", "deleted_at IS NULL"}
+	args := []interface{}{userID, tenant.ID(ctx)}
+
+	if params.From != "" {
+		where = append(where, "DATE(created_at) >= ?")
+		args = append(args, params.From)
+	}
+
+	if params.To != "" {
+		where = append(where, "DATE(created_at) <= ?")
+		args = append(args, params.To)
+	}
+
+	if params.AbsenID != 0 {
+		where = append(where, "absenID = ?")
+		args = append(args, params.AbsenID)
+	}
+
+	if params.ProjectID != 0 {
+		where = append(where, "projectID = ?")
+		args = append(args, params.ProjectID)
+	}
+
+	if params.Tag != "" {
+		where = append(where, fmt.Sprintf("id IN (SELECT activityID FROM %s WHERE tag = ?)", constant.TableTag))
+		args = append(args, params.Tag)
+	}
+
+	if params.Status != "" {
+		where = append(where, "status = ?")
+		args = append(args, params.Status)
+	}
+
+	return strings.Join(where, " AND "), args
+}
+
+func pendingFilter(ctx context.Context, params activitys.PendingReq) (string, []interface{}) {
+	where := []string{"status = ?", "company_id = ?", "deleted_at IS NULL"}
+	args := []interface{}{activitys.StatusPending, tenant.ID(ctx)}
+
+	if params.UserID != 0 {
+		where = append(where, "userID = ?")
+		args = append(args, params.UserID)
+	}
+
+	if len(params.UserIDs) > 0 {
+		placeholder, ids := inClause(params.UserIDs)
+		where = append(where, fmt.Sprintf("userID IN (%s)", placeholder))
+		args = append(args, ids...)
+	}
+
+	if params.From != "" {
+		where = append(where, "DATE(created_at) >= ?")
+		args = append(args, params.From)
+	}
+
+	if params.To != "" {
+		where = append(where, "DATE(created_at) <= ?")
+		args = append(args, params.To)
+	}
+
+	return strings.Join(where, " AND "), args
+}
@@ -2,39 +2,68 @@ package activity
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/Risuii/helpers/exception"
 	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/absensi"
+	"github.com/Risuii/internal/org"
+	"github.com/Risuii/internal/project"
+	"github.com/Risuii/models/absensis"
 	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/paginations"
+	"github.com/Risuii/models/users"
 )
 
 type (
 	ActivityUseCase interface {
-		AddActivity(ctx context.Context, userID int64, params activitys.Activity) response.Response
+		AddActivity(ctx context.Context, userID int64, checkinID int64, params activitys.Activity) response.Response
 		UpdateActivity(ctx context.Context, id int64, userID int64, params activitys.Activity) response.Response
 		DeleteActivity(ctx context.Context, id int64, userID int64) response.Response
+		RestoreActivity(ctx context.Context, id int64, userID int64) response.Response
+		History(ctx context.Context, id int64, userID int64, role string) response.Response
 		Riwayat(ctx context.Context, userID int64, params activitys.DateReq) response.Response
+		AddActivities(ctx context.Context, userID int64, checkinID int64, params []activitys.Activity) response.Response
+		Standup(ctx context.Context, userID int64, checkinID int64, params activitys.StandupReq) response.Response
+		FindStandup(ctx context.Context, userID int64, params activitys.StandupFilter) response.Response
+		Pending(ctx context.Context, reviewerID int64, role string, params activitys.PendingReq) response.Response
+		Review(ctx context.Context, reviewerID int64, role string, params activitys.ReviewReq) response.Response
 	}
 
 	activityUseCaseImpl struct {
-		repository ActivityRepository
+		repository        ActivityRepository
+		absensiRepository absensi.AbsensiRepository
+		projectRepository project.ProjectRepository
+		chain             org.Chain
 	}
 )
 
-func NewActivityUseCaseImpl(repo ActivityRepository) ActivityUseCase {
+func NewActivityUseCaseImpl(repo ActivityRepository, absensiRepo absensi.AbsensiRepository, projectRepo project.ProjectRepository, chain org.Chain) ActivityUseCase {
 	return &activityUseCaseImpl{
-		repository: repo,
+		repository:        repo,
+		absensiRepository: absensiRepo,
+		projectRepository: projectRepo,
+		chain:             chain,
 	}
 }
 
-func (au *activityUseCaseImpl) AddActivity(ctx context.Context, userID int64, params activitys.Activity) response.Response {
+// AddActivity records the activity against the absen session of the checkin
+// token, which must belong to userID and must not be checked out yet.
+func (au *activityUseCaseImpl) AddActivity(ctx context.Context, userID int64, checkinID int64, params activitys.Activity) response.Response {
+	session, res := au.openSession(ctx, userID, checkinID)
+	if res != nil {
+		return res
+	}
 
-	activity := activitys.Activity{
-		ID:          params.ID,
-		UserID:      params.UserID,
-		Description: params.Description,
-		CreatedAt:   time.Now(),
+	activity := newActivity(checkinID, params)
+
+	if res := au.checkProject(ctx, activity.ProjectID); res != nil {
+		return res
+	}
+
+	if res := au.track(ctx, session, &activity); res != nil {
+		return res
 	}
 
 	ID, err := au.repository.AddActivity(ctx, userID, activity)
@@ -44,14 +73,110 @@ func (au *activityUseCaseImpl) AddActivity(ctx context.Context, userID int64, pa
 
 	activity.ID = ID
 	activity.UserID = userID
+	activity.Status = activitys.StatusPending
+
+	if len(activity.Tags) > 0 {
+		if err := au.repository.SetTags(ctx, ID, activity.Tags); err != nil {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+	}
 
 	return response.Success(response.StatusOK, activity)
 }
 
+// AddActivities adds all activities to the session of the checkin token or
+// none of them, the response tells which items were rejected and why.
+func (au *activityUseCaseImpl) AddActivities(ctx context.Context, userID int64, checkinID int64, params []activitys.Activity) response.Response {
+	session, res := au.openSession(ctx, userID, checkinID)
+	if res != nil {
+		return res
+	}
+
+	return au.addBatch(ctx, userID, session, params)
+}
+
+// Standup stores every line of the standup as an activity of the session
+// tagged with TagStandup and its section. A session has one standup.
+func (au *activityUseCaseImpl) Standup(ctx context.Context, userID int64, checkinID int64, params activitys.StandupReq) response.Response {
+	session, res := au.openSession(ctx, userID, checkinID)
+	if res != nil {
+		return res
+	}
+
+	existing, err := au.repository.Riwayat(ctx, userID, activitys.DateReq{
+		AbsenID:    checkinID,
+		Tag:        activitys.TagStandup,
+		Pagination: paginations.Pagination{Limit: 1},
+	})
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if len(existing) > 0 {
+		return response.Error(response.StatusConflicted, exception.ErrConflicted)
+	}
+
+	items := []activitys.Activity{}
+	items = appendStandup(items, params.ProjectID, activitys.TagYesterday, params.Yesterday)
+	today := len(items)
+	items = appendStandup(items, params.ProjectID, activitys.TagToday, params.Today)
+
+	if len(items) == today {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	items = appendStandup(items, params.ProjectID, activitys.TagBlocker, params.Blockers)
+
+	res = au.addBatch(ctx, userID, session, items)
+	if res.Err() != nil {
+		return res
+	}
+
+	added := res.(*response.ResponseImpl).Data.(activitys.BulkResult).Activities
+
+	return response.Success(response.StatusOK, groupStandup(session.Checkin.Format("2006-01-02"), added))
+}
+
+// FindStandup returns the standup the user submitted on params.Date.
+func (au *activityUseCaseImpl) FindStandup(ctx context.Context, userID int64, params activitys.StandupFilter) response.Response {
+	activity, err := au.repository.Riwayat(ctx, userID, activitys.DateReq{
+		From: params.Date,
+		To:   params.Date,
+		Tag:  activitys.TagStandup,
+		Pagination: paginations.Pagination{
+			Limit: paginations.MaxLimit,
+			Sort:  paginations.SortAsc,
+		},
+	})
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if len(activity) == 0 {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	ids := make([]int64, 0, len(activity))
+	for _, a := range activity {
+		ids = append(ids, a.ID)
+	}
+
+	tags, err := au.repository.FindTags(ctx, ids)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	for i := range activity {
+		activity[i].Tags = tags[activity[i].ID]
+	}
+
+	return response.Success(response.StatusOK, groupStandup(params.Date, activity))
+}
+
 func (au *activityUseCaseImpl) UpdateActivity(ctx context.Context, id int64, userID int64, params activitys.Activity) response.Response {
 
 	activity, err := au.repository.FindByID(ctx, id)
-	if err == exception.ErrNotFound {
+	if err == exception.ErrNotFound || !activity.DeletedAt.IsZero() {
 		return response.Error(response.StatusNotFound, exception.ErrNotFound)
 	}
 
@@ -59,14 +184,65 @@ func (au *activityUseCaseImpl) UpdateActivity(ctx context.Context, id int64, use
 		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
 	}
 
+	if activity.Status == activitys.StatusApproved {
+		return response.Error(response.StatusConflicted, exception.ErrConflicted)
+	}
+
+	revision := activitys.Revision{
+		ActivityID:  id,
+		Description: activity.Description,
+		EditedBy:    userID,
+		EditedAt:    time.Now(),
+	}
+
+	projectChanged := params.ProjectID != activity.ProjectID
+
+	activity.ProjectID = params.ProjectID
 	activity.Description = params.Description
+	activity.Tags = normalizeTags(params.Tags)
+	activity.StartedAt = params.StartedAt
+	activity.EndedAt = params.EndedAt
+	activity.Duration = params.Duration
 	activity.UpdateAt = time.Now()
+	activity.Status = activitys.StatusPending
+	activity.ReviewComment = ""
+	activity.ReviewedBy = 0
+	activity.ReviewedAt = time.Time{}
 
 	if activity.UserID != userID {
 		return response.Error(response.StatusUnauthorized, exception.ErrUnauthorized)
 	}
 
-	if err := au.repository.UpdateActivity(ctx, id, activity); err != nil {
+	// an activity may keep a project that was deactivated after it was logged
+	if projectChanged {
+		if res := au.checkProject(ctx, activity.ProjectID); res != nil {
+			return res
+		}
+	}
+
+	timed := !activity.StartedAt.IsZero() || !activity.EndedAt.IsZero() || activity.Duration > 0
+	if timed {
+		// activities logged before they were linked to a session have no
+		// window to be checked against
+		if activity.AbsenID == 0 {
+			return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+		}
+
+		session, err := au.absensiRepository.FindByID(ctx, activity.AbsenID)
+		if err != nil {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+
+		if res := au.track(ctx, session, &activity); res != nil {
+			return res
+		}
+	}
+
+	err = au.repository.UpdateActivity(ctx, id, activity, revision)
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+	if err != nil {
 		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
 	}
 
@@ -75,7 +251,7 @@ func (au *activityUseCaseImpl) UpdateActivity(ctx context.Context, id int64, use
 
 func (au *activityUseCaseImpl) DeleteActivity(ctx context.Context, id int64, userID int64) response.Response {
 	activity, err := au.repository.FindByID(ctx, id)
-	if err == exception.ErrNotFound {
+	if err == exception.ErrNotFound || !activity.DeletedAt.IsZero() {
 		return response.Error(response.StatusNotFound, exception.ErrNotFound)
 	}
 
@@ -87,7 +263,11 @@ func (au *activityUseCaseImpl) DeleteActivity(ctx context.Context, id int64, use
 		return response.Error(response.StatusUnauthorized, exception.ErrUnauthorized)
 	}
 
-	if err := au.repository.Delete(ctx, id); err != nil {
+	if activity.Status == activitys.StatusApproved {
+		return response.Error(response.StatusConflicted, exception.ErrConflicted)
+	}
+
+	if err := au.repository.Delete(ctx, id, time.Now()); err != nil {
 		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
 	}
 
@@ -96,15 +276,576 @@ func (au *activityUseCaseImpl) DeleteActivity(ctx context.Context, id int64, use
 	return response.Success(response.StatusOK, msg)
 }
 
+// RestoreActivity undoes a delete. A timed activity is checked against its
+// session again, something logged after the delete may have taken its slot.
+func (au *activityUseCaseImpl) RestoreActivity(ctx context.Context, id int64, userID int64) response.Response {
+	activity, err := au.repository.FindByID(ctx, id)
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if activity.UserID != userID {
+		return response.Error(response.StatusUnauthorized, exception.ErrUnauthorized)
+	}
+
+	if activity.DeletedAt.IsZero() {
+		return response.Error(response.StatusConflicted, exception.ErrConflicted)
+	}
+
+	if activity.Timed() && activity.AbsenID != 0 {
+		session, err := au.absensiRepository.FindByID(ctx, activity.AbsenID)
+		if err != nil {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+
+		if res := au.track(ctx, session, &activity); res != nil {
+			return res
+		}
+	}
+
+	if err := au.repository.Restore(ctx, id); err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	activity.DeletedAt = time.Time{}
+
+	return response.Success(response.StatusOK, activity)
+}
+
+// History returns the previous descriptions of an activity, including a
+// deleted one. Managers can read the history of the activities of their
+// reporting chain, admins of any activity.
+func (au *activityUseCaseImpl) History(ctx context.Context, id int64, userID int64, role string) response.Response {
+	activity, err := au.repository.FindByID(ctx, id)
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if activity.UserID != userID && role != users.RoleAdmin {
+		if !users.IsReviewer(role) {
+			return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+		}
+
+		above, err := au.chain.InChain(ctx, userID, activity.UserID)
+		if err != nil {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+
+		if !above {
+			return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+		}
+	}
+
+	revision, err := au.repository.Revisions(ctx, id)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, revision)
+}
+
 func (au *activityUseCaseImpl) Riwayat(ctx context.Context, userID int64, params activitys.DateReq) response.Response {
+	params.Pagination = params.Pagination.Normalize()
 
 	activity, err := au.repository.Riwayat(ctx, userID, params)
 	if err == exception.ErrNotFound {
 		return response.Error(response.StatusNotFound, exception.ErrNotFound)
 	}
 	if err != nil {
-		return response.Success(response.StatusInternalServerError, exception.ErrInternalServer)
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	total, err := au.repository.CountRiwayat(ctx, userID, params)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	totals, err := au.repository.DailyTotals(ctx, userID, params)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	projects, err := au.repository.ProjectTotals(ctx, userID, params)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	ids := make([]int64, 0, len(activity))
+	for _, a := range activity {
+		ids = append(ids, a.ID)
+	}
+
+	tags, err := au.repository.FindTags(ctx, ids)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	for i := range activity {
+		activity[i].Tags = tags[activity[i].ID]
+	}
+
+	page := response.Page{
+		Limit: params.Limit,
+		Sort:  params.Sort,
+		Total: total,
+	}
+
+	if len(activity) == params.Limit {
+		page.NextCursor = activity[len(activity)-1].ID
+	}
+
+	return response.SuccessWithPage(response.StatusOK, activitys.Riwayat{
+		Activities: activity,
+		Totals:     totals,
+		Projects:   projects,
+	}, page)
+}
+
+// Pending lists the activities that still wait for a review. Admins see every
+// employee, managers only the people below them in the reporting lines.
+func (au *activityUseCaseImpl) Pending(ctx context.Context, reviewerID int64, role string, params activitys.PendingReq) response.Response {
+	if !users.IsReviewer(role) {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	params.Pagination = params.Pagination.Normalize()
+
+	if role != users.RoleAdmin {
+		if res := au.scopePending(ctx, reviewerID, &params); res != nil {
+			return res
+		}
+
+		if params.UserID == 0 && len(params.UserIDs) == 0 {
+			return response.SuccessWithPage(response.StatusOK, []activitys.Activity{}, response.Page{
+				Limit: params.Limit,
+				Sort:  params.Sort,
+			})
+		}
+	}
+
+	activity, err := au.repository.Pending(ctx, params)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	total, err := au.repository.CountPending(ctx, params)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	page := response.Page{
+		Limit: params.Limit,
+		Sort:  params.Sort,
+		Total: total,
+	}
+
+	if len(activity) == params.Limit {
+		page.NextCursor = activity[len(activity)-1].ID
+	}
+
+	return response.SuccessWithPage(response.StatusOK, activity, page)
+}
+
+// Review approves or rejects all activities in params.IDs or none of them.
+// Reviewers can't review their own activities, managers only review the
+// people below them and every activity has to be pending.
+func (au *activityUseCaseImpl) Review(ctx context.Context, reviewerID int64, role string, params activitys.ReviewReq) response.Response {
+	if !users.IsReviewer(role) {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	ids := uniqueIDs(params.IDs)
+
+	activity, err := au.repository.FindByIDs(ctx, ids)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if len(activity) != len(ids) {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	inChain := map[int64]bool{}
+
+	for _, a := range activity {
+		if !a.DeletedAt.IsZero() {
+			return response.Error(response.StatusNotFound, exception.ErrNotFound)
+		}
+
+		if a.UserID == reviewerID {
+			return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+		}
+
+		if role != users.RoleAdmin {
+			above, checked := inChain[a.UserID]
+			if !checked {
+				above, err = au.chain.InChain(ctx, reviewerID, a.UserID)
+				if err != nil {
+					return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+				}
+				inChain[a.UserID] = above
+			}
+
+			if !above {
+				return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+			}
+		}
+
+		if a.Status != activitys.StatusPending {
+			return response.Error(response.StatusConflicted, exception.ErrConflicted)
+		}
+	}
+
+	review := activitys.Activity{
+		Status:     params.Status,
+		ReviewedBy: reviewerID,
+		ReviewedAt: time.Now(),
+	}
+
+	if params.Status == activitys.StatusRejected {
+		review.ReviewComment = strings.TrimSpace(params.Comment)
+	}
+
+	err = au.repository.Review(ctx, ids, review)
+	if err == exception.ErrConflicted {
+		return response.Error(response.StatusConflicted, exception.ErrConflicted)
+	}
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	for i := range activity {
+		activity[i].Status = review.Status
+		activity[i].ReviewComment = review.ReviewComment
+		activity[i].ReviewedBy = review.ReviewedBy
+		activity[i].ReviewedAt = review.ReviewedAt
 	}
 
 	return response.Success(response.StatusOK, activity)
 }
+
+// scopePending limits params to the reports of the manager. Asking for
+// someone outside of them is forbidden.
+func (au *activityUseCaseImpl) scopePending(ctx context.Context, managerID int64, params *activitys.PendingReq) response.Response {
+	if params.UserID != 0 {
+		above, err := au.chain.InChain(ctx, managerID, params.UserID)
+		if err != nil {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+
+		if !above {
+			return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+		}
+
+		return nil
+	}
+
+	member, err := au.chain.Reports(ctx, managerID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	params.UserIDs = make([]int64, 0, len(member))
+	for _, m := range member {
+		params.UserIDs = append(params.UserIDs, m.UserID)
+	}
+
+	return nil
+}
+
+// track fills in the missing half of the activity's time slot and checks it
+// against the session: the slot has to lie between checkin and checkout (or
+// now while the session is open) and must not overlap another timed activity
+// of the session. A bare duration only has to fit in the session.
+func (au *activityUseCaseImpl) track(ctx context.Context, session absensis.Absensi, activity *activitys.Activity) response.Response {
+	if res := slot(session, activity); res != nil {
+		return res
+	}
+
+	if !activity.Timed() {
+		return nil
+	}
+
+	logged, err := au.repository.FindBySession(ctx, session.ID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if overlaps(*activity, logged) {
+		return response.Error(response.StatusConflicted, exception.ErrConflicted)
+	}
+
+	return nil
+}
+
+// addBatch checks every item the way AddActivity does, items are also checked
+// for overlaps with each other. Nothing is added when one item is rejected.
+func (au *activityUseCaseImpl) addBatch(ctx context.Context, userID int64, session absensis.Absensi, items []activitys.Activity) response.Response {
+	logged, err := au.repository.FindBySession(ctx, session.ID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	added := make([]activitys.Activity, 0, len(items))
+	errs := []activitys.ItemError{}
+	projects := map[int64]response.Response{}
+
+	for i, params := range items {
+		activity := newActivity(session.ID, params)
+
+		res, checked := projects[activity.ProjectID]
+		if !checked {
+			res = au.checkProject(ctx, activity.ProjectID)
+			projects[activity.ProjectID] = res
+		}
+
+		if res == nil {
+			res = slot(session, &activity)
+		}
+
+		if res == nil && activity.Timed() && overlaps(activity, logged) {
+			res = response.Error(response.StatusConflicted, exception.ErrConflicted)
+		}
+
+		if res != nil {
+			if res.Err() == exception.ErrInternalServer {
+				return res
+			}
+			errs = append(errs, itemError(i, res))
+			continue
+		}
+
+		if activity.Timed() {
+			logged = append(logged, activity)
+		}
+		added = append(added, activity)
+	}
+
+	if len(errs) > 0 {
+		return response.ErrorWithData(response.StatusBadRequest, exception.ErrBadRequest, activitys.BulkResult{
+			Activities: []activitys.Activity{},
+			Errors:     errs,
+		})
+	}
+
+	ids, err := au.repository.AddActivities(ctx, userID, added)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	for i := range added {
+		added[i].ID = ids[i]
+		added[i].UserID = userID
+		added[i].Status = activitys.StatusPending
+	}
+
+	return response.Success(response.StatusOK, activitys.BulkResult{
+		Activities: added,
+		Errors:     errs,
+	})
+}
+
+func (au *activityUseCaseImpl) openSession(ctx context.Context, userID int64, checkinID int64) (absensis.Absensi, response.Response) {
+	session, err := au.absensiRepository.FindByID(ctx, checkinID)
+	if err == exception.ErrNotFound {
+		return absensis.Absensi{}, response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+	if err != nil {
+		return absensis.Absensi{}, response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if session.UserID != userID {
+		return absensis.Absensi{}, response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	if !session.Checkout.IsZero() {
+		return absensis.Absensi{}, response.Error(response.StatusConflicted, exception.ErrConflicted)
+	}
+
+	return session, nil
+}
+
+func (au *activityUseCaseImpl) checkProject(ctx context.Context, projectID int64) response.Response {
+	if projectID == 0 {
+		return nil
+	}
+
+	project, err := au.projectRepository.FindByID(ctx, projectID)
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if !project.Active {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	return nil
+}
+
+// normalizeTags lower cases and trims tags and drops empty and repeated ones,
+// so "Meeting" and "meeting " end up as the same tag.
+func normalizeTags(tags []string) []string {
+	normalized := []string{}
+	seen := map[string]bool{}
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+
+	return normalized
+}
+
+func newActivity(absenID int64, params activitys.Activity) activitys.Activity {
+	return activitys.Activity{
+		AbsenID:     absenID,
+		ProjectID:   params.ProjectID,
+		Description: params.Description,
+		Tags:        normalizeTags(params.Tags),
+		StartedAt:   params.StartedAt,
+		EndedAt:     params.EndedAt,
+		Duration:    params.Duration,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// slot fills in EndedAt and Duration of a timed activity and checks that it
+// fits in the session. A bare duration only has to fit the session length.
+func slot(session absensis.Absensi, activity *activitys.Activity) response.Response {
+	if activity.StartedAt.IsZero() && activity.EndedAt.IsZero() {
+		if time.Duration(activity.Duration)*time.Minute > sessionEnd(session).Sub(session.Checkin) {
+			return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+		}
+		return nil
+	}
+
+	if activity.EndedAt.IsZero() && activity.Duration > 0 {
+		activity.EndedAt = activity.StartedAt.Add(time.Duration(activity.Duration) * time.Minute)
+	}
+
+	if !activity.Timed() || !activity.EndedAt.After(activity.StartedAt) {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	if activity.StartedAt.Before(session.Checkin) || activity.EndedAt.After(sessionEnd(session)) {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	activity.Duration = int(activity.EndedAt.Sub(activity.StartedAt).Minutes())
+
+	return nil
+}
+
+// overlaps reports whether the timed activity shares time with another timed
+// activity in logged, activity itself is skipped when it is being updated.
+func overlaps(activity activitys.Activity, logged []activitys.Activity) bool {
+	for _, other := range logged {
+		if (activity.ID != 0 && other.ID == activity.ID) || !other.Timed() {
+			continue
+		}
+		if activity.StartedAt.Before(other.EndedAt) && other.StartedAt.Before(activity.EndedAt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func itemError(index int, res response.Response) activitys.ItemError {
+	item := activitys.ItemError{
+		Index:  index,
+		Reason: res.Err().Error(),
+	}
+
+	if impl, ok := res.(*response.ResponseImpl); ok {
+		item.Status = impl.Status
+	}
+
+	return item
+}
+
+func appendStandup(items []activitys.Activity, projectID int64, section string, lines []string) []activitys.Activity {
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		items = append(items, activitys.Activity{
+			ProjectID:   projectID,
+			Description: line,
+			Tags:        []string{activitys.TagStandup, section},
+		})
+	}
+
+	return items
+}
+
+func groupStandup(date string, activity []activitys.Activity) activitys.Standup {
+	standup := activitys.Standup{
+		Date:      date,
+		Yesterday: []activitys.Activity{},
+		Today:     []activitys.Activity{},
+		Blockers:  []activitys.Activity{},
+	}
+
+	for _, a := range activity {
+		switch standupSection(a.Tags) {
+		case activitys.TagYesterday:
+			standup.Yesterday = append(standup.Yesterday, a)
+		case activitys.TagToday:
+			standup.Today = append(standup.Today, a)
+		case activitys.TagBlocker:
+			standup.Blockers = append(standup.Blockers, a)
+		}
+	}
+
+	return standup
+}
+
+func standupSection(tags []string) string {
+	for _, tag := range tags {
+		if tag == activitys.TagYesterday || tag == activitys.TagToday || tag == activitys.TagBlocker {
+			return tag
+		}
+	}
+
+	return ""
+}
+
+func sessionEnd(session absensis.Absensi) time.Time {
+	if session.Checkout.IsZero() {
+		return time.Now()
+	}
+	return session.Checkout
+}
+
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]bool, len(ids))
+	unique := make([]int64, 0, len(ids))
+
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+
+	return unique
+}
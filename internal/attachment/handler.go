@@ -0,0 +1,136 @@
+package attachment
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/export"
+	"github.com/Risuii/helpers/response"
+)
+
+// maxUploadSize caps the whole request, the usecase applies the configured
+// limit to the file itself.
+const maxUploadSize = 32 << 20
+
+type AttachmentHandler struct {
+	UseCase AttachmentUseCase
+}
+
+func NewAttachmentHandler(router *mux.Router, usecase AttachmentUseCase) {
+	handler := &AttachmentHandler{
+		UseCase: usecase,
+	}
+
+	api := router.PathPrefix("/account").Subrouter()
+
+	api.HandleFunc("/activity/{id}/attachments", handler.Upload).Methods(http.MethodPost)
+	api.HandleFunc("/activity/{id}/attachments", handler.List).Methods(http.MethodGet)
+	api.HandleFunc("/activity/{id}/attachments/{attachmentID}", handler.Download).Methods(http.MethodGet)
+	api.HandleFunc("/activity/{id}/attachments/{attachmentID}", handler.Delete).Methods(http.MethodDelete)
+}
+
+// Upload expects the file as the "file" field of a multipart form.
+func (handler *AttachmentHandler) Upload(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.ParseInt(params["id"], 10, 64)
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+	defer file.Close()
+
+	res = handler.UseCase.Upload(ctx, id, claims.ID, header.Filename, file)
+
+	res.JSON(w)
+}
+
+func (handler *AttachmentHandler) List(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.ParseInt(params["id"], 10, 64)
+
+	res = handler.UseCase.List(ctx, id, claims.ID, claims.Role)
+
+	res.JSON(w)
+}
+
+func (handler *AttachmentHandler) Download(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.ParseInt(params["id"], 10, 64)
+	attachmentID, _ := strconv.ParseInt(params["attachmentID"], 10, 64)
+
+	file := export.NewFileWriter(w, "application/octet-stream", "attachment")
+
+	res = handler.UseCase.Download(ctx, id, attachmentID, claims.ID, claims.Role, file)
+
+	if !file.Started() {
+		res.JSON(w)
+		return
+	}
+
+	if res.Err() != nil {
+		log.Println(res.Err())
+	}
+}
+
+func (handler *AttachmentHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.ParseInt(params["id"], 10, 64)
+	attachmentID, _ := strconv.ParseInt(params["attachmentID"], 10, 64)
+
+	res = handler.UseCase.Delete(ctx, id, attachmentID, claims.ID)
+
+	res.JSON(w)
+}
@@ -0,0 +1,165 @@
+package attachment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/models/activitys"
+)
+
+type (
+	AttachmentRepository interface {
+		Create(ctx context.Context, params activitys.Attachment) (int64, error)
+		FindByID(ctx context.Context, id int64) (activitys.Attachment, error)
+		FindByActivity(ctx context.Context, activityID int64) ([]activitys.Attachment, error)
+		Delete(ctx context.Context, id int64) error
+	}
+
+	attachmentRepositoryImpl struct {
+		db        *sql.DB
+		tableName string
+	}
+)
+
+func NewAttachmentRepositoryImpl(db *sql.DB, tableName string) AttachmentRepository {
+	return &attachmentRepositoryImpl{
+		db:        db,
+		tableName: tableName,
+	}
+}
+
+func (ar *attachmentRepositoryImpl) Create(ctx context.Context, params activitys.Attachment) (int64, error) {
+	query := fmt.Sprintf(`INSERT INTO %s (activityID, file_name, content_type, size, storage_key, uploaded_by, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)`, ar.tableName)
+	stmt, err := ar.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		params.ActivityID,
+		params.FileName,
+		params.ContentType,
+		params.Size,
+		params.Key,
+		params.UploadedBy,
+		params.CreatedAt,
+	)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	ID, err := result.LastInsertId()
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	return ID, nil
+}
+
+func (ar *attachmentRepositoryImpl) FindByID(ctx context.Context, id int64) (activitys.Attachment, error) {
+	query := fmt.Sprintf(`SELECT id, activityID, file_name, content_type, size, storage_key, uploaded_by, created_at FROM %s WHERE id = ?`, ar.tableName)
+	stmt, err := ar.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return activitys.Attachment{}, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	attachment, err := scanAttachment(stmt.QueryRowContext(ctx, id))
+	if err == sql.ErrNoRows {
+		return activitys.Attachment{}, exception.ErrNotFound
+	}
+
+	if err != nil {
+		log.Println(err)
+		return activitys.Attachment{}, exception.ErrInternalServer
+	}
+
+	return attachment, nil
+}
+
+func (ar *attachmentRepositoryImpl) FindByActivity(ctx context.Context, activityID int64) ([]activitys.Attachment, error) {
+	attachment := []activitys.Attachment{}
+
+	query := fmt.Sprintf(`SELECT id, activityID, file_name, content_type, size, storage_key, uploaded_by, created_at FROM %s WHERE activityID = ? ORDER BY id`, ar.tableName)
+	rows, err := ar.db.QueryContext(ctx, query, activityID)
+	if err != nil {
+		log.Println(err)
+		return attachment, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		a, err := scanAttachment(rows)
+		if err != nil {
+			log.Println(err)
+			return attachment, exception.ErrInternalServer
+		}
+		attachment = append(attachment, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return attachment, exception.ErrInternalServer
+	}
+
+	return attachment, nil
+}
+
+func (ar *attachmentRepositoryImpl) Delete(ctx context.Context, id int64) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = ?`, ar.tableName)
+	stmt, err := ar.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, id)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected < 1 {
+		return exception.ErrNotFound
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAttachment(row scanner) (activitys.Attachment, error) {
+	var a activitys.Attachment
+
+	if err := row.Scan(
+		&a.ID,
+		&a.ActivityID,
+		&a.FileName,
+		&a.ContentType,
+		&a.Size,
+		&a.Key,
+		&a.UploadedBy,
+		&a.CreatedAt,
+	); err != nil {
+		return activitys.Attachment{}, err
+	}
+
+	return a, nil
+}
@@ -0,0 +1,367 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/activity"
+	"github.com/Risuii/internal/org"
+	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/users"
+)
+
+const (
+	MaxAttachments = 10
+	sniffLen       = 512
+	maxNameLen     = 255
+)
+
+// allowedTypes maps the sniffed content type to the extension stored with
+// the file, anything else is rejected.
+var allowedTypes = map[string]string{
+	"image/png":       ".png",
+	"image/jpeg":      ".jpg",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"application/pdf": ".pdf",
+	"text/plain":      ".txt",
+}
+
+// officeTypes are sniffed as zip archives, the extension of the uploaded name
+// tells them apart.
+var officeTypes = map[string]string{
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	".pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+}
+
+type (
+	AttachmentUseCase interface {
+		Upload(ctx context.Context, activityID int64, userID int64, fileName string, file io.Reader) response.Response
+		List(ctx context.Context, activityID int64, userID int64, role string) response.Response
+		Download(ctx context.Context, activityID int64, id int64, userID int64, role string, w FileWriter) response.Response
+		Delete(ctx context.Context, activityID int64, id int64, userID int64) response.Response
+	}
+
+	// FileWriter receives a download, its content type and name are only
+	// known once the attachment was found.
+	FileWriter interface {
+		io.Writer
+		SetFile(contentType string, filename string)
+	}
+
+	attachmentUseCaseImpl struct {
+		repository         AttachmentRepository
+		activityRepository activity.ActivityRepository
+		storage            Storage
+		chain              org.Chain
+		maxSize            int64
+	}
+)
+
+func NewAttachmentUseCaseImpl(repo AttachmentRepository, activityRepo activity.ActivityRepository, storage Storage, chain org.Chain, maxSize int64) AttachmentUseCase {
+	return &attachmentUseCaseImpl{
+		repository:         repo,
+		activityRepository: activityRepo,
+		storage:            storage,
+		chain:              chain,
+		maxSize:            maxSize,
+	}
+}
+
+// Upload stores the file for an activity of userID. Like UpdateActivity only
+// the owner can change the attachments, and not once the activity is
+// approved.
+func (au *attachmentUseCaseImpl) Upload(ctx context.Context, activityID int64, userID int64, fileName string, file io.Reader) response.Response {
+	act, res := au.findActivity(ctx, activityID)
+	if res != nil {
+		return res
+	}
+
+	if act.UserID != userID {
+		return response.Error(response.StatusUnauthorized, exception.ErrUnauthorized)
+	}
+
+	if act.Status == activitys.StatusApproved {
+		return response.Error(response.StatusConflicted, exception.ErrConflicted)
+	}
+
+	attachment, err := au.repository.FindByActivity(ctx, activityID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if len(attachment) >= MaxAttachments {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	name := cleanName(fileName)
+	if name == "" {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return response.Error(response.StatusUnprocessableEntity, err)
+	}
+	head = head[:n]
+
+	if n == 0 {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	contentType, ext := detectType(head, name)
+	if contentType == "" {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	token, err := randomToken()
+	if err != nil {
+		log.Println(err)
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	key := fmt.Sprintf("%d/%s%s", activityID, token, ext)
+
+	// one byte over the limit is enough to know the file is too large
+	content := &io.LimitedReader{R: io.MultiReader(bytes.NewReader(head), file), N: au.maxSize + 1}
+
+	size, err := au.storage.Save(ctx, key, content)
+	if err != nil {
+		log.Println(err)
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if size > au.maxSize {
+		au.remove(ctx, key)
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	upload := activitys.Attachment{
+		ActivityID:  activityID,
+		FileName:    name,
+		ContentType: contentType,
+		Size:        size,
+		Key:         key,
+		UploadedBy:  userID,
+		CreatedAt:   time.Now(),
+	}
+
+	ID, err := au.repository.Create(ctx, upload)
+	if err != nil {
+		au.remove(ctx, key)
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	upload.ID = ID
+
+	return response.Success(response.StatusOK, upload)
+}
+
+func (au *attachmentUseCaseImpl) List(ctx context.Context, activityID int64, userID int64, role string) response.Response {
+	act, res := au.findActivity(ctx, activityID)
+	if res != nil {
+		return res
+	}
+
+	if res := au.canRead(ctx, act, userID, role); res != nil {
+		return res
+	}
+
+	attachment, err := au.repository.FindByActivity(ctx, activityID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, attachment)
+}
+
+// Download streams the attachment to w. Managers can download the attachments
+// of their reporting chain's activities so they can review them, admins any.
+func (au *attachmentUseCaseImpl) Download(ctx context.Context, activityID int64, id int64, userID int64, role string, w FileWriter) response.Response {
+	act, res := au.findActivity(ctx, activityID)
+	if res != nil {
+		return res
+	}
+
+	if res := au.canRead(ctx, act, userID, role); res != nil {
+		return res
+	}
+
+	attachment, res := au.findAttachment(ctx, activityID, id)
+	if res != nil {
+		return res
+	}
+
+	file, err := au.storage.Open(ctx, attachment.Key)
+	if err != nil {
+		log.Println(err)
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+	defer file.Close()
+
+	w.SetFile(attachment.ContentType, attachment.FileName)
+
+	if _, err := io.Copy(w, file); err != nil {
+		log.Println(err)
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, attachment)
+}
+
+func (au *attachmentUseCaseImpl) Delete(ctx context.Context, activityID int64, id int64, userID int64) response.Response {
+	act, res := au.findActivity(ctx, activityID)
+	if res != nil {
+		return res
+	}
+
+	if act.UserID != userID {
+		return response.Error(response.StatusUnauthorized, exception.ErrUnauthorized)
+	}
+
+	if act.Status == activitys.StatusApproved {
+		return response.Error(response.StatusConflicted, exception.ErrConflicted)
+	}
+
+	attachment, res := au.findAttachment(ctx, activityID, id)
+	if res != nil {
+		return res
+	}
+
+	err := au.repository.Delete(ctx, id)
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	au.remove(ctx, attachment.Key)
+
+	msg := "Berhasil Menghapus Lampiran"
+
+	return response.Success(response.StatusOK, msg)
+}
+
+// findActivity treats a deleted activity as missing.
+func (au *attachmentUseCaseImpl) findActivity(ctx context.Context, activityID int64) (activitys.Activity, response.Response) {
+	act, err := au.activityRepository.FindByID(ctx, activityID)
+	if err == exception.ErrNotFound || (err == nil && !act.DeletedAt.IsZero()) {
+		return activitys.Activity{}, response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	if err != nil {
+		return activitys.Activity{}, response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return act, nil
+}
+
+func (au *attachmentUseCaseImpl) findAttachment(ctx context.Context, activityID int64, id int64) (activitys.Attachment, response.Response) {
+	attachment, err := au.repository.FindByID(ctx, id)
+	if err == exception.ErrNotFound || (err == nil && attachment.ActivityID != activityID) {
+		return activitys.Attachment{}, response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	if err != nil {
+		return activitys.Attachment{}, response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return attachment, nil
+}
+
+// remove only logs a failure, a leftover file is harmless once its row is
+// gone.
+func (au *attachmentUseCaseImpl) remove(ctx context.Context, key string) {
+	if err := au.storage.Delete(ctx, key); err != nil {
+		log.Println(err)
+	}
+}
+
+// canRead lets the owner, the managers of their reporting chain and admins
+// read the attachments of an activity.
+func (au *attachmentUseCaseImpl) canRead(ctx context.Context, act activitys.Activity, userID int64, role string) response.Response {
+	if act.UserID == userID || role == users.RoleAdmin {
+		return nil
+	}
+
+	if role != users.RoleManager {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	above, err := au.chain.InChain(ctx, userID, act.UserID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if !above {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	return nil
+}
+
+func detectType(head []byte, name string) (string, string) {
+	contentType, _, _ := strings.Cut(http.DetectContentType(head), ";")
+
+	if ext, ok := allowedTypes[contentType]; ok {
+		return contentType, ext
+	}
+
+	if contentType == "application/zip" {
+		ext := strings.ToLower(filepath.Ext(name))
+		if office, ok := officeTypes[ext]; ok {
+			return office, ext
+		}
+	}
+
+	return "", ""
+}
+
+// cleanName keeps the base name of the uploaded file without control
+// characters, cut to what fits the file_name column.
+func cleanName(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == "/" {
+		return ""
+	}
+
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+
+	for len(name) > maxNameLen {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+
+	return name
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,25 @@
+package company
+
+import (
+	"context"
+	"time"
+
+	"github.com/Risuii/helpers/tenant"
+)
+
+// Each calls fn once per company with ctx scoped to it and now in the
+// company's timezone, the background jobs run through it since they have no
+// token to take the company from.
+func Each(ctx context.Context, repo CompanyRepository, now time.Time, fn func(ctx context.Context, now time.Time)) error {
+	company, err := repo.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range company {
+		loc := c.Location()
+		fn(tenant.WithLocation(tenant.WithID(ctx, c.ID), loc), now.In(loc))
+	}
+
+	return nil
+}
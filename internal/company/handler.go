@@ -0,0 +1,76 @@
+package company
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/companies"
+)
+
+type CompanyHandler struct {
+	Validate *validator.Validate
+	UseCase  CompanyUseCase
+}
+
+func NewCompanyHandler(router *mux.Router, validate *validator.Validate, usecase CompanyUseCase) {
+	handler := &CompanyHandler{
+		Validate: validate,
+		UseCase:  usecase,
+	}
+
+	api := router.PathPrefix("/account").Subrouter()
+
+	api.HandleFunc("/company", handler.Get).Methods(http.MethodGet)
+	api.HandleFunc("/company", handler.Update).Methods(http.MethodPatch)
+}
+
+func (handler *CompanyHandler) Get(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	if _, err := r.Cookie("token"); err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Get(ctx)
+
+	res.JSON(w)
+}
+
+func (handler *CompanyHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput companies.CompanyReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Update(ctx, claims.Role, userInput)
+
+	res.JSON(w)
+}
@@ -0,0 +1,131 @@
+package company
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/models/companies"
+)
+
+type (
+	CompanyRepository interface {
+		FindByID(ctx context.Context, id int64) (companies.Company, error)
+		FindByCode(ctx context.Context, code string) (companies.Company, error)
+		FindAll(ctx context.Context) ([]companies.Company, error)
+		Update(ctx context.Context, id int64, params companies.Company) error
+	}
+
+	companyRepositoryImpl struct {
+		db        *sql.DB
+		tableName string
+	}
+)
+
+func NewCompanyRepositoryImpl(db *sql.DB, tableName string) CompanyRepository {
+	return &companyRepositoryImpl{
+		db:        db,
+		tableName: tableName,
+	}
+}
+
+func (cr *companyRepositoryImpl) FindByID(ctx context.Context, id int64) (companies.Company, error) {
+	query := fmt.Sprintf(`SELECT ID, code, name, timezone, created_at FROM %s WHERE ID = ?`, cr.tableName)
+
+	return cr.findOne(ctx, query, id)
+}
+
+func (cr *companyRepositoryImpl) FindByCode(ctx context.Context, code string) (companies.Company, error) {
+	query := fmt.Sprintf(`SELECT ID, code, name, timezone, created_at FROM %s WHERE code = ?`, cr.tableName)
+
+	return cr.findOne(ctx, query, code)
+}
+
+func (cr *companyRepositoryImpl) FindAll(ctx context.Context) ([]companies.Company, error) {
+	company := []companies.Company{}
+
+	query := fmt.Sprintf(`SELECT ID, code, name, timezone, created_at FROM %s ORDER BY ID`, cr.tableName)
+	rows, err := cr.db.QueryContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return company, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		c, err := scanCompany(rows)
+		if err != nil {
+			log.Println(err)
+			return company, exception.ErrInternalServer
+		}
+		company = append(company, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return company, exception.ErrInternalServer
+	}
+
+	return company, nil
+}
+
+func (cr *companyRepositoryImpl) Update(ctx context.Context, id int64, params companies.Company) error {
+	query := fmt.Sprintf(`UPDATE %s SET name = ?, timezone = ? WHERE ID = ?`, cr.tableName)
+	stmt, err := cr.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, params.Name, params.Timezone, id)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	return nil
+}
+
+func (cr *companyRepositoryImpl) findOne(ctx context.Context, query string, arg interface{}) (companies.Company, error) {
+	stmt, err := cr.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return companies.Company{}, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	company, err := scanCompany(stmt.QueryRowContext(ctx, arg))
+	if err == sql.ErrNoRows {
+		return companies.Company{}, exception.ErrNotFound
+	}
+	if err != nil {
+		log.Println(err)
+		return companies.Company{}, exception.ErrInternalServer
+	}
+
+	return company, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanCompany(row scanner) (companies.Company, error) {
+	var company companies.Company
+
+	err := row.Scan(
+		&company.ID,
+		&company.Code,
+		&company.Name,
+		&company.Timezone,
+		&company.CreatedAt,
+	)
+
+	return company, err
+}
@@ -0,0 +1,68 @@
+package company
+
+import (
+	"context"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/models/companies"
+	"github.com/Risuii/models/users"
+)
+
+type (
+	CompanyUseCase interface {
+		Get(ctx context.Context) response.Response
+		Update(ctx context.Context, role string, params companies.CompanyReq) response.Response
+	}
+
+	companyUseCaseImpl struct {
+		repository CompanyRepository
+	}
+)
+
+func NewCompanyUseCase(repo CompanyRepository) CompanyUseCase {
+	return &companyUseCaseImpl{
+		repository: repo,
+	}
+}
+
+// Get returns the company of the caller's token.
+func (cu *companyUseCaseImpl) Get(ctx context.Context) response.Response {
+	company, err := cu.repository.FindByID(ctx, tenant.ID(ctx))
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, company)
+}
+
+// Update changes the name and timezone of the caller's company, the code is
+// fixed once handed out since employees register with it.
+func (cu *companyUseCaseImpl) Update(ctx context.Context, role string, params companies.CompanyReq) response.Response {
+	if role != users.RoleAdmin {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	id := tenant.ID(ctx)
+
+	company, err := cu.repository.FindByID(ctx, id)
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	company.Name = params.Name
+	company.Timezone = params.Timezone
+
+	if err := cu.repository.Update(ctx, id, company); err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, company)
+}
@@ -0,0 +1,52 @@
+package digest
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/company"
+)
+
+type DigestJob struct {
+	UseCase   DigestUseCase
+	Companies company.CompanyRepository
+	Interval  time.Duration
+}
+
+func NewDigestJob(usecase DigestUseCase, companies company.CompanyRepository, interval time.Duration) *DigestJob {
+	return &DigestJob{
+		UseCase:   usecase,
+		Companies: companies,
+		Interval:  interval,
+	}
+}
+
+// Run delivers the due digests of every company on every tick until ctx is
+// cancelled.
+func (job *DigestJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			err := company.Each(ctx, job.Companies, now, func(ctx context.Context, now time.Time) {
+				sent, err := job.UseCase.Deliver(ctx, now)
+				if err != nil {
+					log.Println(err)
+				}
+
+				if sent > 0 {
+					log.Printf("Sent %d digest in company %d", sent, tenant.ID(ctx))
+				}
+			})
+			if err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
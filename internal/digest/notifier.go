@@ -0,0 +1,167 @@
+package digest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Risuii/models/digests"
+)
+
+type (
+	// Notifier delivers a digest to its recipient.
+	Notifier interface {
+		Notify(ctx context.Context, params digests.Digest) error
+	}
+
+	fileNotifier struct {
+		dir string
+	}
+
+	webhookNotifier struct {
+		url    string
+		client *http.Client
+	}
+
+	SMTPConfig struct {
+		Host     string
+		Port     string
+		Username string
+		Password string
+		From     string
+	}
+
+	smtpNotifier struct {
+		cfg SMTPConfig
+	}
+)
+
+// NewFileNotifier writes every digest as a text file in dir, meant for local
+// runs without a mail server.
+func NewFileNotifier(dir string) Notifier {
+	return &fileNotifier{
+		dir: dir,
+	}
+}
+
+func (fn *fileNotifier) Notify(ctx context.Context, params digests.Digest) error {
+	if err := os.MkdirAll(fn.dir, 0o750); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%s-%d.txt", params.Period, params.From, params.Recipient.UserID)
+
+	return os.WriteFile(filepath.Join(fn.dir, name), []byte(Subject(params)+"\n\n"+Render(params)), 0o640)
+}
+
+// NewWebhookNotifier posts every digest as JSON to url.
+func NewWebhookNotifier(url string) Notifier {
+	return &webhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (wn *webhookNotifier) Notify(ctx context.Context, params digests.Digest) error {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wn.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := wn.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("digest webhook returned %s", resp.Status)
+	}
+
+	return nil
+}
+
+// NewSMTPNotifier mails every digest to the recipient's email, recipients
+// without an email are skipped.
+func NewSMTPNotifier(cfg SMTPConfig) Notifier {
+	return &smtpNotifier{
+		cfg: cfg,
+	}
+}
+
+func (sn *smtpNotifier) Notify(ctx context.Context, params digests.Digest) error {
+	if params.Recipient.Email == "" {
+		return nil
+	}
+
+	to := mail.Address{Name: params.Recipient.Name, Address: params.Recipient.Email}
+	from := mail.Address{Address: sn.cfg.From}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", from.String())
+	fmt.Fprintf(&msg, "To: %s\r\n", to.String())
+	fmt.Fprintf(&msg, "Subject: %s\r\n", Subject(params))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(Render(params), "\n", "\r\n"))
+
+	var auth smtp.Auth
+	if sn.cfg.Username != "" {
+		auth = smtp.PlainAuth("", sn.cfg.Username, sn.cfg.Password, sn.cfg.Host)
+	}
+
+	return smtp.SendMail(net.JoinHostPort(sn.cfg.Host, sn.cfg.Port), auth, sn.cfg.From, []string{params.Recipient.Email}, []byte(msg.String()))
+}
+
+func Subject(params digests.Digest) string {
+	if params.Period == digests.PeriodWeekly {
+		return fmt.Sprintf("Ringkasan Mingguan %s - %s", params.From, params.To)
+	}
+
+	return fmt.Sprintf("Ringkasan Harian %s", params.From)
+}
+
+// Render writes the digest as plain text, one block per employee.
+func Render(params digests.Digest) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Halo %s,\n", params.Recipient.Name)
+
+	if len(params.Members) == 0 {
+		b.WriteString("\nTidak ada absensi maupun aktivitas.\n")
+		return b.String()
+	}
+
+	for _, m := range params.Members {
+		a := m.Attendance
+		fmt.Fprintf(&b, "\n%s\n", a.Name)
+		fmt.Fprintf(&b, "  Hadir %d hari, terlambat %d hari (%d menit), absent %d hari, cuti %d hari, WFH %d hari, jam kerja %.2f\n",
+			a.DaysPresent, a.DaysLate, a.LateMinutes, a.DaysAbsent, a.LeaveDays, a.WFHDays, a.WorkedHours)
+		fmt.Fprintf(&b, "  Aktivitas %d (%d menit), %d menunggu review\n", m.Activities, m.ActivityMinutes, m.Pending)
+
+		for _, h := range m.Highlights {
+			fmt.Fprintf(&b, "  - %s\n", h)
+		}
+
+		if more := m.Activities - len(m.Highlights); more > 0 {
+			fmt.Fprintf(&b, "  - dan %d aktivitas lainnya\n", more)
+		}
+	}
+
+	return b.String()
+}
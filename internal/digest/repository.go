@@ -0,0 +1,151 @@
+package digest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/digests"
+	"github.com/Risuii/models/users"
+)
+
+type (
+	DigestRepository interface {
+		FindRecipients(ctx context.Context) ([]digests.Recipient, error)
+		FindActivities(ctx context.Context, from string, to string) ([]activitys.Activity, error)
+		IsSent(ctx context.Context, period string, start string, recipientID int64) (bool, error)
+		MarkSent(ctx context.Context, period string, start string, recipientID int64, sentAt time.Time) error
+	}
+
+	digestRepositoryImpl struct {
+		db *sql.DB
+	}
+)
+
+func NewDigestRepositoryImpl(db *sql.DB) DigestRepository {
+	return &digestRepositoryImpl{
+		db: db,
+	}
+}
+
+// FindRecipients returns the managers of the company, every one of them gets
+// a digest.
+func (dr *digestRepositoryImpl) FindRecipients(ctx context.Context) ([]digests.Recipient, error) {
+	recipient := []digests.Recipient{}
+
+	query := fmt.Sprintf(`SELECT id, name, email FROM %s WHERE company_id = ? AND role = ? ORDER BY id`, constant.TableEmployee)
+	rows, err := dr.db.QueryContext(ctx, query, tenant.ID(ctx), users.RoleManager)
+	if err != nil {
+		log.Println(err)
+		return recipient, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var r digests.Recipient
+		var name, email sql.NullString
+		if err := rows.Scan(
+			&r.UserID,
+			&name,
+			&email,
+		); err != nil {
+			log.Println(err)
+			return recipient, exception.ErrInternalServer
+		}
+		r.Name = name.String
+		r.Email = email.String
+		recipient = append(recipient, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return recipient, exception.ErrInternalServer
+	}
+
+	return recipient, nil
+}
+
+// FindActivities returns the activities logged between from and to
+// inclusive, deleted ones left out.
+func (dr *digestRepositoryImpl) FindActivities(ctx context.Context, from string, to string) ([]activitys.Activity, error) {
+	activity := []activitys.Activity{}
+
+	query := fmt.Sprintf(`SELECT id, userID, deskripsi, duration_minutes, status, created_at FROM %s WHERE company_id = ? AND DATE(created_at) BETWEEN ? AND ? AND deleted_at IS NULL ORDER BY userID, created_at, id`, constant.TableActivity)
+	rows, err := dr.db.QueryContext(ctx, query, tenant.ID(ctx), from, to)
+	if err != nil {
+		log.Println(err)
+		return activity, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var a activitys.Activity
+		var description sql.NullString
+		if err := rows.Scan(
+			&a.ID,
+			&a.UserID,
+			&description,
+			&a.Duration,
+			&a.Status,
+			&a.CreatedAt,
+		); err != nil {
+			log.Println(err)
+			return activity, exception.ErrInternalServer
+		}
+		a.Description = description.String
+		activity = append(activity, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return activity, exception.ErrInternalServer
+	}
+
+	return activity, nil
+}
+
+func (dr *digestRepositoryImpl) IsSent(ctx context.Context, period string, start string, recipientID int64) (bool, error) {
+	var total int
+
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE period = ? AND period_start = ? AND recipientID = ?`, constant.TableDigestLog)
+	stmt, err := dr.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return false, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	if err := stmt.QueryRowContext(ctx, period, start, recipientID).Scan(&total); err != nil {
+		log.Println(err)
+		return false, exception.ErrInternalServer
+	}
+
+	return total > 0, nil
+}
+
+func (dr *digestRepositoryImpl) MarkSent(ctx context.Context, period string, start string, recipientID int64, sentAt time.Time) error {
+	query := fmt.Sprintf(`INSERT INTO %s (period, period_start, recipientID, sent_at) VALUES (?, ?, ?, ?)`, constant.TableDigestLog)
+	stmt, err := dr.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	if _, err := stmt.ExecContext(ctx, period, start, recipientID, sentAt); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package digest
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/internal/org"
+	"github.com/Risuii/internal/report"
+	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/digests"
+	"github.com/Risuii/models/reports"
+)
+
+// maxHighlights caps the activities listed per employee, the counts still
+// cover all of them.
+const maxHighlights = 5
+
+type (
+	DigestUseCase interface {
+		Compile(ctx context.Context, period string, now time.Time) ([]digests.Digest, error)
+		Deliver(ctx context.Context, now time.Time) (int, error)
+	}
+
+	digestUseCaseImpl struct {
+		repository    DigestRepository
+		reportUseCase report.ReportUseCase
+		chain         org.Chain
+		notifier      Notifier
+		schedule      digests.Schedule
+	}
+)
+
+func NewDigestUseCase(repo DigestRepository, reportUseCase report.ReportUseCase, chain org.Chain, notifier Notifier, schedule digests.Schedule) DigestUseCase {
+	return &digestUseCaseImpl{
+		repository:    repo,
+		reportUseCase: reportUseCase,
+		chain:         chain,
+		notifier:      notifier,
+		schedule:      schedule,
+	}
+}
+
+// Compile builds one digest per manager for the day of now, or for the seven
+// days up to it for a weekly digest. A digest covers the employees in the
+// manager's reporting chain, those without attendance, leave or activities
+// in that range are left out.
+func (du *digestUseCaseImpl) Compile(ctx context.Context, period string, now time.Time) ([]digests.Digest, error) {
+	digest := []digests.Digest{}
+
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	from := to
+	if period == digests.PeriodWeekly {
+		from = to.AddDate(0, 0, -6)
+	}
+	start := from.Format("2006-01-02")
+	end := to.Format("2006-01-02")
+
+	recipient, err := du.repository.FindRecipients(ctx)
+	if err != nil {
+		return digest, exception.ErrInternalServer
+	}
+
+	if len(recipient) == 0 {
+		return digest, nil
+	}
+
+	recap, err := du.reportUseCase.Calculate(ctx, 0, from, to)
+	if err != nil {
+		return digest, exception.ErrInternalServer
+	}
+
+	activity, err := du.repository.FindActivities(ctx, start, end)
+	if err != nil {
+		return digest, exception.ErrInternalServer
+	}
+
+	activityByUser := map[int64][]activitys.Activity{}
+	for _, a := range activity {
+		activityByUser[a.UserID] = append(activityByUser[a.UserID], a)
+	}
+
+	member := []digests.Member{}
+	for _, r := range recap {
+		m := summarize(r, activityByUser[r.UserID])
+		if r.DaysPresent+r.DaysAbsent+r.LeaveDays+m.Activities == 0 {
+			continue
+		}
+		member = append(member, m)
+	}
+
+	for _, r := range recipient {
+		reports, err := du.chain.Reports(ctx, r.UserID)
+		if err != nil {
+			return digest, exception.ErrInternalServer
+		}
+
+		team := make(map[int64]bool, len(reports))
+		for _, m := range reports {
+			team[m.UserID] = true
+		}
+
+		d := digests.Digest{
+			Period:    period,
+			From:      start,
+			To:        end,
+			Recipient: r,
+			Members:   []digests.Member{},
+		}
+
+		for _, m := range member {
+			if team[m.Attendance.UserID] {
+				d.Members = append(d.Members, m)
+			}
+		}
+
+		digest = append(digest, d)
+	}
+
+	return digest, nil
+}
+
+// Deliver sends the digests that are due at now and were not sent before,
+// the weekly digest goes out together with the daily one on the weekly day.
+// A failed notification is retried on the next run.
+func (du *digestUseCaseImpl) Deliver(ctx context.Context, now time.Time) (int, error) {
+	sent := 0
+
+	if now.Before(sendTime(du.schedule, now)) {
+		return sent, nil
+	}
+
+	period := []string{digests.PeriodDaily}
+	if now.Weekday() == du.schedule.WeeklyDay {
+		period = append(period, digests.PeriodWeekly)
+	}
+
+	var failed error
+
+	for _, p := range period {
+		digest, err := du.Compile(ctx, p, now)
+		if err != nil {
+			return sent, err
+		}
+
+		for _, d := range digest {
+			if len(d.Members) == 0 {
+				continue
+			}
+
+			done, err := du.repository.IsSent(ctx, d.Period, d.From, d.Recipient.UserID)
+			if err != nil {
+				return sent, exception.ErrInternalServer
+			}
+
+			if done {
+				continue
+			}
+
+			if err := du.notifier.Notify(ctx, d); err != nil {
+				log.Println(err)
+				failed = exception.ErrInternalServer
+				continue
+			}
+
+			if err := du.repository.MarkSent(ctx, d.Period, d.From, d.Recipient.UserID, now); err != nil {
+				return sent, exception.ErrInternalServer
+			}
+
+			sent++
+		}
+	}
+
+	return sent, failed
+}
+
+func summarize(recap reports.Recap, activity []activitys.Activity) digests.Member {
+	member := digests.Member{
+		Attendance: recap,
+		Activities: len(activity),
+		Highlights: []string{},
+	}
+
+	for _, a := range activity {
+		member.ActivityMinutes += a.Duration
+
+		if a.Status == activitys.StatusPending {
+			member.Pending++
+		}
+
+		if len(member.Highlights) < maxHighlights {
+			member.Highlights = append(member.Highlights, strings.Join(strings.Fields(a.Description), " "))
+		}
+	}
+
+	return member
+}
+
+// sendTime is the time of day of the schedule on the day of now, an invalid
+// time falls back to the end of the working day.
+func sendTime(s digests.Schedule, now time.Time) time.Time {
+	at, err := time.Parse("15:04", s.At)
+	if err != nil {
+		at, _ = time.Parse("15:04", "17:00")
+	}
+
+	return time.Date(now.Year(), now.Month(), now.Day(), at.Hour(), at.Minute(), 0, 0, now.Location())
+}
@@ -0,0 +1,273 @@
+package employee
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/users"
+)
+
+const maxUploadSize = 32 << 20
+
+type EmployeeHandler struct {
+	Validate *validator.Validate
+	UseCase  EmployeeUseCase
+}
+
+func NewEmployeeHandler(router *mux.Router, validate *validator.Validate, usecase EmployeeUseCase) {
+	handler := &EmployeeHandler{
+		Validate: validate,
+		UseCase:  usecase,
+	}
+
+	api := router.PathPrefix("/account").Subrouter()
+
+	api.HandleFunc("/employee", handler.List).Methods(http.MethodGet)
+	api.HandleFunc("/employee", handler.Create).Methods(http.MethodPost)
+	api.HandleFunc("/employee/import", handler.Import).Methods(http.MethodPost)
+	api.HandleFunc("/employee/invite", handler.Invite).Methods(http.MethodPost)
+	api.HandleFunc("/employee/{id}", handler.Get).Methods(http.MethodGet)
+	api.HandleFunc("/employee/{id}", handler.Update).Methods(http.MethodPatch)
+	api.HandleFunc("/employee/{id}/status", handler.SetStatus).Methods(http.MethodPatch)
+	api.HandleFunc("/employee/{id}/invite", handler.Reinvite).Methods(http.MethodPost)
+}
+
+func (handler *EmployeeHandler) List(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput users.EmployeeList
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.List(ctx, claims.Role, userInput)
+
+	res.JSON(w)
+}
+
+func (handler *EmployeeHandler) Get(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.ParseInt(params["id"], 10, 64)
+
+	res = handler.UseCase.Get(ctx, id, claims.Role)
+
+	res.JSON(w)
+}
+
+func (handler *EmployeeHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput users.EmployeeReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Create(ctx, claims.Role, userInput)
+
+	res.JSON(w)
+}
+
+func (handler *EmployeeHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput users.EmployeeReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.ParseInt(params["id"], 10, 64)
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Update(ctx, id, claims.Role, userInput)
+
+	res.JSON(w)
+}
+
+func (handler *EmployeeHandler) SetStatus(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput users.StatusReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.ParseInt(params["id"], 10, 64)
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.SetStatus(ctx, id, claims.ID, claims.Role, userInput)
+
+	res.JSON(w)
+}
+
+// Import accepts the CSV either as the "file" field of a multipart form or as
+// the raw request body. Nothing is written unless ?commit=true.
+func (handler *EmployeeHandler) Import(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	var file io.Reader = r.Body
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		upload, _, err := r.FormFile("file")
+		if err != nil {
+			res = response.Error(response.StatusUnprocessableEntity, err)
+			res.JSON(w)
+			return
+		}
+		defer upload.Close()
+
+		file = upload
+	}
+
+	dryRun := r.URL.Query().Get("commit") != "true"
+
+	res = handler.UseCase.Import(ctx, claims.Role, file, dryRun)
+
+	res.JSON(w)
+}
+
+func (handler *EmployeeHandler) Invite(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput users.InviteReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Invite(ctx, claims.Role, userInput)
+
+	res.JSON(w)
+}
+
+func (handler *EmployeeHandler) Reinvite(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.ParseInt(params["id"], 10, 64)
+
+	res = handler.UseCase.Reinvite(ctx, id, claims.Role)
+
+	res.JSON(w)
+}
@@ -0,0 +1,228 @@
+package employee
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/invites"
+	"github.com/Risuii/models/onboardings"
+	"github.com/Risuii/models/users"
+)
+
+// Import onboards the employees of a CSV upload. Every row is checked before
+// anything is written: emails go through the same lookup as registration,
+// codes must be free within the company and department, team and shift must
+// name existing ones. Valid rows are created without a password and get an
+// invite link mailed instead, nothing is written when dryRun is true.
+func (eu *employeeUseCaseImpl) Import(ctx context.Context, role string, r io.Reader, dryRun bool) response.Response {
+	if role != users.RoleAdmin {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	report, err := eu.onboard(ctx, r, dryRun)
+	if err == exception.ErrBadRequest {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if dryRun {
+		return response.Success(response.StatusOK, report)
+	}
+
+	return response.Success(response.StatusCreated, report)
+}
+
+func (eu *employeeUseCaseImpl) onboard(ctx context.Context, r io.Reader, dryRun bool) (onboardings.Report, error) {
+	report := onboardings.Report{
+		DryRun: dryRun,
+		Errors: []onboardings.ImportError{},
+		Hires:  []onboardings.Hire{},
+	}
+
+	lines, row, failed, err := ParseCSV(r, eu.validate)
+	if err != nil {
+		return report, exception.ErrBadRequest
+	}
+	report.Lines = lines
+	report.Errors = append(report.Errors, failed...)
+
+	places, err := eu.findPlaces(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	emails := map[string]int{}
+	codes := map[string]int{}
+
+	for _, rw := range row {
+		email := strings.ToLower(rw.Email)
+		if line, ok := emails[email]; ok {
+			report.Errors = append(report.Errors, onboardings.ImportError{Line: rw.Line, Reason: fmt.Sprintf("email already in line %d", line)})
+			continue
+		}
+		emails[email] = rw.Line
+
+		_, err := eu.accounts.FindByEmail(ctx, rw.Email)
+		if err == nil {
+			report.Errors = append(report.Errors, onboardings.ImportError{Line: rw.Line, Reason: "email already registered"})
+			continue
+		}
+		if err != exception.ErrNotFound {
+			return report, exception.ErrInternalServer
+		}
+
+		if rw.Code != "" {
+			code := strings.ToLower(rw.Code)
+			if line, ok := codes[code]; ok {
+				report.Errors = append(report.Errors, onboardings.ImportError{Line: rw.Line, Reason: fmt.Sprintf("code already in line %d", line)})
+				continue
+			}
+			codes[code] = rw.Line
+
+			_, err := eu.repository.FindByCode(ctx, rw.Code)
+			if err == nil {
+				report.Errors = append(report.Errors, onboardings.ImportError{Line: rw.Line, Reason: "code already used"})
+				continue
+			}
+			if err != exception.ErrNotFound {
+				return report, exception.ErrInternalServer
+			}
+		}
+
+		hire, reason := places.resolve(rw)
+		if reason != "" {
+			report.Errors = append(report.Errors, onboardings.ImportError{Line: rw.Line, Reason: reason})
+			continue
+		}
+
+		report.Hires = append(report.Hires, hire)
+	}
+
+	if dryRun || len(report.Hires) == 0 {
+		return report, nil
+	}
+
+	for i := range report.Hires {
+		token, err := eu.invites.Token()
+		if err != nil {
+			return report, exception.ErrInternalServer
+		}
+		report.Hires[i].TokenHash = token.Hash
+		report.Hires[i].ExpiresAt = token.ExpiresAt
+		report.Hires[i].Link = token.Link
+	}
+
+	IDs, err := eu.repository.Onboard(ctx, report.Hires)
+	if err != nil {
+		return report, exception.ErrInternalServer
+	}
+
+	for i := range report.Hires {
+		report.Hires[i].UserID = IDs[i]
+		report.Hires[i].Mailed = eu.deliver(ctx, report.Hires[i])
+	}
+	report.Created = len(IDs)
+
+	return report, nil
+}
+
+// deliver mails the invite of a hire that is already stored. A failed mail
+// doesn't undo the hire, the admin still has the link and can send a new
+// invite later.
+func (eu *employeeUseCaseImpl) deliver(ctx context.Context, hire onboardings.Hire) bool {
+	token := invites.Token{
+		Hash:      hire.TokenHash,
+		ExpiresAt: hire.ExpiresAt,
+		Link:      hire.Link,
+	}
+
+	if err := eu.invites.Deliver(ctx, hire.Name, hire.Email, token); err != nil {
+		log.Println(err)
+		return false
+	}
+
+	return true
+}
+
+// places looks departments, teams and shifts up by their case insensitive
+// name.
+type places struct {
+	departments map[string]int64
+	teams       map[int64]map[string]int64
+	shifts      map[string]int64
+}
+
+func (eu *employeeUseCaseImpl) findPlaces(ctx context.Context) (places, error) {
+	p := places{
+		departments: map[string]int64{},
+		teams:       map[int64]map[string]int64{},
+		shifts:      map[string]int64{},
+	}
+
+	department, err := eu.org.FindDepartments(ctx)
+	if err != nil {
+		return p, exception.ErrInternalServer
+	}
+	for _, d := range department {
+		p.departments[strings.ToLower(d.Name)] = d.ID
+	}
+
+	team, err := eu.org.FindTeams(ctx, 0)
+	if err != nil {
+		return p, exception.ErrInternalServer
+	}
+	for _, t := range team {
+		if p.teams[t.DepartmentID] == nil {
+			p.teams[t.DepartmentID] = map[string]int64{}
+		}
+		p.teams[t.DepartmentID][strings.ToLower(t.Name)] = t.ID
+	}
+
+	shift, err := eu.repository.FindShifts(ctx)
+	if err != nil {
+		return p, exception.ErrInternalServer
+	}
+	for _, s := range shift {
+		p.shifts[strings.ToLower(s.Name)] = s.ID
+	}
+
+	return p, nil
+}
+
+// resolve turns the names of a row into IDs, the reason is empty when all of
+// them exist.
+func (p places) resolve(rw onboardings.Row) (onboardings.Hire, string) {
+	hire := onboardings.Hire{Row: rw}
+
+	if rw.Department != "" {
+		departmentID, ok := p.departments[strings.ToLower(rw.Department)]
+		if !ok {
+			return hire, fmt.Sprintf("unknown department %q", rw.Department)
+		}
+
+		if rw.Team != "" {
+			teamID, ok := p.teams[departmentID][strings.ToLower(rw.Team)]
+			if !ok {
+				return hire, fmt.Sprintf("unknown team %q in department %q", rw.Team, rw.Department)
+			}
+			hire.TeamID = teamID
+		}
+	}
+
+	if rw.Shift != "" {
+		shiftID, ok := p.shifts[strings.ToLower(rw.Shift)]
+		if !ok {
+			return hire, fmt.Sprintf("unknown shift %q", rw.Shift)
+		}
+		hire.ShiftID = shiftID
+	}
+
+	return hire, ""
+}
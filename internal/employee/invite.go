@@ -0,0 +1,95 @@
+package employee
+
+import (
+	"context"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/onboardings"
+	"github.com/Risuii/models/users"
+)
+
+// Invite creates an employee without a password and mails them the link to
+// set one, the same way an onboarding CSV row is created.
+func (eu *employeeUseCaseImpl) Invite(ctx context.Context, role string, params users.InviteReq) response.Response {
+	if role != users.RoleAdmin {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	if res := eu.checkUnique(ctx, 0, params.Email, params.Code); res != nil {
+		return res
+	}
+
+	token, err := eu.invites.Token()
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	hire := onboardings.Hire{
+		Row: onboardings.Row{
+			Name:  params.Name,
+			Email: params.Email,
+			Code:  params.Code,
+			Role:  params.Role,
+		},
+		TokenHash: token.Hash,
+		ExpiresAt: token.ExpiresAt,
+		Link:      token.Link,
+	}
+
+	IDs, err := eu.repository.Onboard(ctx, []onboardings.Hire{hire})
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	hire.UserID = IDs[0]
+	hire.Mailed = eu.deliver(ctx, hire)
+
+	return response.Success(response.StatusCreated, hire)
+}
+
+// Reinvite sends a new invite to an employee who hasn't set a password yet,
+// earlier invites stay valid until they expire or one of them is accepted.
+func (eu *employeeUseCaseImpl) Reinvite(ctx context.Context, id int64, role string) response.Response {
+	if role != users.RoleAdmin {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	employee, err := eu.repository.FindByID(ctx, id)
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if employee.Password != "" {
+		return response.Error(response.StatusConflicted, exception.ErrConflicted)
+	}
+
+	token, err := eu.invites.Token()
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if err := eu.repository.CreateInvite(ctx, employee.ID, token.Hash, token.ExpiresAt); err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	hire := onboardings.Hire{
+		Row: onboardings.Row{
+			Name:  employee.Name,
+			Email: employee.Email,
+			Code:  employee.Code,
+			Role:  employee.Role,
+		},
+		UserID:    employee.ID,
+		TokenHash: token.Hash,
+		ExpiresAt: token.ExpiresAt,
+		Link:      token.Link,
+	}
+	hire.Mailed = eu.deliver(ctx, hire)
+
+	return response.Success(response.StatusCreated, hire)
+}
@@ -0,0 +1,134 @@
+package employee
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/models/onboardings"
+)
+
+// columns maps the accepted CSV headers to the field they fill, headers not
+// listed here are ignored.
+var columns = map[string]string{
+	"name":          "name",
+	"email":         "email",
+	"code":          "code",
+	"employee_code": "code",
+	"department":    "department",
+	"team":          "team",
+	"shift":         "shift",
+	"role":          "role",
+}
+
+// ParseCSV reads the onboarding CSV. The first record is the header, name and
+// email are required, the other columns may be left out. Rows failing
+// validate are returned as errors with their line number alongside the
+// number of non blank rows read.
+func ParseCSV(r io.Reader, validate *validator.Validate) (int, []onboardings.Row, []onboardings.ImportError, error) {
+	row := []onboardings.Row{}
+	failed := []onboardings.ImportError{}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return 0, row, failed, exception.ErrBadRequest
+	}
+
+	index := map[string]int{}
+	for i, h := range header {
+		name := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))
+		if field, ok := columns[name]; ok {
+			index[field] = i
+		}
+	}
+
+	if _, ok := index["name"]; !ok {
+		return 0, row, failed, exception.ErrBadRequest
+	}
+	if _, ok := index["email"]; !ok {
+		return 0, row, failed, exception.ErrBadRequest
+	}
+
+	lines := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if parseErr, ok := err.(*csv.ParseError); ok {
+			failed = append(failed, onboardings.ImportError{Line: parseErr.StartLine, Reason: parseErr.Err.Error()})
+			continue
+		}
+		if err != nil {
+			return lines, row, failed, exception.ErrBadRequest
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		if blank(record) {
+			continue
+		}
+		lines++
+
+		field := func(name string) string {
+			i, ok := index[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		r := onboardings.Row{
+			Line:       line,
+			Name:       field("name"),
+			Email:      field("email"),
+			Code:       field("code"),
+			Department: field("department"),
+			Team:       field("team"),
+			Shift:      field("shift"),
+			Role:       strings.ToLower(field("role")),
+		}
+
+		if err := validate.Struct(r); err != nil {
+			failed = append(failed, onboardings.ImportError{Line: line, Reason: reason(err)})
+			continue
+		}
+
+		row = append(row, r)
+	}
+
+	return lines, row, failed, nil
+}
+
+func blank(record []string) bool {
+	for _, f := range record {
+		if strings.TrimSpace(f) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// reason turns validator errors into a short message per field.
+func reason(err error) string {
+	validation, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return err.Error()
+	}
+
+	msg := []string{}
+	for _, fe := range validation {
+		msg = append(msg, fmt.Sprintf("%s failed on %s", strings.ToLower(fe.Field()), fe.Tag()))
+	}
+
+	return strings.Join(msg, ", ")
+}
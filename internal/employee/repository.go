@@ -0,0 +1,362 @@
+package employee
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/models/onboardings"
+	"github.com/Risuii/models/paginations"
+	"github.com/Risuii/models/shifts"
+	"github.com/Risuii/models/users"
+)
+
+type (
+	EmployeeRepository interface {
+		List(ctx context.Context, params users.EmployeeList) ([]users.Employee, error)
+		Count(ctx context.Context, params users.EmployeeList) (int64, error)
+		FindByID(ctx context.Context, id int64) (users.Employee, error)
+		FindByCode(ctx context.Context, code string) (users.Employee, error)
+		Create(ctx context.Context, params users.Employee) (int64, error)
+		Update(ctx context.Context, params users.Employee) error
+		SetStatus(ctx context.Context, id int64, status string) error
+		FindShifts(ctx context.Context) ([]shifts.Shift, error)
+		Onboard(ctx context.Context, hires []onboardings.Hire) ([]int64, error)
+		CreateInvite(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error
+	}
+
+	employeeRepositoryImpl struct {
+		db *sql.DB
+	}
+)
+
+func NewEmployeeRepositoryImpl(db *sql.DB) EmployeeRepository {
+	return &employeeRepositoryImpl{
+		db: db,
+	}
+}
+
+const employeeColumns = `id, name, password, email, role, code, status, company_id, created_at, update_at`
+
+func (er *employeeRepositoryImpl) List(ctx context.Context, params users.EmployeeList) ([]users.Employee, error) {
+	employee := []users.Employee{}
+
+	where, args := listFilter(ctx, params)
+
+	if params.Cursor > 0 {
+		if params.Sort == paginations.SortDesc {
+			where += " AND id < ?"
+		} else {
+			where += " AND id > ?"
+		}
+		args = append(args, params.Cursor)
+	}
+
+	order := "ASC"
+	if params.Sort == paginations.SortDesc {
+		order = "DESC"
+	}
+
+	args = append(args, params.Limit)
+
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE %s ORDER BY id %s LIMIT ?`, employeeColumns, constant.TableEmployee, where, order)
+	rows, err := er.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return employee, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		e, err := scanEmployee(rows)
+		if err != nil {
+			log.Println(err)
+			return employee, exception.ErrInternalServer
+		}
+		e.Password = ""
+		employee = append(employee, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return employee, exception.ErrInternalServer
+	}
+
+	return employee, nil
+}
+
+func (er *employeeRepositoryImpl) Count(ctx context.Context, params users.EmployeeList) (int64, error) {
+	var total int64
+
+	where, args := listFilter(ctx, params)
+
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s`, constant.TableEmployee, where)
+	if err := er.db.QueryRowContext(ctx, query, args...).Scan(&total); err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	return total, nil
+}
+
+func listFilter(ctx context.Context, params users.EmployeeList) (string, []interface{}) {
+	where := []string{"company_id = ?"}
+	args := []interface{}{tenant.ID(ctx)}
+
+	if params.Query != "" {
+		like := "%" + params.Query + "%"
+		where = append(where, "(name LIKE ? OR email LIKE ? OR code LIKE ?)")
+		args = append(args, like, like, like)
+	}
+
+	if params.Role != "" {
+		where = append(where, "role = ?")
+		args = append(args, params.Role)
+	}
+
+	if params.Status != "" {
+		where = append(where, "status = ?")
+		args = append(args, params.Status)
+	}
+
+	return strings.Join(where, " AND "), args
+}
+
+func (er *employeeRepositoryImpl) FindByID(ctx context.Context, id int64) (users.Employee, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE id = ? AND company_id = ?`, employeeColumns, constant.TableEmployee)
+	return er.findOne(ctx, query, id, tenant.ID(ctx))
+}
+
+func (er *employeeRepositoryImpl) FindByCode(ctx context.Context, code string) (users.Employee, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE code = ? AND company_id = ?`, employeeColumns, constant.TableEmployee)
+	return er.findOne(ctx, query, code, tenant.ID(ctx))
+}
+
+func (er *employeeRepositoryImpl) Create(ctx context.Context, params users.Employee) (int64, error) {
+	query := fmt.Sprintf(`INSERT INTO %s (name, password, email, role, code, status, company_id, created_at, update_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, constant.TableEmployee)
+	stmt, err := er.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		params.Name,
+		params.Password,
+		params.Email,
+		params.Role,
+		nullString(params.Code),
+		params.Status,
+		tenant.ID(ctx),
+		params.CreatedAt,
+		params.UpdateAt,
+	)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	ID, err := result.LastInsertId()
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	return ID, nil
+}
+
+func (er *employeeRepositoryImpl) Update(ctx context.Context, params users.Employee) error {
+	query := fmt.Sprintf(`UPDATE %s SET name = ?, password = ?, email = ?, role = ?, code = ?, update_at = ? WHERE id = ? AND company_id = ?`, constant.TableEmployee)
+	return er.exec(ctx, query, params.Name, params.Password, params.Email, params.Role, nullString(params.Code), params.UpdateAt, params.ID, tenant.ID(ctx))
+}
+
+func (er *employeeRepositoryImpl) SetStatus(ctx context.Context, id int64, status string) error {
+	query := fmt.Sprintf(`UPDATE %s SET status = ? WHERE id = ? AND company_id = ?`, constant.TableEmployee)
+	return er.exec(ctx, query, status, id, tenant.ID(ctx))
+}
+
+func (er *employeeRepositoryImpl) FindShifts(ctx context.Context) ([]shifts.Shift, error) {
+	shift := []shifts.Shift{}
+
+	query := fmt.Sprintf(`SELECT id, name FROM %s WHERE company_id = ? ORDER BY id`, constant.TableShift)
+	rows, err := er.db.QueryContext(ctx, query, tenant.ID(ctx))
+	if err != nil {
+		log.Println(err)
+		return shift, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var s shifts.Shift
+		if err := rows.Scan(
+			&s.ID,
+			&s.Name,
+		); err != nil {
+			log.Println(err)
+			return shift, exception.ErrInternalServer
+		}
+		shift = append(shift, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return shift, exception.ErrInternalServer
+	}
+
+	return shift, nil
+}
+
+// Onboard creates the hires without a password together with their invites,
+// either all of them or none. It returns the new employee IDs in order.
+func (er *employeeRepositoryImpl) Onboard(ctx context.Context, hires []onboardings.Hire) ([]int64, error) {
+	IDs := []int64{}
+
+	tx, err := er.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println(err)
+		return IDs, exception.ErrInternalServer
+	}
+
+	defer tx.Rollback()
+
+	employee := fmt.Sprintf(`INSERT INTO %s (name, password, email, role, code, status, team_id, shift_id, company_id, created_at, update_at) VALUES (?, '', ?, ?, ?, ?, ?, ?, ?, ?, ?)`, constant.TableEmployee)
+	invite := fmt.Sprintf(`INSERT INTO %s (userID, token_hash, expires_at, created_at) VALUES (?, ?, ?, ?)`, constant.TableInvite)
+
+	now := time.Now()
+
+	for _, h := range hires {
+		role := h.Role
+		if role == "" {
+			role = users.RoleEmployee
+		}
+
+		result, err := tx.ExecContext(ctx, employee, h.Name, h.Email, role, nullString(h.Code), users.StatusActive, nullID(h.TeamID), nullID(h.ShiftID), tenant.ID(ctx), now, now)
+		if err != nil {
+			log.Println(err)
+			return []int64{}, exception.ErrInternalServer
+		}
+
+		ID, err := result.LastInsertId()
+		if err != nil {
+			log.Println(err)
+			return []int64{}, exception.ErrInternalServer
+		}
+
+		if _, err := tx.ExecContext(ctx, invite, ID, h.TokenHash, h.ExpiresAt, now); err != nil {
+			log.Println(err)
+			return []int64{}, exception.ErrInternalServer
+		}
+
+		IDs = append(IDs, ID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return []int64{}, exception.ErrInternalServer
+	}
+
+	return IDs, nil
+}
+
+func (er *employeeRepositoryImpl) CreateInvite(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error {
+	query := fmt.Sprintf(`INSERT INTO %s (userID, token_hash, expires_at, created_at) VALUES (?, ?, ?, ?)`, constant.TableInvite)
+	return er.exec(ctx, query, userID, tokenHash, expiresAt, time.Now())
+}
+
+func (er *employeeRepositoryImpl) findOne(ctx context.Context, query string, args ...interface{}) (users.Employee, error) {
+	stmt, err := er.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return users.Employee{}, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	employee, err := scanEmployee(stmt.QueryRowContext(ctx, args...))
+	if err == sql.ErrNoRows {
+		return users.Employee{}, exception.ErrNotFound
+	}
+
+	if err != nil {
+		log.Println(err)
+		return users.Employee{}, exception.ErrInternalServer
+	}
+
+	return employee, nil
+}
+
+func (er *employeeRepositoryImpl) exec(ctx context.Context, query string, args ...interface{}) error {
+	stmt, err := er.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	if _, err := stmt.ExecContext(ctx, args...); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanEmployee(row scanner) (users.Employee, error) {
+	var e users.Employee
+	var name, password, email, code sql.NullString
+	var createdAt, updateAt sql.NullTime
+
+	if err := row.Scan(
+		&e.ID,
+		&name,
+		&password,
+		&email,
+		&e.Role,
+		&code,
+		&e.Status,
+		&e.CompanyID,
+		&createdAt,
+		&updateAt,
+	); err != nil {
+		return users.Employee{}, err
+	}
+
+	e.Name = name.String
+	e.Password = password.String
+	e.Email = email.String
+	e.Code = code.String
+	e.CreatedAt = createdAt.Time
+	e.UpdateAt = updateAt.Time
+
+	return e, nil
+}
+
+func nullID(id int64) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
+func nullString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
@@ -0,0 +1,253 @@
+package employee
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/Risuii/config/bcrypt"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/invite"
+	"github.com/Risuii/internal/org"
+	"github.com/Risuii/internal/user"
+	"github.com/Risuii/models/users"
+)
+
+type (
+	EmployeeUseCase interface {
+		List(ctx context.Context, role string, params users.EmployeeList) response.Response
+		Get(ctx context.Context, id int64, role string) response.Response
+		Create(ctx context.Context, role string, params users.EmployeeReq) response.Response
+		Update(ctx context.Context, id int64, role string, params users.EmployeeReq) response.Response
+		SetStatus(ctx context.Context, id int64, userID int64, role string, params users.StatusReq) response.Response
+		Import(ctx context.Context, role string, r io.Reader, dryRun bool) response.Response
+		Invite(ctx context.Context, role string, params users.InviteReq) response.Response
+		Reinvite(ctx context.Context, id int64, role string) response.Response
+	}
+
+	employeeUseCaseImpl struct {
+		repository EmployeeRepository
+		accounts   user.UserRepository
+		org        org.OrgRepository
+		bcrypt     bcrypt.Bcrypt
+		validate   *validator.Validate
+		invites    invite.Sender
+	}
+)
+
+// NewEmployeeUseCase validates imported CSV rows with validate, employees
+// created without a password get their invite through invites.
+func NewEmployeeUseCase(repo EmployeeRepository, accounts user.UserRepository, org org.OrgRepository, bcrypt bcrypt.Bcrypt, validate *validator.Validate, invites invite.Sender) EmployeeUseCase {
+	return &employeeUseCaseImpl{
+		repository: repo,
+		accounts:   accounts,
+		org:        org,
+		bcrypt:     bcrypt,
+		validate:   validate,
+		invites:    invites,
+	}
+}
+
+func (eu *employeeUseCaseImpl) List(ctx context.Context, role string, params users.EmployeeList) response.Response {
+	if role != users.RoleAdmin {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	params.Query = strings.TrimSpace(params.Query)
+	params.Pagination = params.Pagination.Normalize()
+
+	employee, err := eu.repository.List(ctx, params)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	total, err := eu.repository.Count(ctx, params)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	page := response.Page{
+		Limit: params.Limit,
+		Sort:  params.Sort,
+		Total: total,
+	}
+
+	if len(employee) == params.Limit {
+		page.NextCursor = employee[len(employee)-1].ID
+	}
+
+	return response.SuccessWithPage(response.StatusOK, employee, page)
+}
+
+func (eu *employeeUseCaseImpl) Get(ctx context.Context, id int64, role string) response.Response {
+	if role != users.RoleAdmin {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	employee, err := eu.repository.FindByID(ctx, id)
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	employee.Password = ""
+
+	return response.Success(response.StatusOK, employee)
+}
+
+// Create adds an active employee to the company of the admin. Emails are
+// unique across companies and codes within the company.
+func (eu *employeeUseCaseImpl) Create(ctx context.Context, role string, params users.EmployeeReq) response.Response {
+	if role != users.RoleAdmin {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	if params.Password == "" {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	if res := eu.checkUnique(ctx, 0, params.Email, params.Code); res != nil {
+		return res
+	}
+
+	hashedPassword, err := eu.bcrypt.HashPassword(params.Password)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if params.Role == "" {
+		params.Role = users.RoleEmployee
+	}
+
+	now := time.Now()
+
+	employee := users.Employee{
+		Name:      params.Name,
+		Password:  hashedPassword,
+		Email:     params.Email,
+		Role:      params.Role,
+		Code:      params.Code,
+		Status:    users.StatusActive,
+		CreatedAt: now,
+		UpdateAt:  now,
+	}
+
+	ID, err := eu.repository.Create(ctx, employee)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	employee.ID = ID
+	employee.Password = ""
+
+	return response.Success(response.StatusCreated, employee)
+}
+
+func (eu *employeeUseCaseImpl) Update(ctx context.Context, id int64, role string, params users.EmployeeReq) response.Response {
+	if role != users.RoleAdmin {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	employee, err := eu.repository.FindByID(ctx, id)
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if res := eu.checkUnique(ctx, id, params.Email, params.Code); res != nil {
+		return res
+	}
+
+	if params.Password != "" {
+		hashedPassword, err := eu.bcrypt.HashPassword(params.Password)
+		if err != nil {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+		employee.Password = hashedPassword
+	}
+
+	if params.Role != "" {
+		employee.Role = params.Role
+	}
+
+	employee.Name = params.Name
+	employee.Email = params.Email
+	employee.Code = params.Code
+	employee.UpdateAt = time.Now()
+
+	if err := eu.repository.Update(ctx, employee); err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	employee.Password = ""
+
+	return response.Success(response.StatusOK, employee)
+}
+
+// SetStatus suspends, terminates or reactivates an employee. Admins can't
+// change their own status so a company is never locked out by accident.
+func (eu *employeeUseCaseImpl) SetStatus(ctx context.Context, id int64, userID int64, role string, params users.StatusReq) response.Response {
+	if role != users.RoleAdmin {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	if id == userID {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	employee, err := eu.repository.FindByID(ctx, id)
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if err := eu.repository.SetStatus(ctx, id, params.Status); err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	employee.Password = ""
+	employee.Status = params.Status
+
+	return response.Success(response.StatusOK, employee)
+}
+
+// checkUnique returns a conflict when the email or code already belongs to
+// an employee other than id.
+func (eu *employeeUseCaseImpl) checkUnique(ctx context.Context, id int64, email string, code string) response.Response {
+	existing, err := eu.accounts.FindByEmail(ctx, email)
+	if err == nil && existing.ID != id {
+		return response.Error(response.StatusConflicted, exception.ErrConflicted)
+	}
+
+	if err != nil && err != exception.ErrNotFound {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if code == "" {
+		return nil
+	}
+
+	existing, err = eu.repository.FindByCode(ctx, code)
+	if err == nil && existing.ID != id {
+		return response.Error(response.StatusConflicted, exception.ErrConflicted)
+	}
+
+	if err != nil && err != exception.ErrNotFound {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package fingerprint
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/response"
+)
+
+const maxUploadSize = 32 << 20
+
+type FingerprintHandler struct {
+	UseCase FingerprintUseCase
+}
+
+func NewFingerprintHandler(router *mux.Router, usecase FingerprintUseCase) {
+	handler := &FingerprintHandler{
+		UseCase: usecase,
+	}
+
+	api := router.PathPrefix("/account").Subrouter()
+
+	api.HandleFunc("/absensi/import", handler.Import).Methods(http.MethodPost)
+}
+
+// Import accepts the device log either as the "file" field of a multipart
+// form or as the raw request body. Nothing is written unless ?commit=true.
+func (handler *FingerprintHandler) Import(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	var file io.Reader = r.Body
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		upload, _, err := r.FormFile("file")
+		if err != nil {
+			res = response.Error(response.StatusUnprocessableEntity, err)
+			res.JSON(w)
+			return
+		}
+		defer upload.Close()
+
+		file = upload
+	}
+
+	dryRun := r.URL.Query().Get("commit") != "true"
+
+	res = handler.UseCase.ImportFile(ctx, claims.Role, file, dryRun)
+
+	res.JSON(w)
+}
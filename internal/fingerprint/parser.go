@@ -0,0 +1,82 @@
+package fingerprint
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Risuii/models/punches"
+)
+
+// Parse reads a ZKTeco style attendance log: one punch per line with tab
+// separated user id, timestamp and state, optionally followed by columns the
+// device adds (verify mode, work code) which are ignored. States 0, 3 and 4
+// (check-in, break-in, overtime-in) are check ins, 1, 2 and 5 check outs.
+// It returns the number of non blank lines read alongside the punches.
+func Parse(r io.Reader, loc *time.Location) (int, []punches.Punch, []punches.ImportError, error) {
+	lines := 0
+	punch := []punches.Punch{}
+	failed := []punches.ImportError{}
+
+	scanner := bufio.NewScanner(r)
+	number := 0
+	for scanner.Scan() {
+		number++
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		lines++
+
+		fields := strings.Split(line, "\t")
+		if len(fields) < 3 {
+			failed = append(failed, punches.ImportError{Line: number, Reason: "expected user id, timestamp and state"})
+			continue
+		}
+
+		deviceUserID := strings.TrimSpace(fields[0])
+		if deviceUserID == "" {
+			failed = append(failed, punches.ImportError{Line: number, Reason: "empty user id"})
+			continue
+		}
+
+		timestamp, err := time.ParseInLocation("2006-01-02 15:04:05", strings.TrimSpace(fields[1]), loc)
+		if err != nil {
+			failed = append(failed, punches.ImportError{Line: number, Reason: fmt.Sprintf("invalid timestamp %q", fields[1])})
+			continue
+		}
+
+		state, ok := parseState(strings.TrimSpace(fields[2]))
+		if !ok {
+			failed = append(failed, punches.ImportError{Line: number, Reason: fmt.Sprintf("unknown state %q", fields[2])})
+			continue
+		}
+
+		punch = append(punch, punches.Punch{
+			Line:         number,
+			DeviceUserID: deviceUserID,
+			Time:         timestamp,
+			State:        state,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return lines, punch, failed, err
+	}
+
+	return lines, punch, failed, nil
+}
+
+func parseState(state string) (string, bool) {
+	switch state {
+	case "0", "3", "4":
+		return punches.StateIn, true
+	case "1", "2", "5":
+		return punches.StateOut, true
+	}
+
+	return "", false
+}
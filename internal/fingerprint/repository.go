@@ -0,0 +1,147 @@
+package fingerprint
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/punches"
+)
+
+type (
+	FingerprintRepository interface {
+		FindDeviceUsers(ctx context.Context) ([]punches.DeviceUser, error)
+		FindSessions(ctx context.Context, userID int64, from string, to string) ([]absensis.Absensi, error)
+		Commit(ctx context.Context, plan punches.Plan) error
+	}
+
+	fingerprintRepositoryImpl struct {
+		db *sql.DB
+	}
+)
+
+func NewFingerprintRepositoryImpl(db *sql.DB) FingerprintRepository {
+	return &fingerprintRepositoryImpl{
+		db: db,
+	}
+}
+
+func (fr *fingerprintRepositoryImpl) FindDeviceUsers(ctx context.Context) ([]punches.DeviceUser, error) {
+	deviceUser := []punches.DeviceUser{}
+
+	query := fmt.Sprintf(`SELECT device_user_id, id, name FROM %s WHERE company_id = ? AND device_user_id IS NOT NULL`, constant.TableEmployee)
+	rows, err := fr.db.QueryContext(ctx, query, tenant.ID(ctx))
+	if err != nil {
+		log.Println(err)
+		return deviceUser, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var d punches.DeviceUser
+		if err := rows.Scan(
+			&d.DeviceUserID,
+			&d.UserID,
+			&d.Name,
+		); err != nil {
+			log.Println(err)
+			return deviceUser, exception.ErrInternalServer
+		}
+		deviceUser = append(deviceUser, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return deviceUser, exception.ErrInternalServer
+	}
+
+	return deviceUser, nil
+}
+
+// FindSessions returns the absen rows of userID with a checkin between from
+// and to, which an import is checked against for duplicates.
+func (fr *fingerprintRepositoryImpl) FindSessions(ctx context.Context, userID int64, from string, to string) ([]absensis.Absensi, error) {
+	absensi := []absensis.Absensi{}
+
+	query := fmt.Sprintf(`SELECT id, userID, name, checkin, checkout, date, status FROM %s WHERE userID = ? AND company_id = ? AND date BETWEEN ? AND ? AND checkin IS NOT NULL ORDER BY checkin`, constant.TableAbsensi)
+	rows, err := fr.db.QueryContext(ctx, query, userID, tenant.ID(ctx), from, to)
+	if err != nil {
+		log.Println(err)
+		return absensi, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var c absensis.Absensi
+		var checkout, date sql.NullTime
+		if err := rows.Scan(
+			&c.ID,
+			&c.UserID,
+			&c.Name,
+			&c.Checkin,
+			&checkout,
+			&date,
+			&c.Status,
+		); err != nil {
+			log.Println(err)
+			return absensi, exception.ErrInternalServer
+		}
+		c.Checkout = checkout.Time
+		c.Date = date.Time
+		absensi = append(absensi, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return absensi, exception.ErrInternalServer
+	}
+
+	return absensi, nil
+}
+
+// Commit writes the whole plan in one transaction so a failed import leaves
+// absen untouched.
+func (fr *fingerprintRepositoryImpl) Commit(ctx context.Context, plan punches.Plan) error {
+	tx, err := fr.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer tx.Rollback()
+
+	insert := fmt.Sprintf(`INSERT INTO %s (userID, name, checkin, checkout, date, status, source, company_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`, constant.TableAbsensi)
+	for _, s := range plan.Sessions {
+		var checkout interface{}
+		if !s.Checkout.IsZero() {
+			checkout = s.Checkout
+		}
+
+		if _, err := tx.ExecContext(ctx, insert, s.UserID, s.Name, s.Checkin, checkout, s.Date.Format("2006-01-02"), s.Status, punches.SourceDevice, tenant.ID(ctx)); err != nil {
+			log.Println(err)
+			return exception.ErrInternalServer
+		}
+	}
+
+	update := fmt.Sprintf(`UPDATE %s SET checkout = ? WHERE id = ? AND company_id = ? AND checkout IS NULL`, constant.TableAbsensi)
+	for _, c := range plan.Checkouts {
+		if _, err := tx.ExecContext(ctx, update, c.Checkout, c.AbsenID, tenant.ID(ctx)); err != nil {
+			log.Println(err)
+			return exception.ErrInternalServer
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+package fingerprint
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/punches"
+	"github.com/Risuii/models/users"
+)
+
+type (
+	FingerprintUseCase interface {
+		Import(ctx context.Context, r io.Reader, dryRun bool) (punches.Report, error)
+		ImportFile(ctx context.Context, role string, r io.Reader, dryRun bool) response.Response
+	}
+
+	fingerprintUseCaseImpl struct {
+		repository FingerprintRepository
+		location   *time.Location
+	}
+
+	// session is an absen row an import can pair punches with, either one
+	// already stored (index -1) or one the plan is about to create.
+	session struct {
+		absensi absensis.Absensi
+		planned int
+	}
+)
+
+// NewFingerprintUseCase reads device timestamps in loc, the timezone the
+// devices are set to.
+func NewFingerprintUseCase(repo FingerprintRepository, loc *time.Location) FingerprintUseCase {
+	return &fingerprintUseCaseImpl{
+		repository: repo,
+		location:   loc,
+	}
+}
+
+// Import parses a device log and plans the absen changes it implies. Check
+// ins open a session and check outs close the latest open session of the
+// same day, punches already stored are counted as duplicates. The plan is
+// only written when dryRun is false.
+func (fu *fingerprintUseCaseImpl) Import(ctx context.Context, r io.Reader, dryRun bool) (punches.Report, error) {
+	report := punches.Report{
+		DryRun: dryRun,
+		Errors: []punches.ImportError{},
+		Plan: punches.Plan{
+			Sessions:  []absensis.Absensi{},
+			Checkouts: []punches.Checkout{},
+		},
+	}
+
+	lines, punch, failed, err := Parse(r, fu.location)
+	if err != nil {
+		return report, exception.ErrBadRequest
+	}
+	report.Lines = lines
+	report.Punches = len(punch)
+	report.Errors = append(report.Errors, failed...)
+
+	deviceUser, err := fu.repository.FindDeviceUsers(ctx)
+	if err != nil {
+		return report, exception.ErrInternalServer
+	}
+
+	employee := map[string]punches.DeviceUser{}
+	for _, d := range deviceUser {
+		employee[d.DeviceUserID] = d
+	}
+
+	byUser := map[int64][]punches.Punch{}
+	userIDs := []int64{}
+	for _, p := range punch {
+		d, ok := employee[p.DeviceUserID]
+		if !ok {
+			report.Errors = append(report.Errors, punches.ImportError{Line: p.Line, Reason: fmt.Sprintf("unknown device user %q", p.DeviceUserID)})
+			continue
+		}
+
+		if _, ok := byUser[d.UserID]; !ok {
+			userIDs = append(userIDs, d.UserID)
+		}
+		byUser[d.UserID] = append(byUser[d.UserID], p)
+	}
+
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+
+	for _, userID := range userIDs {
+		punch := byUser[userID]
+		sort.SliceStable(punch, func(i, j int) bool { return punch[i].Time.Before(punch[j].Time) })
+
+		from := punch[0].Time.Format("2006-01-02")
+		to := punch[len(punch)-1].Time.Format("2006-01-02")
+
+		stored, err := fu.repository.FindSessions(ctx, userID, from, to)
+		if err != nil {
+			return report, exception.ErrInternalServer
+		}
+
+		sessions := []*session{}
+		for _, s := range stored {
+			sessions = append(sessions, &session{absensi: s, planned: -1})
+		}
+
+		for _, p := range punch {
+			if duplicate(sessions, p) {
+				report.Duplicates++
+				continue
+			}
+
+			if p.State == punches.StateIn {
+				d := employee[p.DeviceUserID]
+				sessions = append(sessions, &session{
+					absensi: absensis.Absensi{
+						UserID:  d.UserID,
+						Name:    d.Name,
+						Checkin: p.Time,
+						Date:    p.Time,
+						Status:  absensis.StatusPresent,
+					},
+					planned: len(report.Plan.Sessions),
+				})
+				report.Plan.Sessions = append(report.Plan.Sessions, absensis.Absensi{})
+				continue
+			}
+
+			open := openSession(sessions, p.Time, fu.location)
+			if open == nil {
+				report.Errors = append(report.Errors, punches.ImportError{Line: p.Line, Reason: "check out without a check in on the same day"})
+				continue
+			}
+
+			open.absensi.Checkout = p.Time
+			if open.planned < 0 {
+				report.Plan.Checkouts = append(report.Plan.Checkouts, punches.Checkout{
+					AbsenID:  open.absensi.ID,
+					Checkout: p.Time,
+				})
+			}
+		}
+
+		for _, s := range sessions {
+			if s.planned >= 0 {
+				report.Plan.Sessions[s.planned] = s.absensi
+			}
+		}
+	}
+
+	report.Created = len(report.Plan.Sessions)
+	report.Closed = len(report.Plan.Checkouts)
+
+	if dryRun || (report.Created == 0 && report.Closed == 0) {
+		return report, nil
+	}
+
+	if err := fu.repository.Commit(ctx, report.Plan); err != nil {
+		return report, exception.ErrInternalServer
+	}
+
+	return report, nil
+}
+
+// ImportFile is the admin only HTTP entry point of Import.
+func (fu *fingerprintUseCaseImpl) ImportFile(ctx context.Context, role string, r io.Reader, dryRun bool) response.Response {
+	if role != users.RoleAdmin {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	report, err := fu.Import(ctx, r, dryRun)
+	if err == exception.ErrBadRequest {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if dryRun {
+		return response.Success(response.StatusOK, report)
+	}
+
+	return response.Success(response.StatusCreated, report)
+}
+
+// duplicate reports whether the punch is already stored or planned. Stored
+// rows come back in the database location, so instants are compared rather
+// than wall clock text.
+func duplicate(sessions []*session, p punches.Punch) bool {
+	for _, s := range sessions {
+		if p.State == punches.StateIn && s.absensi.Checkin.Equal(p.Time) {
+			return true
+		}
+		if p.State == punches.StateOut && !s.absensi.Checkout.IsZero() && s.absensi.Checkout.Equal(p.Time) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// openSession finds the latest session of the punch day still missing a
+// checkout. The day is the one of the devices' location, whatever location
+// a stored row was read in.
+func openSession(sessions []*session, at time.Time, loc *time.Location) *session {
+	var open *session
+	day := at.In(loc).Format("2006-01-02")
+
+	for _, s := range sessions {
+		checkin := s.absensi.Checkin
+		if !s.absensi.Checkout.IsZero() || checkin.After(at) || checkin.In(loc).Format("2006-01-02") != day {
+			continue
+		}
+		if open == nil || checkin.After(open.absensi.Checkin) {
+			open = s
+		}
+	}
+
+	return open
+}
@@ -0,0 +1,68 @@
+package invite
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/invites"
+)
+
+type InviteHandler struct {
+	Validate *validator.Validate
+	UseCase  InviteUseCase
+}
+
+func NewInviteHandler(router *mux.Router, validate *validator.Validate, usecase InviteUseCase) {
+	handler := &InviteHandler{
+		Validate: validate,
+		UseCase:  usecase,
+	}
+
+	router.HandleFunc("/invite", handler.Get).Methods(http.MethodGet)
+	router.HandleFunc("/invite", handler.Accept).Methods(http.MethodPost)
+}
+
+func (handler *InviteHandler) Get(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		res = response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Get(ctx, token)
+
+	res.JSON(w)
+}
+
+func (handler *InviteHandler) Accept(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput invites.AcceptReq
+
+	ctx := r.Context()
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Accept(ctx, userInput)
+
+	res.JSON(w)
+}
@@ -0,0 +1,133 @@
+package invite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/models/invites"
+)
+
+type (
+	InviteRepository interface {
+		FindByToken(ctx context.Context, tokenHash string) (invites.Invite, error)
+		Accept(ctx context.Context, params invites.Invite, password string, usedAt time.Time) error
+	}
+
+	inviteRepositoryImpl struct {
+		db *sql.DB
+	}
+)
+
+func NewInviteRepositoryImpl(db *sql.DB) InviteRepository {
+	return &inviteRepositoryImpl{
+		db: db,
+	}
+}
+
+// FindByToken isn't scoped to a company, the invitee has no token yet and the
+// hash alone identifies the invite.
+func (ir *inviteRepositoryImpl) FindByToken(ctx context.Context, tokenHash string) (invites.Invite, error) {
+	var invite invites.Invite
+	var name, email sql.NullString
+	var usedAt, createdAt sql.NullTime
+
+	query := fmt.Sprintf(`SELECT i.id, i.userID, e.name, e.email, i.expires_at, i.used_at, i.created_at FROM %s i JOIN %s e ON e.id = i.userID WHERE i.token_hash = ?`, constant.TableInvite, constant.TableEmployee)
+	stmt, err := ir.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return invite, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	err = stmt.QueryRowContext(ctx, tokenHash).Scan(
+		&invite.ID,
+		&invite.UserID,
+		&name,
+		&email,
+		&invite.ExpiresAt,
+		&usedAt,
+		&createdAt,
+	)
+	if err == sql.ErrNoRows {
+		return invites.Invite{}, exception.ErrNotFound
+	}
+
+	if err != nil {
+		log.Println(err)
+		return invites.Invite{}, exception.ErrInternalServer
+	}
+
+	invite.Name = name.String
+	invite.Email = email.String
+	invite.UsedAt = usedAt.Time
+	invite.CreatedAt = createdAt.Time
+
+	return invite, nil
+}
+
+// Accept sets the password of the invitee and uses up every invite they
+// still have, so an older link can't reset the password later. An invite
+// accepted in the meantime, or an account that already has a password, is
+// reported as not found.
+func (ir *inviteRepositoryImpl) Accept(ctx context.Context, params invites.Invite, password string, usedAt time.Time) error {
+	tx, err := ir.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer tx.Rollback()
+
+	used := fmt.Sprintf(`UPDATE %s SET used_at = ? WHERE id = ? AND used_at IS NULL`, constant.TableInvite)
+	result, err := tx.ExecContext(ctx, used, usedAt, params.ID)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	if rowsAffected < 1 {
+		return exception.ErrNotFound
+	}
+
+	revoke := fmt.Sprintf(`UPDATE %s SET used_at = ? WHERE userID = ? AND used_at IS NULL`, constant.TableInvite)
+	if _, err := tx.ExecContext(ctx, revoke, usedAt, params.UserID); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	update := fmt.Sprintf(`UPDATE %s SET password = ?, update_at = ? WHERE id = ? AND password = ''`, constant.TableEmployee)
+	result, err = tx.ExecContext(ctx, update, password, usedAt, params.UserID)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	rowsAffected, err = result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	if rowsAffected < 1 {
+		return exception.ErrNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package invite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Risuii/config/mailer"
+	"github.com/Risuii/models/invites"
+)
+
+type (
+	// Sender issues invite tokens and mails their link to the invitee.
+	Sender interface {
+		Token() (invites.Token, error)
+		Deliver(ctx context.Context, name string, email string, token invites.Token) error
+	}
+
+	senderImpl struct {
+		settings invites.Settings
+		mailer   mailer.Mailer
+	}
+)
+
+func NewSender(settings invites.Settings, mailer mailer.Mailer) Sender {
+	return &senderImpl{
+		settings: settings,
+		mailer:   mailer,
+	}
+}
+
+func (s *senderImpl) Token() (invites.Token, error) {
+	value, hash, err := NewToken()
+	if err != nil {
+		return invites.Token{}, err
+	}
+
+	return invites.Token{
+		Value:     value,
+		Hash:      hash,
+		ExpiresAt: time.Now().Add(s.settings.TTL),
+		Link:      s.settings.Link(value),
+	}, nil
+}
+
+func (s *senderImpl) Deliver(ctx context.Context, name string, email string, token invites.Token) error {
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      email,
+		Name:    name,
+		Subject: "Undangan Akun Absensi",
+		Body: fmt.Sprintf("Halo %s,\n\nAnda diundang untuk menggunakan aplikasi absensi. Buka link berikut untuk membuat password:\n%s\n\nLink berlaku sampai %s.\n",
+			name, token.Link, token.ExpiresAt.Format("2006-01-02 15:04")),
+	})
+}
@@ -0,0 +1,85 @@
+package invite
+
+import (
+	"context"
+	"time"
+
+	"github.com/Risuii/config/bcrypt"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/invites"
+)
+
+type (
+	InviteUseCase interface {
+		Get(ctx context.Context, token string) response.Response
+		Accept(ctx context.Context, params invites.AcceptReq) response.Response
+	}
+
+	inviteUseCaseImpl struct {
+		repository InviteRepository
+		bcrypt     bcrypt.Bcrypt
+	}
+)
+
+func NewInviteUseCase(repo InviteRepository, bcrypt bcrypt.Bcrypt) InviteUseCase {
+	return &inviteUseCaseImpl{
+		repository: repo,
+		bcrypt:     bcrypt,
+	}
+}
+
+// Get shows who the invite is for so the invitee knows which account they
+// are setting a password on.
+func (iu *inviteUseCaseImpl) Get(ctx context.Context, token string) response.Response {
+	invite, res := iu.find(ctx, token)
+	if res != nil {
+		return res
+	}
+
+	return response.Success(response.StatusOK, invite)
+}
+
+func (iu *inviteUseCaseImpl) Accept(ctx context.Context, params invites.AcceptReq) response.Response {
+	invite, res := iu.find(ctx, params.Token)
+	if res != nil {
+		return res
+	}
+
+	hashedPassword, err := iu.bcrypt.HashPassword(params.Password)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	err = iu.repository.Accept(ctx, invite, hashedPassword, time.Now())
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	msg := "Password berhasil dibuat, silakan login"
+
+	return response.Success(response.StatusOK, msg)
+}
+
+// find returns the invite behind token, used and expired invites are treated
+// as gone.
+func (iu *inviteUseCaseImpl) find(ctx context.Context, token string) (invites.Invite, response.Response) {
+	invite, err := iu.repository.FindByToken(ctx, Hash(token))
+	if err == exception.ErrNotFound {
+		return invite, response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	if err != nil {
+		return invite, response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if !invite.UsedAt.IsZero() || !time.Now().Before(invite.ExpiresAt) {
+		return invite, response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	return invite, nil
+}
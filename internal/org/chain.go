@@ -0,0 +1,74 @@
+package org
+
+import (
+	"context"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/models/orgs"
+)
+
+// maxDepth bounds the walk up the reporting lines, far more levels than any
+// org has.
+const maxDepth = 64
+
+type (
+	// Chain answers who reports to whom, directly or through other managers.
+	// Use cases rely on it to authorize team views.
+	Chain interface {
+		InChain(ctx context.Context, managerID int64, userID int64) (bool, error)
+		Reports(ctx context.Context, managerID int64) ([]orgs.Member, error)
+	}
+
+	chainImpl struct {
+		repository OrgRepository
+	}
+)
+
+func NewChain(repo OrgRepository) Chain {
+	return &chainImpl{
+		repository: repo,
+	}
+}
+
+// InChain reports whether managerID is above userID in the reporting lines.
+// Nobody is in their own chain.
+func (c *chainImpl) InChain(ctx context.Context, managerID int64, userID int64) (bool, error) {
+	if managerID == 0 || managerID == userID {
+		return false, nil
+	}
+
+	seen := map[int64]bool{userID: true}
+	current := userID
+
+	for depth := 0; depth < maxDepth; depth++ {
+		member, err := c.repository.FindMember(ctx, current)
+		if err == exception.ErrNotFound {
+			return false, nil
+		}
+		if err != nil {
+			return false, exception.ErrInternalServer
+		}
+
+		if member.ManagerID == 0 || seen[member.ManagerID] {
+			return false, nil
+		}
+
+		if member.ManagerID == managerID {
+			return true, nil
+		}
+
+		seen[member.ManagerID] = true
+		current = member.ManagerID
+	}
+
+	return false, nil
+}
+
+func (c *chainImpl) Reports(ctx context.Context, managerID int64) ([]orgs.Member, error) {
+	member, err := c.repository.FindReports(ctx, managerID)
+	if err != nil {
+		return member, exception.ErrInternalServer
+	}
+
+	return member, nil
+}
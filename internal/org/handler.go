@@ -0,0 +1,201 @@
+package org
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/orgs"
+)
+
+type OrgHandler struct {
+	Validate *validator.Validate
+	UseCase  OrgUseCase
+}
+
+func NewOrgHandler(router *mux.Router, validate *validator.Validate, usecase OrgUseCase) {
+	handler := &OrgHandler{
+		Validate: validate,
+		UseCase:  usecase,
+	}
+
+	api := router.PathPrefix("/account").Subrouter()
+
+	api.HandleFunc("/department", handler.CreateDepartment).Methods(http.MethodPost)
+	api.HandleFunc("/department", handler.ListDepartments).Methods(http.MethodGet)
+	api.HandleFunc("/team", handler.CreateTeam).Methods(http.MethodPost)
+	api.HandleFunc("/team", handler.ListTeams).Methods(http.MethodGet)
+	api.HandleFunc("/team/{id}/members", handler.TeamMembers).Methods(http.MethodGet)
+	api.HandleFunc("/employee/{id}/org", handler.Place).Methods(http.MethodPatch)
+	api.HandleFunc("/org/reports", handler.Reports).Methods(http.MethodGet)
+}
+
+func (handler *OrgHandler) CreateDepartment(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput orgs.DepartmentReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.CreateDepartment(ctx, claims.Role, userInput)
+
+	res.JSON(w)
+}
+
+func (handler *OrgHandler) ListDepartments(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	if _, err := jwt.FromCookie(r, "token"); err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.ListDepartments(ctx)
+
+	res.JSON(w)
+}
+
+func (handler *OrgHandler) CreateTeam(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput orgs.TeamReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.CreateTeam(ctx, claims.Role, userInput)
+
+	res.JSON(w)
+}
+
+func (handler *OrgHandler) ListTeams(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	if _, err := jwt.FromCookie(r, "token"); err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	departmentID, _ := strconv.ParseInt(r.URL.Query().Get("departmentID"), 10, 64)
+
+	res = handler.UseCase.ListTeams(ctx, departmentID)
+
+	res.JSON(w)
+}
+
+func (handler *OrgHandler) TeamMembers(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.ParseInt(params["id"], 10, 64)
+
+	res = handler.UseCase.TeamMembers(ctx, id, claims.ID, claims.Role)
+
+	res.JSON(w)
+}
+
+// Place sets the team and manager of the employee in the path.
+func (handler *OrgHandler) Place(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput orgs.PlacementReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.ParseInt(params["id"], 10, 64)
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Place(ctx, id, claims.Role, userInput)
+
+	res.JSON(w)
+}
+
+func (handler *OrgHandler) Reports(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Reports(ctx, claims.ID)
+
+	res.JSON(w)
+}
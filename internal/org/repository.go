@@ -0,0 +1,294 @@
+package org
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/models/orgs"
+)
+
+type (
+	OrgRepository interface {
+		CreateDepartment(ctx context.Context, params orgs.Department) (int64, error)
+		FindDepartments(ctx context.Context) ([]orgs.Department, error)
+		CreateTeam(ctx context.Context, params orgs.Team) (int64, error)
+		FindTeamByID(ctx context.Context, id int64) (orgs.Team, error)
+		FindTeams(ctx context.Context, departmentID int64) ([]orgs.Team, error)
+		FindMember(ctx context.Context, userID int64) (orgs.Member, error)
+		FindTeamMembers(ctx context.Context, teamID int64) ([]orgs.Member, error)
+		FindReports(ctx context.Context, managerID int64) ([]orgs.Member, error)
+		Place(ctx context.Context, userID int64, teamID int64, managerID int64) error
+	}
+
+	orgRepositoryImpl struct {
+		db *sql.DB
+	}
+)
+
+func NewOrgRepositoryImpl(db *sql.DB) OrgRepository {
+	return &orgRepositoryImpl{
+		db: db,
+	}
+}
+
+func (or *orgRepositoryImpl) CreateDepartment(ctx context.Context, params orgs.Department) (int64, error) {
+	query := fmt.Sprintf(`INSERT INTO %s (name, company_id, created_at) VALUES (?, ?, ?)`, constant.TableDepartment)
+
+	return or.insert(ctx, query, params.Name, tenant.ID(ctx), params.CreatedAt)
+}
+
+func (or *orgRepositoryImpl) FindDepartments(ctx context.Context) ([]orgs.Department, error) {
+	department := []orgs.Department{}
+
+	query := fmt.Sprintf(`SELECT id, name, created_at FROM %s WHERE company_id = ? ORDER BY name`, constant.TableDepartment)
+	rows, err := or.db.QueryContext(ctx, query, tenant.ID(ctx))
+	if err != nil {
+		log.Println(err)
+		return department, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var d orgs.Department
+		if err := rows.Scan(
+			&d.ID,
+			&d.Name,
+			&d.CreatedAt,
+		); err != nil {
+			log.Println(err)
+			return department, exception.ErrInternalServer
+		}
+		department = append(department, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return department, exception.ErrInternalServer
+	}
+
+	return department, nil
+}
+
+func (or *orgRepositoryImpl) CreateTeam(ctx context.Context, params orgs.Team) (int64, error) {
+	query := fmt.Sprintf(`INSERT INTO %s (departmentID, name, company_id, created_at) VALUES (?, ?, ?, ?)`, constant.TableTeam)
+
+	return or.insert(ctx, query, params.DepartmentID, params.Name, tenant.ID(ctx), params.CreatedAt)
+}
+
+func (or *orgRepositoryImpl) FindTeamByID(ctx context.Context, id int64) (orgs.Team, error) {
+	query := fmt.Sprintf(`SELECT id, departmentID, name, created_at FROM %s WHERE id = ? AND company_id = ?`, constant.TableTeam)
+	stmt, err := or.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return orgs.Team{}, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	var t orgs.Team
+	err = stmt.QueryRowContext(ctx, id, tenant.ID(ctx)).Scan(
+		&t.ID,
+		&t.DepartmentID,
+		&t.Name,
+		&t.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return orgs.Team{}, exception.ErrNotFound
+	}
+
+	if err != nil {
+		log.Println(err)
+		return orgs.Team{}, exception.ErrInternalServer
+	}
+
+	return t, nil
+}
+
+// FindTeams returns the teams of a department, or every team when
+// departmentID is zero.
+func (or *orgRepositoryImpl) FindTeams(ctx context.Context, departmentID int64) ([]orgs.Team, error) {
+	team := []orgs.Team{}
+
+	query := fmt.Sprintf(`SELECT id, departmentID, name, created_at FROM %s WHERE company_id = ? AND (? = 0 OR departmentID = ?) ORDER BY departmentID, name`, constant.TableTeam)
+	rows, err := or.db.QueryContext(ctx, query, tenant.ID(ctx), departmentID, departmentID)
+	if err != nil {
+		log.Println(err)
+		return team, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var t orgs.Team
+		if err := rows.Scan(
+			&t.ID,
+			&t.DepartmentID,
+			&t.Name,
+			&t.CreatedAt,
+		); err != nil {
+			log.Println(err)
+			return team, exception.ErrInternalServer
+		}
+		team = append(team, t)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return team, exception.ErrInternalServer
+	}
+
+	return team, nil
+}
+
+func (or *orgRepositoryImpl) FindMember(ctx context.Context, userID int64) (orgs.Member, error) {
+	query := fmt.Sprintf(`SELECT id, name, email, role, team_id, manager_id FROM %s WHERE id = ? AND company_id = ?`, constant.TableEmployee)
+	stmt, err := or.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return orgs.Member{}, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	member, err := scanMember(stmt.QueryRowContext(ctx, userID, tenant.ID(ctx)))
+	if err == sql.ErrNoRows {
+		return orgs.Member{}, exception.ErrNotFound
+	}
+
+	if err != nil {
+		log.Println(err)
+		return orgs.Member{}, exception.ErrInternalServer
+	}
+
+	return member, nil
+}
+
+func (or *orgRepositoryImpl) FindTeamMembers(ctx context.Context, teamID int64) ([]orgs.Member, error) {
+	query := fmt.Sprintf(`SELECT id, name, email, role, team_id, manager_id FROM %s WHERE team_id = ? AND company_id = ? ORDER BY name, id`, constant.TableEmployee)
+
+	return or.findMembers(ctx, query, teamID, tenant.ID(ctx))
+}
+
+// FindReports returns everyone below the manager in the reporting lines,
+// direct reports and theirs.
+func (or *orgRepositoryImpl) FindReports(ctx context.Context, managerID int64) ([]orgs.Member, error) {
+	query := fmt.Sprintf(`WITH RECURSIVE chain (id) AS (
+		SELECT id FROM %[1]s WHERE manager_id = ? AND company_id = ?
+		UNION
+		SELECT e.id FROM %[1]s e JOIN chain c ON e.manager_id = c.id
+	)
+	SELECT e.id, e.name, e.email, e.role, e.team_id, e.manager_id FROM %[1]s e JOIN chain c ON c.id = e.id ORDER BY e.name, e.id`, constant.TableEmployee)
+
+	return or.findMembers(ctx, query, managerID, tenant.ID(ctx))
+}
+
+func (or *orgRepositoryImpl) Place(ctx context.Context, userID int64, teamID int64, managerID int64) error {
+	query := fmt.Sprintf(`UPDATE %s SET team_id = ?, manager_id = ? WHERE id = ? AND company_id = ?`, constant.TableEmployee)
+	stmt, err := or.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	if _, err := stmt.ExecContext(ctx, nullID(teamID), nullID(managerID), userID, tenant.ID(ctx)); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	return nil
+}
+
+func (or *orgRepositoryImpl) insert(ctx context.Context, query string, args ...interface{}) (int64, error) {
+	stmt, err := or.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	ID, err := result.LastInsertId()
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	return ID, nil
+}
+
+func (or *orgRepositoryImpl) findMembers(ctx context.Context, query string, args ...interface{}) ([]orgs.Member, error) {
+	member := []orgs.Member{}
+
+	rows, err := or.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return member, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		m, err := scanMember(rows)
+		if err != nil {
+			log.Println(err)
+			return member, exception.ErrInternalServer
+		}
+		member = append(member, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return member, exception.ErrInternalServer
+	}
+
+	return member, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanMember(row scanner) (orgs.Member, error) {
+	var m orgs.Member
+	var name, email sql.NullString
+	var teamID, managerID sql.NullInt64
+
+	if err := row.Scan(
+		&m.UserID,
+		&name,
+		&email,
+		&m.Role,
+		&teamID,
+		&managerID,
+	); err != nil {
+		return orgs.Member{}, err
+	}
+
+	m.Name = name.String
+	m.Email = email.String
+	m.TeamID = teamID.Int64
+	m.ManagerID = managerID.Int64
+
+	return m, nil
+}
+
+func nullID(id int64) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
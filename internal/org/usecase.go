@@ -0,0 +1,236 @@
+package org
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/orgs"
+	"github.com/Risuii/models/users"
+)
+
+type (
+	OrgUseCase interface {
+		CreateDepartment(ctx context.Context, role string, params orgs.DepartmentReq) response.Response
+		ListDepartments(ctx context.Context) response.Response
+		CreateTeam(ctx context.Context, role string, params orgs.TeamReq) response.Response
+		ListTeams(ctx context.Context, departmentID int64) response.Response
+		TeamMembers(ctx context.Context, id int64, userID int64, role string) response.Response
+		Place(ctx context.Context, id int64, role string, params orgs.PlacementReq) response.Response
+		Reports(ctx context.Context, userID int64) response.Response
+	}
+
+	orgUseCaseImpl struct {
+		repository OrgRepository
+		chain      Chain
+	}
+)
+
+func NewOrgUseCase(repo OrgRepository, chain Chain) OrgUseCase {
+	return &orgUseCaseImpl{
+		repository: repo,
+		chain:      chain,
+	}
+}
+
+func (ou *orgUseCaseImpl) CreateDepartment(ctx context.Context, role string, params orgs.DepartmentReq) response.Response {
+	if role != users.RoleAdmin {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	department, err := ou.repository.FindDepartments(ctx)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	name := strings.TrimSpace(params.Name)
+	for _, d := range department {
+		if strings.EqualFold(d.Name, name) {
+			return response.Error(response.StatusConflicted, exception.ErrConflicted)
+		}
+	}
+
+	created := orgs.Department{
+		Name:      name,
+		CreatedAt: time.Now(),
+	}
+
+	ID, err := ou.repository.CreateDepartment(ctx, created)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	created.ID = ID
+
+	return response.Success(response.StatusCreated, created)
+}
+
+func (ou *orgUseCaseImpl) ListDepartments(ctx context.Context) response.Response {
+	department, err := ou.repository.FindDepartments(ctx)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, department)
+}
+
+func (ou *orgUseCaseImpl) CreateTeam(ctx context.Context, role string, params orgs.TeamReq) response.Response {
+	if role != users.RoleAdmin {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	department, err := ou.repository.FindDepartments(ctx)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	found := false
+	for _, d := range department {
+		if d.ID == params.DepartmentID {
+			found = true
+		}
+	}
+
+	if !found {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	team, err := ou.repository.FindTeams(ctx, params.DepartmentID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	name := strings.TrimSpace(params.Name)
+	for _, t := range team {
+		if strings.EqualFold(t.Name, name) {
+			return response.Error(response.StatusConflicted, exception.ErrConflicted)
+		}
+	}
+
+	created := orgs.Team{
+		DepartmentID: params.DepartmentID,
+		Name:         name,
+		CreatedAt:    time.Now(),
+	}
+
+	ID, err := ou.repository.CreateTeam(ctx, created)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	created.ID = ID
+
+	return response.Success(response.StatusCreated, created)
+}
+
+func (ou *orgUseCaseImpl) ListTeams(ctx context.Context, departmentID int64) response.Response {
+	team, err := ou.repository.FindTeams(ctx, departmentID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, team)
+}
+
+// TeamMembers lists the members of a team to admins, to the members
+// themselves and to anyone a member reports to.
+func (ou *orgUseCaseImpl) TeamMembers(ctx context.Context, id int64, userID int64, role string) response.Response {
+	if _, err := ou.repository.FindTeamByID(ctx, id); err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	} else if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	member, err := ou.repository.FindTeamMembers(ctx, id)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if role == users.RoleAdmin {
+		return response.Success(response.StatusOK, member)
+	}
+
+	for _, m := range member {
+		if m.UserID == userID {
+			return response.Success(response.StatusOK, member)
+		}
+
+		above, err := ou.chain.InChain(ctx, userID, m.UserID)
+		if err != nil {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+
+		if above {
+			return response.Success(response.StatusOK, member)
+		}
+	}
+
+	return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+}
+
+// Place moves an employee to a team and under a manager. A manager can not
+// be the employee or someone who already reports to the employee, so the
+// reporting lines never loop.
+func (ou *orgUseCaseImpl) Place(ctx context.Context, id int64, role string, params orgs.PlacementReq) response.Response {
+	if role != users.RoleAdmin {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	member, err := ou.repository.FindMember(ctx, id)
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if params.TeamID != 0 {
+		if _, err := ou.repository.FindTeamByID(ctx, params.TeamID); err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, exception.ErrNotFound)
+		} else if err != nil {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+	}
+
+	if params.ManagerID != 0 {
+		if params.ManagerID == id {
+			return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+		}
+
+		if _, err := ou.repository.FindMember(ctx, params.ManagerID); err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, exception.ErrNotFound)
+		} else if err != nil {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+
+		loop, err := ou.chain.InChain(ctx, id, params.ManagerID)
+		if err != nil {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+
+		if loop {
+			return response.Error(response.StatusConflicted, exception.ErrConflicted)
+		}
+	}
+
+	if err := ou.repository.Place(ctx, id, params.TeamID, params.ManagerID); err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	member.TeamID = params.TeamID
+	member.ManagerID = params.ManagerID
+
+	return response.Success(response.StatusOK, member)
+}
+
+// Reports lists everyone reporting to the user, directly or not.
+func (ou *orgUseCaseImpl) Reports(ctx context.Context, userID int64) response.Response {
+	member, err := ou.chain.Reports(ctx, userID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, member)
+}
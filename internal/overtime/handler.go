@@ -0,0 +1,130 @@
+package overtime
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/overtimes"
+)
+
+type OvertimeHandler struct {
+	Validate *validator.Validate
+	UseCase  OvertimeUseCase
+}
+
+func NewOvertimeHandler(router *mux.Router, validate *validator.Validate, usecase OvertimeUseCase) {
+	handler := &OvertimeHandler{
+		Validate: validate,
+		UseCase:  usecase,
+	}
+
+	api := router.PathPrefix("/account").Subrouter()
+
+	api.HandleFunc("/overtime", handler.Request).Methods(http.MethodPost)
+	api.HandleFunc("/overtime", handler.Riwayat).Methods(http.MethodGet)
+	api.HandleFunc("/overtime/pending", handler.Pending).Methods(http.MethodGet)
+	api.HandleFunc("/overtime/{id}/review", handler.Review).Methods(http.MethodPatch)
+}
+
+func (handler *OvertimeHandler) Request(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput overtimes.OvertimeReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Request(ctx, claims.ID, userInput)
+
+	res.JSON(w)
+}
+
+func (handler *OvertimeHandler) Riwayat(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Riwayat(ctx, claims.ID)
+
+	res.JSON(w)
+}
+
+func (handler *OvertimeHandler) Pending(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Pending(ctx, claims.ID, claims.Role)
+
+	res.JSON(w)
+}
+
+func (handler *OvertimeHandler) Review(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput overtimes.ReviewReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.ParseInt(params["id"], 10, 64)
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Review(ctx, id, claims.ID, claims.Role, userInput)
+
+	res.JSON(w)
+}
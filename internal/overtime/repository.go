@@ -0,0 +1,205 @@
+package overtime
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/models/overtimes"
+)
+
+type (
+	OvertimeRepository interface {
+		Create(ctx context.Context, params overtimes.Overtime) (int64, error)
+		FindByID(ctx context.Context, id int64) (overtimes.Overtime, error)
+		FindByUserID(ctx context.Context, userID int64) ([]overtimes.Overtime, error)
+		FindByStatus(ctx context.Context, status string) ([]overtimes.Overtime, error)
+		UpdateStatus(ctx context.Context, id int64, params overtimes.Overtime) error
+	}
+
+	overtimeRepositoryImpl struct {
+		db        *sql.DB
+		tableName string
+	}
+)
+
+func NewOvertimeRepositoryImpl(db *sql.DB, tableName string) OvertimeRepository {
+	return &overtimeRepositoryImpl{
+		db:        db,
+		tableName: tableName,
+	}
+}
+
+func (or *overtimeRepositoryImpl) Create(ctx context.Context, params overtimes.Overtime) (int64, error) {
+	query := fmt.Sprintf(`INSERT INTO %s (userID, date, planned_hours, reason, status, created_at) VALUES (?, ?, ?, ?, ?, ?)`, or.tableName)
+	stmt, err := or.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		params.UserID,
+		params.Date.Format("2006-01-02"),
+		params.PlannedHours,
+		params.Reason,
+		params.Status,
+		params.CreatedAt,
+	)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	ID, err := result.LastInsertId()
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	return ID, nil
+}
+
+func (or *overtimeRepositoryImpl) FindByID(ctx context.Context, id int64) (overtimes.Overtime, error) {
+	query := fmt.Sprintf(`SELECT id, userID, date, planned_hours, reason, status, reviewed_by, reviewed_at, created_at FROM %s WHERE id = ? AND %s`, or.tableName, inCompany)
+	stmt, err := or.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return overtimes.Overtime{}, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	overtime, err := scanOvertime(stmt.QueryRowContext(ctx, id, tenant.ID(ctx)))
+	if err == sql.ErrNoRows {
+		return overtimes.Overtime{}, exception.ErrNotFound
+	}
+
+	if err != nil {
+		log.Println(err)
+		return overtimes.Overtime{}, exception.ErrInternalServer
+	}
+
+	return overtime, nil
+}
+
+func (or *overtimeRepositoryImpl) FindByUserID(ctx context.Context, userID int64) ([]overtimes.Overtime, error) {
+	query := fmt.Sprintf(`SELECT id, userID, date, planned_hours, reason, status, reviewed_by, reviewed_at, created_at FROM %s WHERE userID = ? AND %s ORDER BY date desc`, or.tableName, inCompany)
+
+	return or.findAll(ctx, query, userID, tenant.ID(ctx))
+}
+
+func (or *overtimeRepositoryImpl) FindByStatus(ctx context.Context, status string) ([]overtimes.Overtime, error) {
+	query := fmt.Sprintf(`SELECT id, userID, date, planned_hours, reason, status, reviewed_by, reviewed_at, created_at FROM %s WHERE status = ? AND %s ORDER BY date asc`, or.tableName, inCompany)
+
+	return or.findAll(ctx, query, status, tenant.ID(ctx))
+}
+
+// UpdateStatus reviews a pending request. A request reviewed in the meantime
+// is left as it is and reported as conflicted.
+func (or *overtimeRepositoryImpl) UpdateStatus(ctx context.Context, id int64, params overtimes.Overtime) error {
+	query := fmt.Sprintf(`UPDATE %s SET status = ?, reviewed_by = ?, reviewed_at = ? WHERE id = ? AND status = ? AND %s`, or.tableName, inCompany)
+	stmt, err := or.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		params.Status,
+		params.ReviewedBy,
+		params.ReviewedAt,
+		id,
+		overtimes.StatusPending,
+		tenant.ID(ctx),
+	)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	if rowsAffected < 1 {
+		return exception.ErrConflicted
+	}
+
+	return nil
+}
+
+// inCompany keeps requests to the employees of the caller's company, the
+// overtime table has no company of its own.
+var inCompany = fmt.Sprintf(`userID IN (SELECT id FROM %s WHERE company_id = ?)`, constant.TableEmployee)
+
+func (or *overtimeRepositoryImpl) findAll(ctx context.Context, query string, args ...interface{}) ([]overtimes.Overtime, error) {
+	overtime := []overtimes.Overtime{}
+
+	rows, err := or.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return overtime, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		o, err := scanOvertime(rows)
+		if err != nil {
+			log.Println(err)
+			return overtime, exception.ErrInternalServer
+		}
+		overtime = append(overtime, o)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return overtime, exception.ErrInternalServer
+	}
+
+	return overtime, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanOvertime(row scanner) (overtimes.Overtime, error) {
+	var o overtimes.Overtime
+	var reviewedBy sql.NullInt64
+	var reviewedAt sql.NullTime
+
+	if err := row.Scan(
+		&o.ID,
+		&o.UserID,
+		&o.Date,
+		&o.PlannedHours,
+		&o.Reason,
+		&o.Status,
+		&reviewedBy,
+		&reviewedAt,
+		&o.CreatedAt,
+	); err != nil {
+		return overtimes.Overtime{}, err
+	}
+
+	o.ReviewedBy = reviewedBy.Int64
+	if reviewedAt.Valid {
+		o.ReviewedAt = reviewedAt.Time
+	}
+
+	return o, nil
+}
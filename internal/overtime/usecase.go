@@ -0,0 +1,153 @@
+package overtime
+
+import (
+	"context"
+	"time"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/org"
+	"github.com/Risuii/models/overtimes"
+	"github.com/Risuii/models/users"
+)
+
+type (
+	OvertimeUseCase interface {
+		Request(ctx context.Context, userID int64, params overtimes.OvertimeReq) response.Response
+		Review(ctx context.Context, id int64, reviewerID int64, role string, params overtimes.ReviewReq) response.Response
+		Riwayat(ctx context.Context, userID int64) response.Response
+		Pending(ctx context.Context, reviewerID int64, role string) response.Response
+	}
+
+	overtimeUseCaseImpl struct {
+		repository OvertimeRepository
+		chain      org.Chain
+	}
+)
+
+func NewOvertimeUseCase(repo OvertimeRepository, chain org.Chain) OvertimeUseCase {
+	return &overtimeUseCaseImpl{
+		repository: repo,
+		chain:      chain,
+	}
+}
+
+func (ou *overtimeUseCaseImpl) Request(ctx context.Context, userID int64, params overtimes.OvertimeReq) response.Response {
+	date, err := time.Parse("2006-01-02", params.Date)
+	if err != nil {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	overtime := overtimes.Overtime{
+		UserID:       userID,
+		Date:         date,
+		PlannedHours: params.PlannedHours,
+		Reason:       params.Reason,
+		Status:       overtimes.StatusPending,
+		CreatedAt:    time.Now(),
+	}
+
+	ID, err := ou.repository.Create(ctx, overtime)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	overtime.ID = ID
+
+	return response.Success(response.StatusCreated, overtime)
+}
+
+// Review approves or rejects a pending overtime request. Reviewers can't
+// review their own requests and managers only review the people below them.
+func (ou *overtimeUseCaseImpl) Review(ctx context.Context, id int64, reviewerID int64, role string, params overtimes.ReviewReq) response.Response {
+	if !users.IsReviewer(role) {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	overtime, err := ou.repository.FindByID(ctx, id)
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if overtime.UserID == reviewerID {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	if role != users.RoleAdmin {
+		above, err := ou.chain.InChain(ctx, reviewerID, overtime.UserID)
+		if err != nil {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+
+		if !above {
+			return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+		}
+	}
+
+	if overtime.Status != overtimes.StatusPending {
+		return response.Error(response.StatusConflicted, exception.ErrConflicted)
+	}
+
+	overtime.Status = params.Status
+	overtime.ReviewedBy = reviewerID
+	overtime.ReviewedAt = time.Now()
+
+	err = ou.repository.UpdateStatus(ctx, id, overtime)
+	if err == exception.ErrConflicted {
+		return response.Error(response.StatusConflicted, exception.ErrConflicted)
+	}
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, overtime)
+}
+
+func (ou *overtimeUseCaseImpl) Riwayat(ctx context.Context, userID int64) response.Response {
+	overtime, err := ou.repository.FindByUserID(ctx, userID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, overtime)
+}
+
+// Pending lists the requests that still wait for a review. Admins see every
+// employee, managers only the people below them in the reporting lines.
+func (ou *overtimeUseCaseImpl) Pending(ctx context.Context, reviewerID int64, role string) response.Response {
+	if !users.IsReviewer(role) {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	overtime, err := ou.repository.FindByStatus(ctx, overtimes.StatusPending)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if role == users.RoleAdmin {
+		return response.Success(response.StatusOK, overtime)
+	}
+
+	member, err := ou.chain.Reports(ctx, reviewerID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	reports := make(map[int64]bool, len(member))
+	for _, m := range member {
+		reports[m.UserID] = true
+	}
+
+	scoped := []overtimes.Overtime{}
+	for _, o := range overtime {
+		if reports[o.UserID] {
+			scoped = append(scoped, o)
+		}
+	}
+
+	return response.Success(response.StatusOK, scoped)
+}
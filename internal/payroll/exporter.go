@@ -0,0 +1,200 @@
+package payroll
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Risuii/helpers/export"
+	"github.com/Risuii/models/payrolls"
+)
+
+// Exporter writes payroll records in the format a payroll system imports.
+type Exporter interface {
+	Export(w io.Writer, records []payrolls.Record) error
+	Extension() string
+}
+
+// DefaultMapping is used when no mapping file is configured.
+func DefaultMapping() payrolls.Mapping {
+	return payrolls.Mapping{
+		Format:    payrolls.FormatCSV,
+		Delimiter: ",",
+		Header:    true,
+		Columns: []payrolls.Column{
+			{Field: payrolls.FieldCode, Header: "employee_code"},
+			{Field: payrolls.FieldPeriod, Header: "period"},
+			{Field: payrolls.FieldWorkedDays, Header: "worked_days"},
+			{Field: payrolls.FieldOvertimeHours, Header: "overtime_hours"},
+			{Field: payrolls.FieldLateDeduction, Header: "late_deduction"},
+		},
+	}
+}
+
+// LoadMapping reads a JSON mapping from path, or returns DefaultMapping when
+// path is empty.
+func LoadMapping(path string) (payrolls.Mapping, error) {
+	if path == "" {
+		return DefaultMapping(), nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return payrolls.Mapping{}, err
+	}
+	defer file.Close()
+
+	var mapping payrolls.Mapping
+	if err := json.NewDecoder(file).Decode(&mapping); err != nil {
+		return payrolls.Mapping{}, err
+	}
+
+	return mapping, nil
+}
+
+func NewExporter(mapping payrolls.Mapping) (Exporter, error) {
+	if len(mapping.Columns) == 0 {
+		return nil, fmt.Errorf("payroll mapping has no columns")
+	}
+
+	for _, c := range mapping.Columns {
+		if _, err := value(payrolls.Record{}, c.Field); err != nil {
+			return nil, err
+		}
+	}
+
+	switch mapping.Format {
+	case payrolls.FormatCSV:
+		return &csvExporter{mapping: mapping}, nil
+	case payrolls.FormatFixed:
+		for _, c := range mapping.Columns {
+			if c.Width <= 0 {
+				return nil, fmt.Errorf("payroll column %q needs a width", c.Field)
+			}
+		}
+		return &fixedWidthExporter{mapping: mapping}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported payroll format %q", mapping.Format)
+}
+
+type csvExporter struct {
+	mapping payrolls.Mapping
+}
+
+func (ce *csvExporter) Extension() string {
+	return "csv"
+}
+
+func (ce *csvExporter) Export(w io.Writer, records []payrolls.Record) error {
+	writer := csv.NewWriter(w)
+	if ce.mapping.Delimiter != "" {
+		delimiter, _ := utf8.DecodeRuneInString(ce.mapping.Delimiter)
+		writer.Comma = delimiter
+	}
+
+	if ce.mapping.Header {
+		row := make([]string, len(ce.mapping.Columns))
+		for i, c := range ce.mapping.Columns {
+			row[i] = c.Header
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range records {
+		row := make([]string, len(ce.mapping.Columns))
+		for i, c := range ce.mapping.Columns {
+			row[i], _ = value(r, c.Field)
+			if text(c.Field) {
+				row[i] = export.EscapeFormula(row[i])
+			}
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+type fixedWidthExporter struct {
+	mapping payrolls.Mapping
+}
+
+func (fe *fixedWidthExporter) Extension() string {
+	return "txt"
+}
+
+func (fe *fixedWidthExporter) Export(w io.Writer, records []payrolls.Record) error {
+	if fe.mapping.Header {
+		line := make([]string, len(fe.mapping.Columns))
+		for i, c := range fe.mapping.Columns {
+			line[i] = pad(c.Header, c.Width, payrolls.AlignLeft)
+		}
+		if _, err := fmt.Fprintln(w, strings.Join(line, "")); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range records {
+		line := make([]string, len(fe.mapping.Columns))
+		for i, c := range fe.mapping.Columns {
+			v, _ := value(r, c.Field)
+			line[i] = pad(v, c.Width, c.Align)
+		}
+		if _, err := fmt.Fprintln(w, strings.Join(line, "")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pad cuts or pads s to exactly width characters.
+func pad(s string, width int, align string) string {
+	runes := []rune(s)
+	if len(runes) > width {
+		return string(runes[:width])
+	}
+
+	fill := strings.Repeat(" ", width-len(runes))
+	if align == payrolls.AlignRight {
+		return fill + s
+	}
+
+	return s + fill
+}
+
+// text reports whether field holds free text, as opposed to a number.
+func text(field string) bool {
+	return field == payrolls.FieldCode || field == payrolls.FieldName || field == payrolls.FieldPeriod
+}
+
+func value(r payrolls.Record, field string) (string, error) {
+	switch field {
+	case payrolls.FieldCode:
+		return r.Code, nil
+	case payrolls.FieldName:
+		return r.Name, nil
+	case payrolls.FieldPeriod:
+		return r.Period, nil
+	case payrolls.FieldWorkedDays:
+		return strconv.Itoa(r.WorkedDays), nil
+	case payrolls.FieldOvertimeHours:
+		return strconv.FormatFloat(r.OvertimeHours, 'f', 2, 64), nil
+	case payrolls.FieldLateMinutes:
+		return strconv.Itoa(r.LateMinutes), nil
+	case payrolls.FieldLateDeduction:
+		return strconv.FormatFloat(r.LateDeduction, 'f', 2, 64), nil
+	}
+
+	return "", fmt.Errorf("unknown payroll field %q", field)
+}
@@ -0,0 +1,75 @@
+package payroll
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/internal/report"
+	"github.com/Risuii/models/payrolls"
+)
+
+type (
+	PayrollUseCase interface {
+		Records(ctx context.Context, month time.Time) ([]payrolls.Record, error)
+		Generate(ctx context.Context, month time.Time, w io.Writer) error
+	}
+
+	payrollUseCaseImpl struct {
+		report        report.ReportUseCase
+		exporter      Exporter
+		lateDeduction float64
+	}
+)
+
+// NewPayrollUseCase builds payroll records from the monthly recap. Every late
+// minute is deducted at lateDeduction.
+func NewPayrollUseCase(report report.ReportUseCase, exporter Exporter, lateDeduction float64) PayrollUseCase {
+	return &payrollUseCaseImpl{
+		report:        report,
+		exporter:      exporter,
+		lateDeduction: lateDeduction,
+	}
+}
+
+func (pu *payrollUseCaseImpl) Records(ctx context.Context, month time.Time) ([]payrolls.Record, error) {
+	records := []payrolls.Record{}
+
+	from := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, -1)
+
+	recap, err := pu.report.Calculate(ctx, 0, from, to)
+	if err != nil {
+		return records, exception.ErrInternalServer
+	}
+
+	for _, r := range recap {
+		code := r.Code
+		if code == "" {
+			code = strconv.FormatInt(r.UserID, 10)
+		}
+
+		records = append(records, payrolls.Record{
+			Code:          code,
+			Name:          r.Name,
+			Period:        r.Month,
+			WorkedDays:    r.DaysPresent,
+			OvertimeHours: r.OvertimeHours,
+			LateMinutes:   r.LateMinutes,
+			LateDeduction: float64(r.LateMinutes) * pu.lateDeduction,
+		})
+	}
+
+	return records, nil
+}
+
+func (pu *payrollUseCaseImpl) Generate(ctx context.Context, month time.Time, w io.Writer) error {
+	records, err := pu.Records(ctx, month)
+	if err != nil {
+		return err
+	}
+
+	return pu.exporter.Export(w, records)
+}
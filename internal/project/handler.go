@@ -0,0 +1,114 @@
+package project
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/projects"
+)
+
+type ProjectHandler struct {
+	Validate *validator.Validate
+	UseCase  ProjectUseCase
+}
+
+func NewProjectHandler(router *mux.Router, validate *validator.Validate, usecase ProjectUseCase) {
+	handler := &ProjectHandler{
+		Validate: validate,
+		UseCase:  usecase,
+	}
+
+	api := router.PathPrefix("/account").Subrouter()
+
+	api.HandleFunc("/project", handler.Create).Methods(http.MethodPost)
+	api.HandleFunc("/project", handler.List).Methods(http.MethodGet)
+	api.HandleFunc("/project/{id}", handler.Update).Methods(http.MethodPatch)
+}
+
+func (handler *ProjectHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput projects.ProjectReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Create(ctx, claims.Role, userInput)
+
+	res.JSON(w)
+}
+
+func (handler *ProjectHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput projects.ProjectReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.ParseInt(params["id"], 10, 64)
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Update(ctx, id, claims.Role, userInput)
+
+	res.JSON(w)
+}
+
+func (handler *ProjectHandler) List(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	all := r.URL.Query().Get("all") == "true"
+
+	res = handler.UseCase.List(ctx, claims.Role, all)
+
+	res.JSON(w)
+}
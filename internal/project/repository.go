@@ -0,0 +1,181 @@
+package project
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/models/projects"
+)
+
+type (
+	ProjectRepository interface {
+		Create(ctx context.Context, params projects.Project) (int64, error)
+		Update(ctx context.Context, id int64, params projects.Project) error
+		FindByID(ctx context.Context, id int64) (projects.Project, error)
+		FindByCode(ctx context.Context, code string) (projects.Project, error)
+		FindAll(ctx context.Context, activeOnly bool) ([]projects.Project, error)
+	}
+
+	projectRepositoryImpl struct {
+		db        *sql.DB
+		tableName string
+	}
+)
+
+func NewProjectRepositoryImpl(db *sql.DB, tableName string) ProjectRepository {
+	return &projectRepositoryImpl{
+		db:        db,
+		tableName: tableName,
+	}
+}
+
+func (pr *projectRepositoryImpl) Create(ctx context.Context, params projects.Project) (int64, error) {
+	query := fmt.Sprintf(`INSERT INTO %s (code, name, client, active, company_id, created_at) VALUES (?, ?, ?, ?, ?, ?)`, pr.tableName)
+	stmt, err := pr.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		params.Code,
+		params.Name,
+		params.Client,
+		params.Active,
+		tenant.ID(ctx),
+		params.CreatedAt,
+	)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	ID, err := result.LastInsertId()
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	return ID, nil
+}
+
+func (pr *projectRepositoryImpl) Update(ctx context.Context, id int64, params projects.Project) error {
+	query := fmt.Sprintf(`UPDATE %s SET code = ?, name = ?, client = ?, active = ? WHERE id = ? AND company_id = ?`, pr.tableName)
+	stmt, err := pr.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(
+		ctx,
+		params.Code,
+		params.Name,
+		params.Client,
+		params.Active,
+		id,
+		tenant.ID(ctx),
+	)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	return nil
+}
+
+func (pr *projectRepositoryImpl) FindByID(ctx context.Context, id int64) (projects.Project, error) {
+	query := fmt.Sprintf(`SELECT id, code, name, client, active, created_at FROM %s WHERE id = ? AND company_id = ?`, pr.tableName)
+
+	return pr.findOne(ctx, query, id, tenant.ID(ctx))
+}
+
+func (pr *projectRepositoryImpl) FindByCode(ctx context.Context, code string) (projects.Project, error) {
+	query := fmt.Sprintf(`SELECT id, code, name, client, active, created_at FROM %s WHERE code = ? AND company_id = ?`, pr.tableName)
+
+	return pr.findOne(ctx, query, code, tenant.ID(ctx))
+}
+
+func (pr *projectRepositoryImpl) FindAll(ctx context.Context, activeOnly bool) ([]projects.Project, error) {
+	project := []projects.Project{}
+
+	query := fmt.Sprintf(`SELECT id, code, name, client, active, created_at FROM %s WHERE company_id = ? AND (? = FALSE OR active = TRUE) ORDER BY code`, pr.tableName)
+	rows, err := pr.db.QueryContext(ctx, query, tenant.ID(ctx), activeOnly)
+	if err != nil {
+		log.Println(err)
+		return project, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		p, err := scanProject(rows)
+		if err != nil {
+			log.Println(err)
+			return project, exception.ErrInternalServer
+		}
+		project = append(project, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return project, exception.ErrInternalServer
+	}
+
+	return project, nil
+}
+
+func (pr *projectRepositoryImpl) findOne(ctx context.Context, query string, args ...interface{}) (projects.Project, error) {
+	stmt, err := pr.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return projects.Project{}, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	project, err := scanProject(stmt.QueryRowContext(ctx, args...))
+	if err == sql.ErrNoRows {
+		return projects.Project{}, exception.ErrNotFound
+	}
+
+	if err != nil {
+		log.Println(err)
+		return projects.Project{}, exception.ErrInternalServer
+	}
+
+	return project, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanProject(row scanner) (projects.Project, error) {
+	var p projects.Project
+	var client sql.NullString
+
+	if err := row.Scan(
+		&p.ID,
+		&p.Code,
+		&p.Name,
+		&client,
+		&p.Active,
+		&p.CreatedAt,
+	); err != nil {
+		return projects.Project{}, err
+	}
+
+	p.Client = client.String
+
+	return p, nil
+}
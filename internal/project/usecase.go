@@ -0,0 +1,114 @@
+package project
+
+import (
+	"context"
+	"time"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/projects"
+	"github.com/Risuii/models/users"
+)
+
+type (
+	ProjectUseCase interface {
+		Create(ctx context.Context, role string, params projects.ProjectReq) response.Response
+		Update(ctx context.Context, id int64, role string, params projects.ProjectReq) response.Response
+		List(ctx context.Context, role string, all bool) response.Response
+	}
+
+	projectUseCaseImpl struct {
+		repository ProjectRepository
+	}
+)
+
+func NewProjectUseCase(repo ProjectRepository) ProjectUseCase {
+	return &projectUseCaseImpl{
+		repository: repo,
+	}
+}
+
+func (pu *projectUseCaseImpl) Create(ctx context.Context, role string, params projects.ProjectReq) response.Response {
+	if !isManager(role) {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	_, err := pu.repository.FindByCode(ctx, params.Code)
+	if err == nil {
+		return response.Error(response.StatusConflicted, exception.ErrConflicted)
+	}
+	if err != exception.ErrNotFound {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	project := projects.Project{
+		Code:      params.Code,
+		Name:      params.Name,
+		Client:    params.Client,
+		Active:    params.Active == nil || *params.Active,
+		CreatedAt: time.Now(),
+	}
+
+	ID, err := pu.repository.Create(ctx, project)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	project.ID = ID
+
+	return response.Success(response.StatusCreated, project)
+}
+
+// Update replaces the project's fields, deactivating a project keeps the
+// activities already logged against it but no new ones can reference it.
+func (pu *projectUseCaseImpl) Update(ctx context.Context, id int64, role string, params projects.ProjectReq) response.Response {
+	if !isManager(role) {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	project, err := pu.repository.FindByID(ctx, id)
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if params.Code != project.Code {
+		_, err := pu.repository.FindByCode(ctx, params.Code)
+		if err == nil {
+			return response.Error(response.StatusConflicted, exception.ErrConflicted)
+		}
+		if err != exception.ErrNotFound {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+	}
+
+	project.Code = params.Code
+	project.Name = params.Name
+	project.Client = params.Client
+	if params.Active != nil {
+		project.Active = *params.Active
+	}
+
+	if err := pu.repository.Update(ctx, id, project); err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, project)
+}
+
+// List returns the active projects, managers and admins can ask for the
+// inactive ones too.
+func (pu *projectUseCaseImpl) List(ctx context.Context, role string, all bool) response.Response {
+	project, err := pu.repository.FindAll(ctx, !(all && isManager(role)))
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, project)
+}
+
+func isManager(role string) bool {
+	return role == users.RoleManager || role == users.RoleAdmin
+}
@@ -0,0 +1,129 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/export"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/reports"
+)
+
+type ReportHandler struct {
+	Validate *validator.Validate
+	UseCase  ReportUseCase
+}
+
+func NewReportHandler(router *mux.Router, validate *validator.Validate, usecase ReportUseCase) {
+	handler := &ReportHandler{
+		Validate: validate,
+		UseCase:  usecase,
+	}
+
+	api := router.PathPrefix("/account").Subrouter()
+
+	api.HandleFunc("/report/monthly", handler.MonthlyRecap).Methods(http.MethodGet)
+	api.HandleFunc("/export/absensi", handler.ExportAbsensi).Methods(http.MethodGet)
+	api.HandleFunc("/export/activity", handler.ExportActivity).Methods(http.MethodGet)
+}
+
+func (handler *ReportHandler) MonthlyRecap(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput reports.RecapReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.MonthlyRecap(ctx, claims.ID, claims.Role, userInput)
+
+	res.JSON(w)
+}
+
+func (handler *ReportHandler) ExportAbsensi(w http.ResponseWriter, r *http.Request) {
+	handler.export(w, r, "absensi", handler.UseCase.ExportAbsensi)
+}
+
+func (handler *ReportHandler) ExportActivity(w http.ResponseWriter, r *http.Request) {
+	handler.export(w, r, "activity", handler.UseCase.ExportActivity)
+}
+
+type exportFunc func(ctx context.Context, userID int64, role string, params reports.ExportReq, w io.Writer) response.Response
+
+// export reads the filter from the query string so the endpoint can be used
+// as a plain download link.
+func (handler *ReportHandler) export(w http.ResponseWriter, r *http.Request, name string, fn exportFunc) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	query := r.URL.Query()
+	userInput := reports.ExportReq{
+		From:   query.Get("from"),
+		To:     query.Get("to"),
+		Format: query.Get("format"),
+		Lang:   query.Get("lang"),
+	}
+
+	if userID := query.Get("userID"); userID != "" {
+		userInput.UserID, err = strconv.ParseInt(userID, 10, 64)
+		if err != nil {
+			res = response.Error(response.StatusUnprocessableEntity, err)
+			res.JSON(w)
+			return
+		}
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	filename := fmt.Sprintf("%s_%s_%s.%s", name, userInput.From, userInput.To, userInput.Format)
+	file := export.NewFileWriter(w, export.ContentType(userInput.Format), filename)
+
+	res = fn(ctx, claims.ID, claims.Role, userInput, file)
+
+	if !file.Started() {
+		res.JSON(w)
+		return
+	}
+
+	if res.Err() != nil {
+		log.Println(res.Err())
+	}
+}
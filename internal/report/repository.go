@@ -0,0 +1,279 @@
+package report
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/leaves"
+	"github.com/Risuii/models/reports"
+)
+
+type (
+	ReportRepository interface {
+		FindEmployees(ctx context.Context, userID int64) ([]reports.Employee, error)
+		FindAttendance(ctx context.Context, userID int64, from string, to string) ([]absensis.Absensi, error)
+		FindLeaves(ctx context.Context, userID int64, from string, to string) ([]leaves.Leave, error)
+		StreamAbsensi(ctx context.Context, params reports.ExportReq, fn func(absensis.Absensi) error) error
+		StreamActivity(ctx context.Context, params reports.ExportReq, fn func(activitys.Activity) error) error
+	}
+
+	reportRepositoryImpl struct {
+		db *sql.DB
+	}
+)
+
+func NewReportRepositoryImpl(db *sql.DB) ReportRepository {
+	return &reportRepositoryImpl{
+		db: db,
+	}
+}
+
+// FindEmployees returns every employee of the company with their shift, or
+// only the employee with userID when it is not zero.
+func (rr *reportRepositoryImpl) FindEmployees(ctx context.Context, userID int64) ([]reports.Employee, error) {
+	employee := []reports.Employee{}
+
+	query := fmt.Sprintf(`SELECT e.id, e.code, e.name, s.id, s.name, s.start_time, s.end_time, s.grace_minutes, s.work_days FROM %s e LEFT JOIN %s s ON s.id = e.shift_id WHERE e.company_id = ? AND (? = 0 OR e.id = ?) ORDER BY e.id`, constant.TableEmployee, constant.TableShift)
+	rows, err := rr.db.QueryContext(ctx, query, tenant.ID(ctx), userID, userID)
+	if err != nil {
+		log.Println(err)
+		return employee, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var e reports.Employee
+		var code sql.NullString
+		var shiftID sql.NullInt64
+		var shiftName, startTime, endTime, workDays sql.NullString
+		var grace sql.NullInt64
+		if err := rows.Scan(
+			&e.UserID,
+			&code,
+			&e.Name,
+			&shiftID,
+			&shiftName,
+			&startTime,
+			&endTime,
+			&grace,
+			&workDays,
+		); err != nil {
+			log.Println(err)
+			return employee, exception.ErrInternalServer
+		}
+		e.Code = code.String
+		e.Shift.ID = shiftID.Int64
+		e.Shift.Name = shiftName.String
+		e.Shift.StartTime = startTime.String
+		e.Shift.EndTime = endTime.String
+		e.Shift.GraceMinutes = int(grace.Int64)
+		e.Shift.WorkDays = workDays.String
+		employee = append(employee, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return employee, exception.ErrInternalServer
+	}
+
+	return employee, nil
+}
+
+func (rr *reportRepositoryImpl) FindAttendance(ctx context.Context, userID int64, from string, to string) ([]absensis.Absensi, error) {
+	absensi := []absensis.Absensi{}
+
+	query := fmt.Sprintf(`SELECT id, userID, name, checkin, checkout, date, status, approved_overtime_minutes FROM %s WHERE company_id = ? AND (? = 0 OR userID = ?) AND date BETWEEN ? AND ? ORDER BY userID, date, id`, constant.TableAbsensi)
+	rows, err := rr.db.QueryContext(ctx, query, tenant.ID(ctx), userID, userID, from, to)
+	if err != nil {
+		log.Println(err)
+		return absensi, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var c absensis.Absensi
+		var checkin, checkout, date sql.NullTime
+		if err := rows.Scan(
+			&c.ID,
+			&c.UserID,
+			&c.Name,
+			&checkin,
+			&checkout,
+			&date,
+			&c.Status,
+			&c.ApprovedOvertimeMinutes,
+		); err != nil {
+			log.Println(err)
+			return absensi, exception.ErrInternalServer
+		}
+		if checkin.Valid {
+			c.Checkin = checkin.Time
+		}
+		if checkout.Valid {
+			c.Checkout = checkout.Time
+		}
+		if date.Valid {
+			c.Date = date.Time
+		}
+		absensi = append(absensi, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return absensi, exception.ErrInternalServer
+	}
+
+	return absensi, nil
+}
+
+func (rr *reportRepositoryImpl) FindLeaves(ctx context.Context, userID int64, from string, to string) ([]leaves.Leave, error) {
+	leave := []leaves.Leave{}
+
+	query := fmt.Sprintf(`SELECT id, userID, start_date, end_date, type FROM %s WHERE userID IN (SELECT id FROM %s WHERE company_id = ?) AND (? = 0 OR userID = ?) AND start_date <= ? AND end_date >= ?`, constant.TableLeave, constant.TableEmployee)
+	rows, err := rr.db.QueryContext(ctx, query, tenant.ID(ctx), userID, userID, to, from)
+	if err != nil {
+		log.Println(err)
+		return leave, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var l leaves.Leave
+		var leaveType sql.NullString
+		if err := rows.Scan(
+			&l.ID,
+			&l.UserID,
+			&l.StartDate,
+			&l.EndDate,
+			&leaveType,
+		); err != nil {
+			log.Println(err)
+			return leave, exception.ErrInternalServer
+		}
+		l.Type = leaveType.String
+		leave = append(leave, l)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return leave, exception.ErrInternalServer
+	}
+
+	return leave, nil
+}
+
+// StreamAbsensi calls fn for every absen row in the export range as it is
+// read, so the caller can write it out without buffering the whole result.
+func (rr *reportRepositoryImpl) StreamAbsensi(ctx context.Context, params reports.ExportReq, fn func(absensis.Absensi) error) error {
+	members, ids := inMembers(params.UserIDs)
+	query := fmt.Sprintf(`SELECT id, userID, name, checkin, checkout, date, status, overtime_minutes, approved_overtime_minutes FROM %s WHERE company_id = ? AND (? = 0 OR userID = ?) AND date BETWEEN ? AND ?%s ORDER BY date, id`, constant.TableAbsensi, members)
+	args := append([]interface{}{tenant.ID(ctx), params.UserID, params.UserID, params.From, params.To}, ids...)
+	rows, err := rr.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var c absensis.Absensi
+		var checkin, checkout, date sql.NullTime
+		if err := rows.Scan(
+			&c.ID,
+			&c.UserID,
+			&c.Name,
+			&checkin,
+			&checkout,
+			&date,
+			&c.Status,
+			&c.OvertimeMinutes,
+			&c.ApprovedOvertimeMinutes,
+		); err != nil {
+			log.Println(err)
+			return exception.ErrInternalServer
+		}
+		c.Checkin = checkin.Time
+		c.Checkout = checkout.Time
+		c.Date = date.Time
+
+		if err := fn(c); err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	return nil
+}
+
+func (rr *reportRepositoryImpl) StreamActivity(ctx context.Context, params reports.ExportReq, fn func(activitys.Activity) error) error {
+	members, ids := inMembers(params.UserIDs)
+	query := fmt.Sprintf(`SELECT id, userID, deskripsi, created_at, update_at FROM %s WHERE company_id = ? AND (? = 0 OR userID = ?) AND DATE(created_at) BETWEEN ? AND ? AND deleted_at IS NULL%s ORDER BY created_at, id`, constant.TableActivity, members)
+	args := append([]interface{}{tenant.ID(ctx), params.UserID, params.UserID, params.From, params.To}, ids...)
+	rows, err := rr.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var a activitys.Activity
+		var description sql.NullString
+		var createdAt, updateAt sql.NullTime
+		if err := rows.Scan(
+			&a.ID,
+			&a.UserID,
+			&description,
+			&createdAt,
+			&updateAt,
+		); err != nil {
+			log.Println(err)
+			return exception.ErrInternalServer
+		}
+		a.Description = description.String
+		a.CreatedAt = createdAt.Time
+		a.UpdateAt = updateAt.Time
+
+		if err := fn(a); err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	return nil
+}
+
+// inMembers narrows an export down to userIDs, when the use case set any.
+func inMembers(userIDs []int64) (string, []interface{}) {
+	if len(userIDs) == 0 {
+		return "", nil
+	}
+
+	args := make([]interface{}, 0, len(userIDs))
+	for _, id := range userIDs {
+		args = append(args, id)
+	}
+
+	return fmt.Sprintf(" AND userID IN (%s)", strings.TrimSuffix(strings.Repeat("?, ", len(userIDs)), ", ")), args
+}
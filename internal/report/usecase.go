@@ -0,0 +1,385 @@
+package report
+
+import (
+	"context"
+	"io"
+	"log"
+	"time"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/export"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/org"
+	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/leaves"
+	"github.com/Risuii/models/reports"
+	"github.com/Risuii/models/shifts"
+	"github.com/Risuii/models/users"
+)
+
+type (
+	ReportUseCase interface {
+		Calculate(ctx context.Context, userID int64, from time.Time, to time.Time) ([]reports.Recap, error)
+		MonthlyRecap(ctx context.Context, userID int64, role string, params reports.RecapReq) response.Response
+		ExportAbsensi(ctx context.Context, userID int64, role string, params reports.ExportReq, w io.Writer) response.Response
+		ExportActivity(ctx context.Context, userID int64, role string, params reports.ExportReq, w io.Writer) response.Response
+	}
+
+	reportUseCaseImpl struct {
+		repository ReportRepository
+		chain      org.Chain
+	}
+)
+
+func NewReportUseCase(repo ReportRepository, chain org.Chain) ReportUseCase {
+	return &reportUseCaseImpl{
+		repository: repo,
+		chain:      chain,
+	}
+}
+
+// Calculate builds one recap per employee for the dates between from and to
+// inclusive. A zero userID recaps every employee.
+func (ru *reportUseCaseImpl) Calculate(ctx context.Context, userID int64, from time.Time, to time.Time) ([]reports.Recap, error) {
+	recap := []reports.Recap{}
+	start := from.Format("2006-01-02")
+	end := to.Format("2006-01-02")
+
+	employee, err := ru.repository.FindEmployees(ctx, userID)
+	if err != nil {
+		return recap, exception.ErrInternalServer
+	}
+
+	absensi, err := ru.repository.FindAttendance(ctx, userID, start, end)
+	if err != nil {
+		return recap, exception.ErrInternalServer
+	}
+
+	leave, err := ru.repository.FindLeaves(ctx, userID, start, end)
+	if err != nil {
+		return recap, exception.ErrInternalServer
+	}
+
+	attendance := map[int64][]absensis.Absensi{}
+	for _, a := range absensi {
+		attendance[a.UserID] = append(attendance[a.UserID], a)
+	}
+
+	leaveByUser := map[int64][]leaves.Leave{}
+	for _, l := range leave {
+		leaveByUser[l.UserID] = append(leaveByUser[l.UserID], l)
+	}
+
+	loc := tenant.Location(ctx)
+	for _, e := range employee {
+		r := summarize(e, attendance[e.UserID], from, loc)
+		r.LeaveDays = leaveDays(e.Shift, leaveByUser[e.UserID], from, to)
+		recap = append(recap, r)
+	}
+
+	return recap, nil
+}
+
+// MonthlyRecap returns the recap of params.Month. Employees may only read
+// their own recap, managers the recaps of their reporting chain and admins
+// everyone's.
+func (ru *reportUseCaseImpl) MonthlyRecap(ctx context.Context, userID int64, role string, params reports.RecapReq) response.Response {
+	target, members, res := ru.scope(ctx, userID, role, params.UserID)
+	if res != nil {
+		return res
+	}
+	params.UserID = target
+
+	from, err := time.Parse("2006-01", params.Month)
+	if err != nil {
+		return response.Error(response.StatusBadRequest, err)
+	}
+	to := from.AddDate(0, 1, -1)
+
+	recap, err := ru.Calculate(ctx, params.UserID, from, to)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if params.UserID != 0 && len(recap) == 0 {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	if members != nil {
+		recap = only(recap, members)
+	}
+
+	return response.Success(response.StatusOK, recap)
+}
+
+// ExportAbsensi writes the absen rows of the requested range to w in
+// params.Format. Nothing is written to w when an error is returned before the
+// first row, so the caller can still answer with a JSON error.
+func (ru *reportUseCaseImpl) ExportAbsensi(ctx context.Context, userID int64, role string, params reports.ExportReq, w io.Writer) response.Response {
+	target, members, res := ru.scope(ctx, userID, role, params.UserID)
+	if res != nil {
+		return res
+	}
+	params.UserID = target
+	params.UserIDs = members
+
+	writer, err := export.NewWriter(params.Format, w)
+	if err != nil {
+		return response.Error(response.StatusBadRequest, err)
+	}
+
+	header := export.Header(params.Lang, "id", "userID", "name", "date", "checkin", "checkout", "status", "overtime_minutes", "approved_overtime")
+	if err := writer.Write(header); err != nil {
+		log.Println(err)
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	err = ru.repository.StreamAbsensi(ctx, params, func(a absensis.Absensi) error {
+		return writer.Write([]interface{}{
+			a.ID,
+			a.UserID,
+			a.Name,
+			formatTime(a.Date, "2006-01-02"),
+			formatTime(a.Checkin, "2006-01-02 15:04:05"),
+			formatTime(a.Checkout, "2006-01-02 15:04:05"),
+			a.Status,
+			a.OvertimeMinutes,
+			a.ApprovedOvertimeMinutes,
+		})
+	})
+	if err != nil {
+		log.Println(err)
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if err := writer.Flush(); err != nil {
+		log.Println(err)
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, nil)
+}
+
+func (ru *reportUseCaseImpl) ExportActivity(ctx context.Context, userID int64, role string, params reports.ExportReq, w io.Writer) response.Response {
+	target, members, res := ru.scope(ctx, userID, role, params.UserID)
+	if res != nil {
+		return res
+	}
+	params.UserID = target
+	params.UserIDs = members
+
+	writer, err := export.NewWriter(params.Format, w)
+	if err != nil {
+		return response.Error(response.StatusBadRequest, err)
+	}
+
+	header := export.Header(params.Lang, "id", "userID", "description", "created_at", "update_at")
+	if err := writer.Write(header); err != nil {
+		log.Println(err)
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	err = ru.repository.StreamActivity(ctx, params, func(a activitys.Activity) error {
+		return writer.Write([]interface{}{
+			a.ID,
+			a.UserID,
+			a.Description,
+			formatTime(a.CreatedAt, "2006-01-02"),
+			formatTime(a.UpdateAt, "2006-01-02"),
+		})
+	})
+	if err != nil {
+		log.Println(err)
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if err := writer.Flush(); err != nil {
+		log.Println(err)
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, nil)
+}
+
+// scope resolves which employees a report is for. Employees are limited to
+// themselves and admins may ask for anyone or everyone (zero). Managers may
+// ask for themselves or anyone in their reporting chain, and everyone is
+// narrowed down to them and their reports, returned as members.
+func (ru *reportUseCaseImpl) scope(ctx context.Context, userID int64, role string, requested int64) (int64, []int64, response.Response) {
+	if role == users.RoleAdmin {
+		return requested, nil, nil
+	}
+
+	if requested == userID {
+		return userID, nil, nil
+	}
+
+	if role != users.RoleManager {
+		if requested != 0 {
+			return 0, nil, response.Error(response.StatusForbiddend, exception.ErrForbidden)
+		}
+
+		return userID, nil, nil
+	}
+
+	if requested != 0 {
+		above, err := ru.chain.InChain(ctx, userID, requested)
+		if err != nil {
+			return 0, nil, response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+
+		if !above {
+			return 0, nil, response.Error(response.StatusForbiddend, exception.ErrForbidden)
+		}
+
+		return requested, nil, nil
+	}
+
+	member, err := ru.chain.Reports(ctx, userID)
+	if err != nil {
+		return 0, nil, response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	members := []int64{userID}
+	for _, m := range member {
+		members = append(members, m.UserID)
+	}
+
+	return 0, members, nil
+}
+
+// only keeps the recaps of members.
+func only(recap []reports.Recap, members []int64) []reports.Recap {
+	keep := map[int64]bool{}
+	for _, id := range members {
+		keep[id] = true
+	}
+
+	scoped := []reports.Recap{}
+	for _, r := range recap {
+		if keep[r.UserID] {
+			scoped = append(scoped, r)
+		}
+	}
+
+	return scoped
+}
+
+func formatTime(t time.Time, layout string) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(layout)
+}
+
+// summarize recaps the absen rows of one employee. Check ins are read in loc,
+// the company timezone shifts are set in.
+func summarize(e reports.Employee, absensi []absensis.Absensi, from time.Time, loc *time.Location) reports.Recap {
+	recap := reports.Recap{
+		UserID: e.UserID,
+		Code:   e.Code,
+		Name:   e.Name,
+		Month:  from.Format("2006-01"),
+	}
+	overtime := 0
+
+	present := map[string]bool{}
+	wfh := map[string]bool{}
+	absent := map[string]bool{}
+	late := map[string]int{}
+
+	for _, a := range absensi {
+		date := a.Date.Format("2006-01-02")
+
+		switch a.Status {
+		case absensis.StatusAbsent:
+			absent[date] = true
+			continue
+		case absensis.StatusWFH:
+			wfh[date] = true
+		}
+
+		present[date] = true
+		overtime += a.ApprovedOvertimeMinutes
+
+		if !a.Checkin.IsZero() && !a.Checkout.IsZero() && a.Checkout.After(a.Checkin) {
+			recap.WorkedHours += a.Checkout.Sub(a.Checkin).Hours()
+		}
+
+		if minutes, ok := lateMinutes(e.Shift, a.Checkin.In(loc)); ok {
+			if _, counted := late[date]; !counted {
+				late[date] = minutes
+			}
+		}
+	}
+
+	for date := range absent {
+		if !present[date] {
+			recap.DaysAbsent++
+		}
+	}
+
+	for _, minutes := range late {
+		recap.LateMinutes += minutes
+	}
+
+	recap.DaysPresent = len(present)
+	recap.WFHDays = len(wfh)
+	recap.DaysLate = len(late)
+	recap.WorkedHours = float64(int(recap.WorkedHours*100)) / 100
+	recap.OvertimeHours = float64(int(float64(overtime)/60*100)) / 100
+
+	return recap
+}
+
+// lateMinutes reports how long checkin is past the shift start, counted only
+// once checkin is past the grace period. The shift starts on the day and in
+// the location of checkin.
+func lateMinutes(s shifts.Shift, checkin time.Time) (int, bool) {
+	if s.StartTime == "" || checkin.IsZero() {
+		return 0, false
+	}
+
+	start, err := time.Parse("15:04:05", s.StartTime)
+	if err != nil {
+		start, err = time.Parse("15:04", s.StartTime)
+		if err != nil {
+			return 0, false
+		}
+	}
+
+	shiftStart := time.Date(checkin.Year(), checkin.Month(), checkin.Day(), start.Hour(), start.Minute(), start.Second(), 0, checkin.Location())
+	deadline := shiftStart.Add(time.Duration(s.GraceMinutes) * time.Minute)
+	if !checkin.After(deadline) {
+		return 0, false
+	}
+
+	return int(checkin.Sub(shiftStart).Minutes()), true
+}
+
+// leaveDays counts the work days between from and to covered by a leave.
+func leaveDays(s shifts.Shift, leave []leaves.Leave, from time.Time, to time.Time) int {
+	days := 0
+
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		if !s.IsWorkDay(d) {
+			continue
+		}
+
+		for _, l := range leave {
+			if !d.Before(truncate(l.StartDate)) && !d.After(truncate(l.EndDate)) {
+				days++
+				break
+			}
+		}
+	}
+
+	return days
+}
+
+func truncate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,59 @@
+package search
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/searches"
+)
+
+type SearchHandler struct {
+	Validate *validator.Validate
+	UseCase  SearchUseCase
+}
+
+func NewSearchHandler(router *mux.Router, validate *validator.Validate, usecase SearchUseCase) {
+	handler := &SearchHandler{
+		Validate: validate,
+		UseCase:  usecase,
+	}
+
+	api := router.PathPrefix("/account").Subrouter()
+
+	api.HandleFunc("/activity/search", handler.Activity).Methods(http.MethodGet)
+}
+
+func (handler *SearchHandler) Activity(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput searches.SearchReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Activity(ctx, claims.ID, claims.Role, userInput)
+
+	res.JSON(w)
+}
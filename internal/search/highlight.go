@@ -0,0 +1,97 @@
+package search
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// snippetWidth is roughly how many bytes of the description a snippet shows.
+const snippetWidth = 160
+
+// Terms splits a search query into the lower cased words it is made of. The
+// characters MySQL treats as boolean operators are dropped so user input can
+// not change the meaning of the fulltext query.
+func Terms(query string) []string {
+	terms := []string{}
+	seen := map[string]bool{}
+
+	clean := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`+-<>()~*"@%_\`, r) {
+			return ' '
+		}
+		return r
+	}, strings.ToLower(query))
+
+	for _, term := range strings.Fields(clean) {
+		if seen[term] {
+			continue
+		}
+		seen[term] = true
+		terms = append(terms, term)
+	}
+
+	return terms
+}
+
+// Highlight cuts a snippet of text around the first term found and wraps
+// every term in it with <mark>. The text is HTML escaped, so the snippet is
+// safe to render as is.
+func Highlight(text string, terms []string) string {
+	if len(terms) == 0 {
+		return html.EscapeString(truncate(text, 0, snippetWidth))
+	}
+
+	quoted := make([]string, 0, len(terms))
+	for _, term := range terms {
+		quoted = append(quoted, regexp.QuoteMeta(term))
+	}
+	pattern := regexp.MustCompile(`(?i)` + strings.Join(quoted, "|"))
+
+	start := 0
+	if loc := pattern.FindStringIndex(text); loc != nil && loc[0] > snippetWidth/3 {
+		start = loc[0] - snippetWidth/3
+		for start > 0 && !utf8.RuneStart(text[start]) {
+			start--
+		}
+	}
+
+	excerpt := truncate(text, start, snippetWidth)
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+
+	last := 0
+	for _, loc := range pattern.FindAllStringIndex(excerpt, -1) {
+		b.WriteString(html.EscapeString(excerpt[last:loc[0]]))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(excerpt[loc[0]:loc[1]]))
+		b.WriteString("</mark>")
+		last = loc[1]
+	}
+	b.WriteString(html.EscapeString(excerpt[last:]))
+
+	if start+len(excerpt) < len(text) {
+		b.WriteString("…")
+	}
+
+	return b.String()
+}
+
+// truncate returns at most width bytes of text from start without splitting
+// a rune.
+func truncate(text string, start int, width int) string {
+	end := start + width
+	if end >= len(text) {
+		return text[start:]
+	}
+
+	for end > start && !utf8.RuneStart(text[end]) {
+		end--
+	}
+
+	return text[start:end]
+}
@@ -0,0 +1,156 @@
+package search
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/models/paginations"
+	"github.com/Risuii/models/searches"
+)
+
+type (
+	SearchRepository interface {
+		Search(ctx context.Context, terms []string, params searches.SearchReq) ([]searches.Result, error)
+		Count(ctx context.Context, terms []string, params searches.SearchReq) (int64, error)
+	}
+
+	searchRepositoryImpl struct {
+		db       *sql.DB
+		fullText bool
+	}
+)
+
+// NewSearchRepositoryImpl matches descriptions with the activity FULLTEXT
+// index, or with LIKE when fullText is false for databases without one.
+func NewSearchRepositoryImpl(db *sql.DB, fullText bool) SearchRepository {
+	return &searchRepositoryImpl{
+		db:       db,
+		fullText: fullText,
+	}
+}
+
+func (sr *searchRepositoryImpl) Search(ctx context.Context, terms []string, params searches.SearchReq) ([]searches.Result, error) {
+	result := []searches.Result{}
+
+	where, args := sr.filter(ctx, terms, params)
+
+	if params.Cursor > 0 {
+		if params.Sort == paginations.SortDesc {
+			where += " AND a.id < ?"
+		} else {
+			where += " AND a.id > ?"
+		}
+		args = append(args, params.Cursor)
+	}
+
+	order := "ASC"
+	if params.Sort == paginations.SortDesc {
+		order = "DESC"
+	}
+
+	args = append(args, params.Limit)
+
+	query := fmt.Sprintf(`SELECT a.id, a.userID, e.name, a.projectID, a.deskripsi, a.created_at FROM %s a JOIN %s e ON e.id = a.userID WHERE %s ORDER BY a.id %s LIMIT ?`, constant.TableActivity, constant.TableEmployee, where, order)
+	rows, err := sr.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return result, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var r searches.Result
+		var projectID sql.NullInt64
+		if err := rows.Scan(
+			&r.ID,
+			&r.UserID,
+			&r.Name,
+			&projectID,
+			&r.Description,
+			&r.CreatedAt,
+		); err != nil {
+			log.Println(err)
+			return result, exception.ErrInternalServer
+		}
+		r.ProjectID = projectID.Int64
+		result = append(result, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return result, exception.ErrInternalServer
+	}
+
+	return result, nil
+}
+
+func (sr *searchRepositoryImpl) Count(ctx context.Context, terms []string, params searches.SearchReq) (int64, error) {
+	var total int64
+
+	where, args := sr.filter(ctx, terms, params)
+
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s a WHERE %s`, constant.TableActivity, where)
+	if err := sr.db.QueryRowContext(ctx, query, args...).Scan(&total); err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	return total, nil
+}
+
+// filter requires every term to appear in the description, as a word prefix
+// in fulltext mode and as a substring with LIKE.
+func (sr *searchRepositoryImpl) filter(ctx context.Context, terms []string, params searches.SearchReq) (string, []interface{}) {
+	where := []string{"a.company_id = ?", "a.deleted_at IS NULL"}
+	args := []interface{}{tenant.ID(ctx)}
+
+	if sr.fullText {
+		boolean := make([]string, 0, len(terms))
+		for _, term := range terms {
+			boolean = append(boolean, "+"+term+"*")
+		}
+		where = append(where, "MATCH(a.deskripsi) AGAINST (? IN BOOLEAN MODE)")
+		args = append(args, strings.Join(boolean, " "))
+	} else {
+		for _, term := range terms {
+			where = append(where, "a.deskripsi LIKE ?")
+			args = append(args, "%"+term+"%")
+		}
+	}
+
+	if params.UserID != 0 {
+		where = append(where, "a.userID = ?")
+		args = append(args, params.UserID)
+	}
+
+	if len(params.UserIDs) > 0 {
+		where = append(where, fmt.Sprintf("a.userID IN (%s)", strings.TrimSuffix(strings.Repeat("?, ", len(params.UserIDs)), ", ")))
+		for _, id := range params.UserIDs {
+			args = append(args, id)
+		}
+	}
+
+	if params.ProjectID != 0 {
+		where = append(where, "a.projectID = ?")
+		args = append(args, params.ProjectID)
+	}
+
+	if params.From != "" {
+		where = append(where, "DATE(a.created_at) >= ?")
+		args = append(args, params.From)
+	}
+
+	if params.To != "" {
+		where = append(where, "DATE(a.created_at) <= ?")
+		args = append(args, params.To)
+	}
+
+	return strings.Join(where, " AND "), args
+}
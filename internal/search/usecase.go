@@ -0,0 +1,118 @@
+package search
+
+import (
+	"context"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/org"
+	"github.com/Risuii/models/paginations"
+	"github.com/Risuii/models/searches"
+	"github.com/Risuii/models/users"
+)
+
+type (
+	SearchUseCase interface {
+		Activity(ctx context.Context, userID int64, role string, params searches.SearchReq) response.Response
+	}
+
+	searchUseCaseImpl struct {
+		repository SearchRepository
+		chain      org.Chain
+	}
+)
+
+func NewSearchUseCase(repo SearchRepository, chain org.Chain) SearchUseCase {
+	return &searchUseCaseImpl{
+		repository: repo,
+		chain:      chain,
+	}
+}
+
+// Activity searches activity descriptions, newest first unless asked
+// otherwise. Employees only search their own activities, managers their own
+// and their reporting chain's, admins everyone's. Managers and admins can
+// narrow it down with userID.
+func (su *searchUseCaseImpl) Activity(ctx context.Context, userID int64, role string, params searches.SearchReq) response.Response {
+	switch role {
+	case users.RoleAdmin:
+	case users.RoleManager:
+		if res := su.scope(ctx, userID, &params); res != nil {
+			return res
+		}
+	default:
+		if params.UserID != 0 && params.UserID != userID {
+			return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+		}
+		params.UserID = userID
+	}
+
+	terms := Terms(params.Query)
+	if len(terms) == 0 {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	if params.Sort == "" {
+		params.Sort = paginations.SortDesc
+	}
+	params.Pagination = params.Pagination.Normalize()
+
+	result, err := su.repository.Search(ctx, terms, params)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	total, err := su.repository.Count(ctx, terms, params)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	for i := range result {
+		result[i].Snippet = Highlight(result[i].Description, terms)
+	}
+
+	page := response.Page{
+		Limit: params.Limit,
+		Sort:  params.Sort,
+		Total: total,
+	}
+
+	if len(result) == params.Limit {
+		page.NextCursor = result[len(result)-1].ID
+	}
+
+	return response.SuccessWithPage(response.StatusOK, result, page)
+}
+
+// scope limits params to the manager and their reports. Asking for someone
+// outside of them is forbidden.
+func (su *searchUseCaseImpl) scope(ctx context.Context, managerID int64, params *searches.SearchReq) response.Response {
+	if params.UserID == managerID {
+		return nil
+	}
+
+	if params.UserID != 0 {
+		above, err := su.chain.InChain(ctx, managerID, params.UserID)
+		if err != nil {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+
+		if !above {
+			return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+		}
+
+		return nil
+	}
+
+	member, err := su.chain.Reports(ctx, managerID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	params.UserIDs = []int64{managerID}
+	for _, m := range member {
+		params.UserIDs = append(params.UserIDs, m.UserID)
+	}
+
+	return nil
+}
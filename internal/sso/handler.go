@@ -0,0 +1,111 @@
+package sso
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/identities"
+)
+
+// stateCookie keeps state and nonce of the login in flight, it only has to
+// outlive the visit to the provider.
+const (
+	stateCookie = "sso-state"
+	stateMaxAge = 10 * 60
+)
+
+type SSOHandler struct {
+	Validate *validator.Validate
+	UseCase  SSOUseCase
+}
+
+func NewSSOHandler(router *mux.Router, validate *validator.Validate, usecase SSOUseCase) {
+	handler := &SSOHandler{
+		Validate: validate,
+		UseCase:  usecase,
+	}
+
+	router.HandleFunc("/sso/login", handler.Login).Methods(http.MethodGet)
+	router.HandleFunc("/sso/callback", handler.Callback).Methods(http.MethodGet)
+}
+
+// Login redirects the browser to the provider.
+func (handler *SSOHandler) Login(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	res, login := handler.UseCase.Start(ctx)
+	if res.Err() != nil {
+		res.JSON(w)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookie,
+		Path:     "/sso",
+		Value:    fmt.Sprintf("%s.%s", login.State, login.Nonce),
+		HttpOnly: true,
+		MaxAge:   stateMaxAge,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, login.URL, http.StatusFound)
+}
+
+// Callback is where the provider redirects back to, on success the token
+// cookie is set just like after a password login.
+func (handler *SSOHandler) Callback(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	var login identities.Login
+	if c, err := r.Cookie(stateCookie); err == nil {
+		login.State, login.Nonce, _ = strings.Cut(c.Value, ".")
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookie,
+		Path:     "/sso",
+		Value:    "",
+		HttpOnly: true,
+		MaxAge:   -1,
+	})
+
+	query := r.URL.Query()
+
+	if query.Get("error") != "" {
+		res = response.Error(response.StatusUnauthorized, exception.ErrUnauthorized)
+		res.JSON(w)
+		return
+	}
+
+	userInput := identities.CallbackReq{
+		Code:  query.Get("code"),
+		State: query.Get("state"),
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res, token := handler.UseCase.Callback(ctx, userInput, login)
+
+	if token.Token != "" {
+		http.SetCookie(w, &http.Cookie{
+			Name:     "token",
+			Path:     "/",
+			Value:    token.Token,
+			HttpOnly: true,
+		})
+	}
+
+	res.JSON(w)
+}
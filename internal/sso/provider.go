@@ -0,0 +1,362 @@
+package sso
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	newJWT "github.com/dgrijalva/jwt-go"
+
+	"github.com/Risuii/models/identities"
+)
+
+type (
+	// Provider runs the authorization code flow against an OpenID Connect
+	// provider.
+	Provider interface {
+		AuthURL(ctx context.Context, state string, nonce string) (string, error)
+		Exchange(ctx context.Context, code string, nonce string) (identities.Identity, error)
+	}
+
+	providerImpl struct {
+		settings identities.Settings
+		client   *http.Client
+
+		mu       sync.Mutex
+		metadata *metadata
+		keys     map[string]*rsa.PublicKey
+	}
+
+	metadata struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		JWKSURI               string `json:"jwks_uri"`
+	}
+
+	tokenResponse struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+
+	jsonWebKey struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	}
+
+	idClaims struct {
+		Issuer          string   `json:"iss"`
+		Subject         string   `json:"sub"`
+		Audience        audience `json:"aud"`
+		AuthorizedParty string   `json:"azp"`
+		ExpiresAt       int64    `json:"exp"`
+		IssuedAt        int64    `json:"iat"`
+		Nonce           string   `json:"nonce"`
+		Email           string   `json:"email"`
+		EmailVerified   bool     `json:"email_verified"`
+		Name            string   `json:"name"`
+	}
+
+	// audience is a single client ID or a list of them.
+	audience []string
+)
+
+var defaultScopes = []string{"openid", "email", "profile"}
+
+// NewProvider reads the provider metadata on first use, so the app still
+// starts while the provider is unreachable.
+func NewProvider(settings identities.Settings) Provider {
+	settings.Issuer = strings.TrimSuffix(settings.Issuer, "/")
+	if len(settings.Scopes) == 0 {
+		settings.Scopes = defaultScopes
+	}
+	if !contains(settings.Scopes, "openid") {
+		settings.Scopes = append([]string{"openid"}, settings.Scopes...)
+	}
+
+	return &providerImpl{
+		settings: settings,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *providerImpl) AuthURL(ctx context.Context, state string, nonce string) (string, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", p.settings.ClientID)
+	query.Set("redirect_uri", p.settings.RedirectURL)
+	query.Set("scope", strings.Join(p.settings.Scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+
+	separator := "?"
+	if strings.Contains(meta.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return meta.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+// Exchange redeems the code at the token endpoint and returns who the
+// verified ID token is about. The token has to be issued for this client in
+// answer to the request that carried nonce.
+func (p *providerImpl) Exchange(ctx context.Context, code string, nonce string) (identities.Identity, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return identities.Identity{}, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.settings.RedirectURL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return identities.Identity{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(p.settings.ClientID), url.QueryEscape(p.settings.ClientSecret))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return identities.Identity{}, err
+	}
+	defer resp.Body.Close()
+
+	var body tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return identities.Identity{}, fmt.Errorf("token endpoint answered %s: %w", resp.Status, err)
+	}
+
+	if resp.StatusCode != http.StatusOK || body.Error != "" {
+		return identities.Identity{}, fmt.Errorf("token endpoint answered %s: %s %s", resp.Status, body.Error, body.ErrorDescription)
+	}
+
+	if body.IDToken == "" {
+		return identities.Identity{}, fmt.Errorf("token endpoint returned no id_token")
+	}
+
+	claims, err := p.verify(ctx, body.IDToken, nonce)
+	if err != nil {
+		return identities.Identity{}, err
+	}
+
+	return identities.Identity{
+		Issuer:        p.settings.Issuer,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+	}, nil
+}
+
+func (p *providerImpl) verify(ctx context.Context, idToken string, nonce string) (*idClaims, error) {
+	claims := &idClaims{}
+
+	_, err := newJWT.ParseWithClaims(idToken, claims, func(t *newJWT.Token) (interface{}, error) {
+		if _, ok := t.Method.(*newJWT.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+
+		kid, _ := t.Header["kid"].(string)
+		return p.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSuffix(claims.Issuer, "/") != p.settings.Issuer {
+		return nil, fmt.Errorf("id_token issued by %q", claims.Issuer)
+	}
+
+	if !contains(claims.Audience, p.settings.ClientID) {
+		return nil, fmt.Errorf("id_token not issued for this client")
+	}
+
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.settings.ClientID {
+		return nil, fmt.Errorf("id_token authorized for %q", claims.AuthorizedParty)
+	}
+
+	if claims.Nonce != nonce {
+		return nil, fmt.Errorf("id_token nonce mismatch")
+	}
+
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("id_token has no subject")
+	}
+
+	return claims, nil
+}
+
+// Valid is called while parsing, the checks that need the settings are done
+// by verify.
+func (c *idClaims) Valid() error {
+	if c.ExpiresAt == 0 || time.Now().Unix() > c.ExpiresAt {
+		return fmt.Errorf("id_token expired")
+	}
+
+	return nil
+}
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*a = list
+
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *providerImpl) discover(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	meta := &metadata{}
+	if err := p.getJSON(ctx, p.settings.Issuer+"/.well-known/openid-configuration", meta); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSuffix(meta.Issuer, "/") != p.settings.Issuer {
+		return nil, fmt.Errorf("provider metadata is for issuer %q", meta.Issuer)
+	}
+
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, fmt.Errorf("provider metadata is incomplete")
+	}
+
+	p.metadata = meta
+
+	return meta, nil
+}
+
+// key returns the signing key with ID kid. The key set is fetched again when
+// kid is unknown since the provider may have rotated its keys.
+func (p *providerImpl) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.lookup(kid); ok {
+		return key, nil
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := p.getJSON(ctx, meta.JWKSURI, &set); err != nil {
+		return nil, err
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+
+		key, err := rsaKey(k)
+		if err != nil {
+			return nil, err
+		}
+		keys[k.Kid] = key
+	}
+	p.keys = keys
+
+	if key, ok := p.lookup(kid); ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// lookup finds kid in the cached keys, a token without kid can only be
+// matched when the provider has a single key.
+func (p *providerImpl) lookup(kid string) (*rsa.PublicKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *providerImpl) getJSON(ctx context.Context, endpoint string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s answered %s", endpoint, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func rsaKey(k jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("key %q: %w", k.Kid, err)
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("key %q: %w", k.Kid, err)
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
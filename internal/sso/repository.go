@@ -0,0 +1,176 @@
+package sso
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/models/identities"
+	"github.com/Risuii/models/users"
+)
+
+type (
+	SSORepository interface {
+		FindBySubject(ctx context.Context, issuer string, subject string) (users.Employee, error)
+		Link(ctx context.Context, userID int64, params identities.Identity) error
+		Claim(ctx context.Context, userID int64, params identities.Identity, claimedAt time.Time) error
+		Provision(ctx context.Context, employee users.Employee, params identities.Identity) (int64, error)
+	}
+
+	ssoRepositoryImpl struct {
+		db *sql.DB
+	}
+)
+
+func NewSSORepositoryImpl(db *sql.DB) SSORepository {
+	return &ssoRepositoryImpl{
+		db: db,
+	}
+}
+
+// FindBySubject isn't scoped to a company, nobody is logged in yet and the
+// issuer and subject alone identify the employee.
+func (sr *ssoRepositoryImpl) FindBySubject(ctx context.Context, issuer string, subject string) (users.Employee, error) {
+	var employee users.Employee
+	var name, email sql.NullString
+	var createdAt, updateAt sql.NullTime
+
+	query := fmt.Sprintf(`SELECT e.id, e.name, e.email, e.role, e.status, e.company_id, e.created_at, e.update_at FROM %s i JOIN %s e ON e.id = i.userID WHERE i.issuer = ? AND i.subject = ?`, constant.TableIdentity, constant.TableEmployee)
+	stmt, err := sr.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return employee, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	err = stmt.QueryRowContext(ctx, issuer, subject).Scan(
+		&employee.ID,
+		&name,
+		&email,
+		&employee.Role,
+		&employee.Status,
+		&employee.CompanyID,
+		&createdAt,
+		&updateAt,
+	)
+	if err == sql.ErrNoRows {
+		return users.Employee{}, exception.ErrNotFound
+	}
+
+	if err != nil {
+		log.Println(err)
+		return users.Employee{}, exception.ErrInternalServer
+	}
+
+	employee.Name = name.String
+	employee.Email = email.String
+	employee.CreatedAt = createdAt.Time
+	employee.UpdateAt = updateAt.Time
+
+	return employee, nil
+}
+
+func (sr *ssoRepositoryImpl) Link(ctx context.Context, userID int64, params identities.Identity) error {
+	query := fmt.Sprintf(`INSERT INTO %s (userID, issuer, subject, created_at) VALUES (?, ?, ?, ?)`, constant.TableIdentity)
+	stmt, err := sr.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	if _, err := stmt.ExecContext(ctx, userID, params.Issuer, params.Subject, time.Now()); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	return nil
+}
+
+// Claim links the identity to a pending account and activates it without a
+// password, either both or none. Whoever registered the account may not be
+// the owner of the email, so the password they chose must not log in to it.
+// An account that is no longer pending is reported as conflicted.
+func (sr *ssoRepositoryImpl) Claim(ctx context.Context, userID int64, params identities.Identity, claimedAt time.Time) error {
+	tx, err := sr.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer tx.Rollback()
+
+	update := fmt.Sprintf(`UPDATE %s SET password = '', status = ?, update_at = ? WHERE id = ? AND status = ?`, constant.TableEmployee)
+	result, err := tx.ExecContext(ctx, update, users.StatusActive, claimedAt, userID, users.StatusPending)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	if rowsAffected < 1 {
+		return exception.ErrConflicted
+	}
+
+	link := fmt.Sprintf(`INSERT INTO %s (userID, issuer, subject, created_at) VALUES (?, ?, ?, ?)`, constant.TableIdentity)
+	if _, err := tx.ExecContext(ctx, link, userID, params.Issuer, params.Subject, claimedAt); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	return nil
+}
+
+// Provision creates the employee without a password together with the link
+// to their identity, either both or none.
+func (sr *ssoRepositoryImpl) Provision(ctx context.Context, employee users.Employee, params identities.Identity) (int64, error) {
+	tx, err := sr.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	defer tx.Rollback()
+
+	insert := fmt.Sprintf(`INSERT INTO %s (name, password, email, role, status, company_id, created_at, update_at) VALUES (?, '', ?, ?, ?, ?, ?, ?)`, constant.TableEmployee)
+	result, err := tx.ExecContext(ctx, insert, employee.Name, employee.Email, employee.Role, employee.Status, employee.CompanyID, employee.CreatedAt, employee.UpdateAt)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	ID, err := result.LastInsertId()
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	link := fmt.Sprintf(`INSERT INTO %s (userID, issuer, subject, created_at) VALUES (?, ?, ?, ?)`, constant.TableIdentity)
+	if _, err := tx.ExecContext(ctx, link, ID, params.Issuer, params.Subject, employee.CreatedAt); err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	return ID, nil
+}
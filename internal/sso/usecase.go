@@ -0,0 +1,215 @@
+package sso
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"log"
+	"time"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/company"
+	"github.com/Risuii/internal/user"
+	"github.com/Risuii/models/identities"
+	"github.com/Risuii/models/token"
+	"github.com/Risuii/models/users"
+)
+
+type (
+	SSOUseCase interface {
+		Start(ctx context.Context) (response.Response, identities.Login)
+		Callback(ctx context.Context, params identities.CallbackReq, login identities.Login) (response.Response, token.Token)
+	}
+
+	ssoUseCaseImpl struct {
+		repository SSORepository
+		accounts   user.UserRepository
+		companies  company.CompanyRepository
+		provider   Provider
+		settings   identities.Settings
+	}
+)
+
+func NewSSOUseCase(repo SSORepository, accounts user.UserRepository, companies company.CompanyRepository, provider Provider, settings identities.Settings) SSOUseCase {
+	return &ssoUseCaseImpl{
+		repository: repo,
+		accounts:   accounts,
+		companies:  companies,
+		provider:   provider,
+		settings:   settings,
+	}
+}
+
+// Start begins a login at the provider. The caller keeps State and Nonce of
+// the returned login until the provider redirects back.
+func (su *ssoUseCaseImpl) Start(ctx context.Context) (response.Response, identities.Login) {
+	state, err := random()
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer), identities.Login{}
+	}
+
+	nonce, err := random()
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer), identities.Login{}
+	}
+
+	URL, err := su.provider.AuthURL(ctx, state, nonce)
+	if err != nil {
+		log.Println(err)
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer), identities.Login{}
+	}
+
+	login := identities.Login{
+		URL:   URL,
+		State: state,
+		Nonce: nonce,
+	}
+
+	return response.Success(response.StatusOK, URL), login
+}
+
+// Callback finishes the login started with login and issues the same token
+// as a password login. The identity is matched by its subject first, then by
+// a verified email which links it for next time.
+func (su *ssoUseCaseImpl) Callback(ctx context.Context, params identities.CallbackReq, login identities.Login) (response.Response, token.Token) {
+	if login.State == "" || subtle.ConstantTimeCompare([]byte(params.State), []byte(login.State)) != 1 {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest), token.Token{}
+	}
+
+	identity, err := su.provider.Exchange(ctx, params.Code, login.Nonce)
+	if err != nil {
+		log.Println(err)
+		return response.Error(response.StatusUnauthorized, exception.ErrUnauthorized), token.Token{}
+	}
+
+	employee, res := su.resolve(ctx, identity)
+	if res != nil {
+		return res, token.Token{}
+	}
+
+	// the provider vouches for the email, no need to wait for our own link
+	if employee.Status == users.StatusPending {
+		if err := su.accounts.Activate(ctx, employee.ID, time.Now()); err != nil {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer), token.Token{}
+		}
+		employee.Status = users.StatusActive
+	}
+
+	if !user.IsActive(employee) {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden), token.Token{}
+	}
+
+	company, err := su.companies.FindByID(ctx, employee.CompanyID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer), token.Token{}
+	}
+
+	newToken, err := user.SignToken(employee, company.Timezone)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer), token.Token{}
+	}
+
+	employee.Password = ""
+
+	return response.Success(response.StatusOK, employee), newToken
+}
+
+// resolve returns the employee the identity belongs to, an unknown one is
+// only accepted with a verified email.
+func (su *ssoUseCaseImpl) resolve(ctx context.Context, identity identities.Identity) (users.Employee, response.Response) {
+	employee, err := su.repository.FindBySubject(ctx, identity.Issuer, identity.Subject)
+	if err == nil {
+		return employee, nil
+	}
+
+	if err != exception.ErrNotFound {
+		return users.Employee{}, response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if identity.Email == "" || !identity.EmailVerified {
+		return users.Employee{}, response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	employee, err = su.accounts.FindByEmail(ctx, identity.Email)
+	if err == nil && employee.Status == users.StatusPending {
+		// anyone can register a pending account for the email, so its
+		// password is dropped and only the identity logs in from now on
+		err := su.repository.Claim(ctx, employee.ID, identity, time.Now())
+		if err == exception.ErrConflicted {
+			return users.Employee{}, response.Error(response.StatusConflicted, exception.ErrConflicted)
+		}
+		if err != nil {
+			return users.Employee{}, response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+		employee.Password = ""
+		employee.Status = users.StatusActive
+		return employee, nil
+	}
+
+	if err == nil {
+		if err := su.repository.Link(ctx, employee.ID, identity); err != nil {
+			return users.Employee{}, response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+		return employee, nil
+	}
+
+	if err != exception.ErrNotFound {
+		return users.Employee{}, response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if !su.settings.AutoProvision {
+		return users.Employee{}, response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	return su.provision(ctx, identity)
+}
+
+func (su *ssoUseCaseImpl) provision(ctx context.Context, identity identities.Identity) (users.Employee, response.Response) {
+	companyID := tenant.Default
+	if su.settings.Company != "" {
+		company, err := su.companies.FindByCode(ctx, su.settings.Company)
+		if err != nil {
+			log.Println("sso company", su.settings.Company, err)
+			return users.Employee{}, response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+		companyID = company.ID
+	}
+
+	name := identity.Name
+	if name == "" {
+		name = identity.Email
+	}
+
+	now := time.Now()
+
+	employee := users.Employee{
+		Name:      name,
+		Email:     identity.Email,
+		Role:      users.RoleEmployee,
+		Status:    users.StatusActive,
+		CompanyID: companyID,
+		CreatedAt: now,
+		UpdateAt:  now,
+	}
+
+	ID, err := su.repository.Provision(ctx, employee, identity)
+	if err != nil {
+		return users.Employee{}, response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	employee.ID = ID
+
+	return employee, nil
+}
+
+func random() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
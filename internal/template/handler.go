@@ -0,0 +1,183 @@
+package template
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/templates"
+)
+
+type TemplateHandler struct {
+	Validate *validator.Validate
+	UseCase  TemplateUseCase
+}
+
+func NewTemplateHandler(router *mux.Router, validate *validator.Validate, usecase TemplateUseCase) {
+	handler := &TemplateHandler{
+		Validate: validate,
+		UseCase:  usecase,
+	}
+
+	api := router.PathPrefix("/account").Subrouter()
+
+	api.HandleFunc("/template", handler.Create).Methods(http.MethodPost)
+	api.HandleFunc("/template", handler.List).Methods(http.MethodGet)
+	api.HandleFunc("/template/apply", handler.Apply).Methods(http.MethodPost)
+	api.HandleFunc("/template/suggest", handler.Suggest).Methods(http.MethodGet)
+	api.HandleFunc("/template/{id}", handler.Update).Methods(http.MethodPatch)
+	api.HandleFunc("/template/{id}", handler.Delete).Methods(http.MethodDelete)
+}
+
+func (handler *TemplateHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput templates.TemplateReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Create(ctx, claims.ID, userInput)
+
+	res.JSON(w)
+}
+
+func (handler *TemplateHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput templates.TemplateReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.ParseInt(params["id"], 10, 64)
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Update(ctx, id, claims.ID, userInput)
+
+	res.JSON(w)
+}
+
+func (handler *TemplateHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.ParseInt(params["id"], 10, 64)
+
+	res = handler.UseCase.Delete(ctx, id, claims.ID)
+
+	res.JSON(w)
+}
+
+func (handler *TemplateHandler) List(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.List(ctx, claims.ID)
+
+	res.JSON(w)
+}
+
+// Apply needs the checkin token, the templates are added to its session.
+func (handler *TemplateHandler) Apply(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput templates.ApplyReq
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "checkin-token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Apply(ctx, claims.ID, claims.CheckinID, userInput)
+
+	res.JSON(w)
+}
+
+func (handler *TemplateHandler) Suggest(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "checkin-token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Suggest(ctx, claims.ID, claims.CheckinID)
+
+	res.JSON(w)
+}
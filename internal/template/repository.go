@@ -0,0 +1,265 @@
+package template
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/models/templates"
+)
+
+type (
+	TemplateRepository interface {
+		Create(ctx context.Context, params templates.Template) (int64, error)
+		Update(ctx context.Context, id int64, params templates.Template) error
+		Delete(ctx context.Context, id int64) error
+		FindByID(ctx context.Context, id int64) (templates.Template, error)
+		FindByUser(ctx context.Context, userID int64) ([]templates.Template, error)
+	}
+
+	templateRepositoryImpl struct {
+		db        *sql.DB
+		tableName string
+	}
+)
+
+func NewTemplateRepositoryImpl(db *sql.DB, tableName string) TemplateRepository {
+	return &templateRepositoryImpl{
+		db:        db,
+		tableName: tableName,
+	}
+}
+
+func (tr *templateRepositoryImpl) Create(ctx context.Context, params templates.Template) (int64, error) {
+	tags, err := json.Marshal(params.Tags)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	query := fmt.Sprintf(`INSERT INTO %s (userID, name, projectID, deskripsi, tags, duration_minutes, recurrence, days, created_at, update_at, company_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, tr.tableName)
+	stmt, err := tr.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(
+		ctx,
+		params.UserID,
+		params.Name,
+		nullID(params.ProjectID),
+		params.Description,
+		string(tags),
+		params.Duration,
+		params.Recurrence,
+		dayMask(params.Days),
+		params.CreatedAt,
+		params.UpdateAt,
+		tenant.ID(ctx),
+	)
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	ID, err := result.LastInsertId()
+	if err != nil {
+		log.Println(err)
+		return 0, exception.ErrInternalServer
+	}
+
+	return ID, nil
+}
+
+func (tr *templateRepositoryImpl) Update(ctx context.Context, id int64, params templates.Template) error {
+	tags, err := json.Marshal(params.Tags)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	query := fmt.Sprintf(`UPDATE %s SET name = ?, projectID = ?, deskripsi = ?, tags = ?, duration_minutes = ?, recurrence = ?, days = ?, update_at = ? WHERE id = ? AND company_id = ?`, tr.tableName)
+	stmt, err := tr.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(
+		ctx,
+		params.Name,
+		nullID(params.ProjectID),
+		params.Description,
+		string(tags),
+		params.Duration,
+		params.Recurrence,
+		dayMask(params.Days),
+		params.UpdateAt,
+		id,
+		tenant.ID(ctx),
+	)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	return nil
+}
+
+func (tr *templateRepositoryImpl) Delete(ctx context.Context, id int64) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = ? AND company_id = ?`, tr.tableName)
+	stmt, err := tr.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, id, tenant.ID(ctx))
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+
+	if affected == 0 {
+		return exception.ErrNotFound
+	}
+
+	return nil
+}
+
+func (tr *templateRepositoryImpl) FindByID(ctx context.Context, id int64) (templates.Template, error) {
+	query := fmt.Sprintf(`SELECT id, userID, name, projectID, deskripsi, tags, duration_minutes, recurrence, days, created_at, update_at FROM %s WHERE id = ? AND company_id = ?`, tr.tableName)
+	stmt, err := tr.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return templates.Template{}, exception.ErrInternalServer
+	}
+
+	defer stmt.Close()
+
+	template, err := scanTemplate(stmt.QueryRowContext(ctx, id, tenant.ID(ctx)))
+	if err == sql.ErrNoRows {
+		return templates.Template{}, exception.ErrNotFound
+	}
+
+	if err != nil {
+		log.Println(err)
+		return templates.Template{}, exception.ErrInternalServer
+	}
+
+	return template, nil
+}
+
+func (tr *templateRepositoryImpl) FindByUser(ctx context.Context, userID int64) ([]templates.Template, error) {
+	template := []templates.Template{}
+
+	query := fmt.Sprintf(`SELECT id, userID, name, projectID, deskripsi, tags, duration_minutes, recurrence, days, created_at, update_at FROM %s WHERE userID = ? AND company_id = ? ORDER BY name, id`, tr.tableName)
+	rows, err := tr.db.QueryContext(ctx, query, userID, tenant.ID(ctx))
+	if err != nil {
+		log.Println(err)
+		return template, exception.ErrInternalServer
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		t, err := scanTemplate(rows)
+		if err != nil {
+			log.Println(err)
+			return template, exception.ErrInternalServer
+		}
+		template = append(template, t)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println(err)
+		return template, exception.ErrInternalServer
+	}
+
+	return template, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanTemplate(row scanner) (templates.Template, error) {
+	var t templates.Template
+	var projectID sql.NullInt64
+	var tags string
+	var days uint8
+
+	if err := row.Scan(
+		&t.ID,
+		&t.UserID,
+		&t.Name,
+		&projectID,
+		&t.Description,
+		&tags,
+		&t.Duration,
+		&t.Recurrence,
+		&days,
+		&t.CreatedAt,
+		&t.UpdateAt,
+	); err != nil {
+		return templates.Template{}, err
+	}
+
+	t.ProjectID = projectID.Int64
+	t.Tags = []string{}
+	t.Days = weekdays(days)
+
+	if tags != "" {
+		if err := json.Unmarshal([]byte(tags), &t.Tags); err != nil {
+			return templates.Template{}, err
+		}
+	}
+
+	return t, nil
+}
+
+// dayMask stores the days of a weekly template as one bit per weekday.
+func dayMask(days []time.Weekday) uint8 {
+	var mask uint8
+	for _, day := range days {
+		mask |= 1 << uint(day)
+	}
+
+	return mask
+}
+
+func weekdays(mask uint8) []time.Weekday {
+	days := []time.Weekday{}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if mask&(1<<uint(day)) != 0 {
+			days = append(days, day)
+		}
+	}
+
+	return days
+}
+
+func nullID(id int64) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
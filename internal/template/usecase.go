@@ -0,0 +1,242 @@
+package template
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/absensi"
+	"github.com/Risuii/internal/activity"
+	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/templates"
+)
+
+type (
+	TemplateUseCase interface {
+		Create(ctx context.Context, userID int64, params templates.TemplateReq) response.Response
+		Update(ctx context.Context, id int64, userID int64, params templates.TemplateReq) response.Response
+		Delete(ctx context.Context, id int64, userID int64) response.Response
+		List(ctx context.Context, userID int64) response.Response
+		Apply(ctx context.Context, userID int64, checkinID int64, params templates.ApplyReq) response.Response
+		Suggest(ctx context.Context, userID int64, checkinID int64) response.Response
+	}
+
+	templateUseCaseImpl struct {
+		repository         TemplateRepository
+		activityUseCase    activity.ActivityUseCase
+		activityRepository activity.ActivityRepository
+		absensiRepository  absensi.AbsensiRepository
+	}
+)
+
+func NewTemplateUseCase(repo TemplateRepository, activityUseCase activity.ActivityUseCase, activityRepo activity.ActivityRepository, absensiRepo absensi.AbsensiRepository) TemplateUseCase {
+	return &templateUseCaseImpl{
+		repository:         repo,
+		activityUseCase:    activityUseCase,
+		activityRepository: activityRepo,
+		absensiRepository:  absensiRepo,
+	}
+}
+
+func (tu *templateUseCaseImpl) Create(ctx context.Context, userID int64, params templates.TemplateReq) response.Response {
+	if params.Recurrence == templates.RecurWeekly && len(params.Days) == 0 {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	template := newTemplate(params)
+	template.UserID = userID
+	template.CreatedAt = time.Now()
+	template.UpdateAt = template.CreatedAt
+
+	ID, err := tu.repository.Create(ctx, template)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	template.ID = ID
+
+	return response.Success(response.StatusCreated, template)
+}
+
+func (tu *templateUseCaseImpl) Update(ctx context.Context, id int64, userID int64, params templates.TemplateReq) response.Response {
+	if params.Recurrence == templates.RecurWeekly && len(params.Days) == 0 {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	existing, res := tu.findOwned(ctx, id, userID)
+	if res != nil {
+		return res
+	}
+
+	template := newTemplate(params)
+	template.ID = existing.ID
+	template.UserID = existing.UserID
+	template.CreatedAt = existing.CreatedAt
+	template.UpdateAt = time.Now()
+
+	if err := tu.repository.Update(ctx, id, template); err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, template)
+}
+
+func (tu *templateUseCaseImpl) Delete(ctx context.Context, id int64, userID int64) response.Response {
+	if _, res := tu.findOwned(ctx, id, userID); res != nil {
+		return res
+	}
+
+	err := tu.repository.Delete(ctx, id)
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	msg := "Berhasil Menghapus Template"
+
+	return response.Success(response.StatusOK, msg)
+}
+
+func (tu *templateUseCaseImpl) List(ctx context.Context, userID int64) response.Response {
+	template, err := tu.repository.FindByUser(ctx, userID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, template)
+}
+
+// Apply adds an activity for every template to the session of the checkin
+// token through AddActivities, so the templates are checked like any other
+// activity and either all of them are added or none.
+func (tu *templateUseCaseImpl) Apply(ctx context.Context, userID int64, checkinID int64, params templates.ApplyReq) response.Response {
+	owned, err := tu.repository.FindByUser(ctx, userID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	byID := make(map[int64]templates.Template, len(owned))
+	for _, t := range owned {
+		byID[t.ID] = t
+	}
+
+	items := make([]activitys.Activity, 0, len(params.IDs))
+	for _, id := range params.IDs {
+		t, ok := byID[id]
+		if !ok {
+			return response.Error(response.StatusNotFound, exception.ErrNotFound)
+		}
+
+		items = append(items, activitys.Activity{
+			ProjectID:   t.ProjectID,
+			Description: t.Description,
+			Tags:        t.Tags,
+			Duration:    t.Duration,
+		})
+	}
+
+	return tu.activityUseCase.AddActivities(ctx, userID, checkinID, items)
+}
+
+// Suggest lists the templates recurring on the day of the checkin that have
+// not been logged in the session yet, matched by description.
+func (tu *templateUseCaseImpl) Suggest(ctx context.Context, userID int64, checkinID int64) response.Response {
+	session, err := tu.absensiRepository.FindByID(ctx, checkinID)
+	if err == exception.ErrNotFound {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if session.UserID != userID {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
+
+	if !session.Checkout.IsZero() {
+		return response.Error(response.StatusConflicted, exception.ErrConflicted)
+	}
+
+	owned, err := tu.repository.FindByUser(ctx, userID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	logged, err := tu.activityRepository.FindBySession(ctx, session.ID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	done := make(map[string]bool, len(logged))
+	for _, a := range logged {
+		done[descriptionKey(a.Description)] = true
+	}
+
+	suggested := []templates.Template{}
+	for _, t := range owned {
+		if t.Due(session.Checkin) && !done[descriptionKey(t.Description)] {
+			suggested = append(suggested, t)
+		}
+	}
+
+	return response.Success(response.StatusOK, suggested)
+}
+
+// findOwned hides the templates of other users behind a 404.
+func (tu *templateUseCaseImpl) findOwned(ctx context.Context, id int64, userID int64) (templates.Template, response.Response) {
+	template, err := tu.repository.FindByID(ctx, id)
+	if err == exception.ErrNotFound || (err == nil && template.UserID != userID) {
+		return templates.Template{}, response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	if err != nil {
+		return templates.Template{}, response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return template, nil
+}
+
+func newTemplate(params templates.TemplateReq) templates.Template {
+	template := templates.Template{
+		Name:        strings.TrimSpace(params.Name),
+		ProjectID:   params.ProjectID,
+		Description: params.Description,
+		Tags:        params.Tags,
+		Duration:    params.Duration,
+		Recurrence:  params.Recurrence,
+		Days:        []time.Weekday{},
+	}
+
+	if template.Tags == nil {
+		template.Tags = []string{}
+	}
+
+	if params.Recurrence == templates.RecurWeekly {
+		template.Days = uniqueDays(params.Days)
+	}
+
+	return template
+}
+
+func uniqueDays(days []time.Weekday) []time.Weekday {
+	seen := map[time.Weekday]bool{}
+	unique := []time.Weekday{}
+
+	for _, day := range days {
+		if seen[day] {
+			continue
+		}
+		seen[day] = true
+		unique = append(unique, day)
+	}
+
+	return unique
+}
+
+func descriptionKey(description string) string {
+	return strings.ToLower(strings.TrimSpace(description))
+}
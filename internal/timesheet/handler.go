@@ -0,0 +1,85 @@
+package timesheet
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/export"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/timesheets"
+)
+
+type TimesheetHandler struct {
+	Validate *validator.Validate
+	UseCase  TimesheetUseCase
+}
+
+func NewTimesheetHandler(router *mux.Router, validate *validator.Validate, usecase TimesheetUseCase) {
+	handler := &TimesheetHandler{
+		Validate: validate,
+		UseCase:  usecase,
+	}
+
+	api := router.PathPrefix("/account").Subrouter()
+
+	api.HandleFunc("/timesheet", handler.Generate).Methods(http.MethodGet)
+}
+
+func (handler *TimesheetHandler) Generate(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	claims, err := jwt.FromCookie(r, "token")
+	if err != nil {
+		res = response.Error(response.StatusUnauthorized, err)
+		res.JSON(w)
+		return
+	}
+
+	query := r.URL.Query()
+	userInput := timesheets.TimesheetReq{
+		From: query.Get("from"),
+		To:   query.Get("to"),
+	}
+
+	if userID := query.Get("userID"); userID != "" {
+		userInput.UserID, err = strconv.ParseInt(userID, 10, 64)
+		if err != nil {
+			res = response.Error(response.StatusUnprocessableEntity, err)
+			res.JSON(w)
+			return
+		}
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	userID := userInput.UserID
+	if userID == 0 {
+		userID = claims.ID
+	}
+
+	filename := fmt.Sprintf("timesheet_%d_%s_%s.pdf", userID, userInput.From, userInput.To)
+	file := export.NewFileWriter(w, "application/pdf", filename)
+
+	res = handler.UseCase.Generate(ctx, claims.ID, claims.Role, userInput, file)
+
+	if !file.Started() {
+		res.JSON(w)
+		return
+	}
+
+	if res.Err() != nil {
+		log.Println(res.Err())
+	}
+}
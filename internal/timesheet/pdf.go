@@ -0,0 +1,151 @@
+package timesheet
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+
+	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/timesheets"
+)
+
+const (
+	lineHeight   = 5.0
+	marginBottom = 15.0
+)
+
+var (
+	columnTitle = []string{"Tanggal", "Masuk", "Keluar", "Istirahat", "Jam Kerja", "Aktivitas"}
+	columnWidth = []float64{22, 18, 18, 20, 20, 92}
+)
+
+// render writes sheet as an A4 timesheet with a daily table, totals and
+// signature boxes for the employee and their supervisor.
+func render(sheet timesheets.Timesheet, w io.Writer) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(10, 15, 10)
+	pdf.SetAutoPageBreak(false, marginBottom)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 10, "Timesheet", "", 1, "C", false, 0, "")
+
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(30, 6, "Nama", "", 0, "", false, 0, "")
+	pdf.CellFormat(0, 6, tr(": "+sheet.Name), "", 1, "", false, 0, "")
+	pdf.CellFormat(30, 6, "ID Karyawan", "", 0, "", false, 0, "")
+	pdf.CellFormat(0, 6, fmt.Sprintf(": %d", sheet.UserID), "", 1, "", false, 0, "")
+	pdf.CellFormat(30, 6, "Periode", "", 0, "", false, 0, "")
+	pdf.CellFormat(0, 6, fmt.Sprintf(": %s - %s", sheet.From.Format("02/01/2006"), sheet.To.Format("02/01/2006")), "", 1, "", false, 0, "")
+	pdf.Ln(4)
+
+	tableHeader(pdf)
+
+	_, pageHeight := pdf.GetPageSize()
+
+	for _, d := range sheet.Days {
+		checkin, checkout := sessionLines(d.Sessions)
+
+		cells := []string{
+			d.Date.Format("02/01/2006"),
+			strings.Join(checkin, "\n"),
+			strings.Join(checkout, "\n"),
+			fmt.Sprintf("%d mnt", d.BreakMinutes),
+			fmt.Sprintf("%.2f", d.WorkedHours),
+			tr(strings.Join(d.Activities, "\n")),
+		}
+
+		lines := 1
+		for i, text := range cells {
+			n := len(pdf.SplitText(text, columnWidth[i]-2))
+			if n > lines {
+				lines = n
+			}
+		}
+		height := float64(lines)*lineHeight + 2
+
+		if pdf.GetY()+height > pageHeight-marginBottom {
+			pdf.AddPage()
+			tableHeader(pdf)
+		}
+
+		x, y := pdf.GetXY()
+		for i, text := range cells {
+			align := "C"
+			if i == len(cells)-1 {
+				align = "L"
+			}
+
+			pdf.Rect(x, y, columnWidth[i], height, "D")
+			pdf.SetXY(x+1, y+1)
+			pdf.MultiCell(columnWidth[i]-2, lineHeight, text, "", align, false)
+			x += columnWidth[i]
+		}
+		pdf.SetXY(10, y+height)
+	}
+
+	if pdf.GetY()+60 > pageHeight-marginBottom {
+		pdf.AddPage()
+	}
+
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(columnWidth[0]+columnWidth[1]+columnWidth[2], 7, "Total", "1", 0, "C", false, 0, "")
+	pdf.CellFormat(columnWidth[3], 7, fmt.Sprintf("%d mnt", sheet.BreakMinutes), "1", 0, "C", false, 0, "")
+	pdf.CellFormat(columnWidth[4], 7, fmt.Sprintf("%.2f", sheet.WorkedHours), "1", 0, "C", false, 0, "")
+	pdf.CellFormat(columnWidth[5], 7, fmt.Sprintf("%d hari, %d aktivitas", len(sheet.Days), sheet.Activities), "1", 1, "L", false, 0, "")
+
+	pdf.Ln(15)
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(95, 6, "Karyawan", "", 0, "C", false, 0, "")
+	pdf.CellFormat(95, 6, "Atasan", "", 1, "C", false, 0, "")
+	pdf.Ln(20)
+	pdf.CellFormat(95, 6, tr("( "+sheet.Name+" )"), "", 0, "C", false, 0, "")
+	pdf.CellFormat(95, 6, "(                              )", "", 1, "C", false, 0, "")
+
+	return pdf.Output(w)
+}
+
+func tableHeader(pdf *fpdf.Fpdf) {
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.SetFillColor(230, 230, 230)
+	for i, title := range columnTitle {
+		pdf.CellFormat(columnWidth[i], 7, title, "1", 0, "C", true, 0, "")
+	}
+	pdf.Ln(-1)
+	pdf.SetFont("Helvetica", "", 9)
+}
+
+func sessionLines(sessions []timesheets.Session) ([]string, []string) {
+	checkin := []string{}
+	checkout := []string{}
+
+	for _, s := range sessions {
+		if s.Status == absensis.StatusAbsent {
+			checkin = append(checkin, "Absen")
+			checkout = append(checkout, "-")
+			continue
+		}
+
+		in := "-"
+		if !s.Checkin.IsZero() {
+			in = s.Checkin.Format("15:04")
+		}
+		if s.Status == absensis.StatusWFH {
+			in += " (WFH)"
+		}
+
+		out := "-"
+		if !s.Checkout.IsZero() {
+			out = s.Checkout.Format("15:04")
+		}
+
+		checkin = append(checkin, in)
+		checkout = append(checkout, out)
+	}
+
+	return checkin, checkout
+}
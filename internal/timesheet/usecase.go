@@ -0,0 +1,250 @@
+package timesheet
+
+import (
+	"context"
+	"io"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/absensi"
+	"github.com/Risuii/internal/activity"
+	"github.com/Risuii/internal/org"
+	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/paginations"
+	"github.com/Risuii/models/timesheets"
+	"github.com/Risuii/models/users"
+)
+
+const pageLimit = 100
+
+type (
+	TimesheetUseCase interface {
+		Build(ctx context.Context, userID int64, from time.Time, to time.Time) (timesheets.Timesheet, error)
+		Generate(ctx context.Context, userID int64, role string, params timesheets.TimesheetReq, w io.Writer) response.Response
+	}
+
+	timesheetUseCaseImpl struct {
+		absensiRepository  absensi.AbsensiRepository
+		activityRepository activity.ActivityRepository
+		chain              org.Chain
+	}
+)
+
+func NewTimesheetUseCase(absensiRepo absensi.AbsensiRepository, activityRepo activity.ActivityRepository, chain org.Chain) TimesheetUseCase {
+	return &timesheetUseCaseImpl{
+		absensiRepository:  absensiRepo,
+		activityRepository: activityRepo,
+		chain:              chain,
+	}
+}
+
+// Build collects every session and activity of userID between from and to
+// inclusive, paging through the riwayat queries until they run out. Session
+// times are set in the company timezone, as the timesheet prints them.
+func (tu *timesheetUseCaseImpl) Build(ctx context.Context, userID int64, from time.Time, to time.Time) (timesheets.Timesheet, error) {
+	sheet := timesheets.Timesheet{
+		UserID: userID,
+		From:   from,
+		To:     to,
+		Days:   []timesheets.Day{},
+	}
+
+	absensiParams := absensis.Riwayat{
+		UserID: userID,
+		From:   from.Format("2006-01-02"),
+		To:     to.Format("2006-01-02"),
+		Pagination: paginations.Pagination{
+			Limit: pageLimit,
+			Sort:  paginations.SortAsc,
+		},
+	}
+
+	sessions := []absensis.Absensi{}
+	for {
+		rows, err := tu.absensiRepository.Riwayat(ctx, absensiParams)
+		if err != nil {
+			return sheet, exception.ErrInternalServer
+		}
+
+		sessions = append(sessions, rows...)
+		if len(rows) < pageLimit {
+			break
+		}
+		absensiParams.Cursor = rows[len(rows)-1].ID
+	}
+
+	activityParams := activitys.DateReq{
+		From: absensiParams.From,
+		To:   absensiParams.To,
+		Pagination: paginations.Pagination{
+			Limit: pageLimit,
+			Sort:  paginations.SortAsc,
+		},
+	}
+
+	activities := []activitys.Activity{}
+	for {
+		rows, err := tu.activityRepository.Riwayat(ctx, userID, activityParams)
+		if err != nil {
+			return sheet, exception.ErrInternalServer
+		}
+
+		activities = append(activities, rows...)
+		if len(rows) < pageLimit {
+			break
+		}
+		activityParams.Cursor = rows[len(rows)-1].ID
+	}
+
+	// sessions and activities are laid out on the company's calendar and clock
+	loc := tenant.Location(ctx)
+
+	days := map[string]*timesheets.Day{}
+	day := func(t time.Time) *timesheets.Day {
+		key := t.Format("2006-01-02")
+		if _, ok := days[key]; !ok {
+			days[key] = &timesheets.Day{
+				Date:       time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC),
+				Sessions:   []timesheets.Session{},
+				Activities: []string{},
+			}
+		}
+		return days[key]
+	}
+
+	for _, s := range sessions {
+		if sheet.Name == "" {
+			sheet.Name = s.Name
+		}
+
+		date := s.Date
+		if date.IsZero() {
+			date = s.Checkin.In(loc)
+		}
+
+		d := day(date)
+		d.Sessions = append(d.Sessions, timesheets.Session{
+			ID:         s.ID,
+			Checkin:    s.Checkin.In(loc),
+			Checkout:   s.Checkout.In(loc),
+			Status:     s.Status,
+			Activities: []string{},
+		})
+	}
+
+	for _, a := range activities {
+		d := day(a.CreatedAt.In(loc))
+		d.Activities = append(d.Activities, a.Description)
+		sheet.Activities++
+
+		if a.AbsenID == 0 {
+			continue
+		}
+		for i := range d.Sessions {
+			if d.Sessions[i].ID == a.AbsenID {
+				d.Sessions[i].Activities = append(d.Sessions[i].Activities, a.Description)
+				break
+			}
+		}
+	}
+
+	for _, d := range days {
+		summarize(d)
+		sheet.WorkedHours += d.WorkedHours
+		sheet.BreakMinutes += d.BreakMinutes
+		sheet.Days = append(sheet.Days, *d)
+	}
+
+	sort.Slice(sheet.Days, func(i, j int) bool {
+		return sheet.Days[i].Date.Before(sheet.Days[j].Date)
+	})
+
+	sheet.WorkedHours = round(sheet.WorkedHours)
+
+	return sheet, nil
+}
+
+// Generate renders the timesheet of the requested period as a PDF to w.
+// Employees may only print their own, managers their reporting chain's and
+// admins anyone's.
+func (tu *timesheetUseCaseImpl) Generate(ctx context.Context, userID int64, role string, params timesheets.TimesheetReq, w io.Writer) response.Response {
+	if params.UserID != 0 && params.UserID != userID && role != users.RoleAdmin {
+		if role != users.RoleManager {
+			return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+		}
+
+		above, err := tu.chain.InChain(ctx, userID, params.UserID)
+		if err != nil {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+
+		if !above {
+			return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+		}
+	}
+
+	if params.UserID == 0 {
+		params.UserID = userID
+	}
+
+	from, err := time.Parse("2006-01-02", params.From)
+	if err != nil {
+		return response.Error(response.StatusBadRequest, err)
+	}
+
+	to, err := time.Parse("2006-01-02", params.To)
+	if err != nil {
+		return response.Error(response.StatusBadRequest, err)
+	}
+
+	if to.Before(from) {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	sheet, err := tu.Build(ctx, params.UserID, from, to)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if err := render(sheet, w); err != nil {
+		log.Println(err)
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, nil)
+}
+
+func summarize(d *timesheets.Day) {
+	sort.Slice(d.Sessions, func(i, j int) bool {
+		return d.Sessions[i].Checkin.Before(d.Sessions[j].Checkin)
+	})
+
+	var worked time.Duration
+	var lastCheckout time.Time
+
+	for _, s := range d.Sessions {
+		if s.Checkin.IsZero() {
+			continue
+		}
+
+		if !lastCheckout.IsZero() && s.Checkin.After(lastCheckout) {
+			d.BreakMinutes += int(s.Checkin.Sub(lastCheckout).Minutes())
+		}
+
+		if s.Checkout.After(s.Checkin) {
+			worked += s.Checkout.Sub(s.Checkin)
+			lastCheckout = s.Checkout
+		}
+	}
+
+	d.WorkedHours = round(worked.Hours())
+}
+
+func round(hours float64) float64 {
+	return float64(int(hours*100+0.5)) / 100
+}
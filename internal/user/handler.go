@@ -7,6 +7,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/gorilla/mux"
 
+	"github.com/Risuii/helpers/exception"
 	"github.com/Risuii/helpers/response"
 	"github.com/Risuii/models/users"
 )
@@ -25,6 +26,8 @@ func NewUserHandler(router *mux.Router, validate *validator.Validate, usecase Us
 	router.HandleFunc("/register", handler.Register).Methods(http.MethodPost)
 	router.HandleFunc("/login", handler.Login).Methods(http.MethodPost)
 	router.HandleFunc("/logout", handler.Logout).Methods(http.MethodGet)
+	router.HandleFunc("/verify", handler.Verify).Methods(http.MethodGet)
+	router.HandleFunc("/verify/resend", handler.Resend).Methods(http.MethodPost)
 }
 
 func (handler *UserHandler) Register(w http.ResponseWriter, r *http.Request) {
@@ -113,3 +116,43 @@ func (handler *UserHandler) Logout(w http.ResponseWriter, r *http.Request) {
 
 	res.JSON(w)
 }
+
+func (handler *UserHandler) Verify(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+
+	ctx := r.Context()
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		res = response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Verify(ctx, token)
+
+	res.JSON(w)
+}
+
+func (handler *UserHandler) Resend(w http.ResponseWriter, r *http.Request) {
+	var res response.Response
+	var userInput users.ResendReq
+
+	ctx := r.Context()
+
+	if err := json.NewDecoder(r.Body).Decode(&userInput); err != nil {
+		res = response.Error(response.StatusUnprocessableEntity, err)
+		res.JSON(w)
+		return
+	}
+
+	if err := handler.Validate.StructCtx(ctx, userInput); err != nil {
+		res = response.Error(response.StatusBadRequest, err)
+		res.JSON(w)
+		return
+	}
+
+	res = handler.UseCase.Resend(ctx, userInput)
+
+	res.JSON(w)
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Risuii/helpers/exception"
 	"github.com/Risuii/models/users"
@@ -14,6 +15,7 @@ type (
 	UserRepository interface {
 		Create(ctx context.Context, params users.Employee) (int64, error)
 		FindByEmail(ctx context.Context, params string) (users.Employee, error)
+		Activate(ctx context.Context, id int64, updateAt time.Time) error
 	}
 
 	userRepositoryImpl struct {
@@ -30,7 +32,7 @@ func NewUserRepository(db *sql.DB, tableName string) UserRepository {
 }
 
 func (ur *userRepositoryImpl) Create(ctx context.Context, params users.Employee) (int64, error) {
-	query := fmt.Sprintf(`INSERT INTO %s (name, password, email, created_at) VALUES (?,?,?,?)`, ur.tableName)
+	query := fmt.Sprintf(`INSERT INTO %s (name, password, email, status, company_id, created_at) VALUES (?,?,?,?,?,?)`, ur.tableName)
 	stmt, err := ur.db.PrepareContext(ctx, query)
 	if err != nil {
 		log.Println(err)
@@ -43,6 +45,8 @@ func (ur *userRepositoryImpl) Create(ctx context.Context, params users.Employee)
 		params.Name,
 		params.Password,
 		params.Email,
+		params.Status,
+		params.CompanyID,
 		params.CreatedAt,
 	)
 	if err != nil {
@@ -55,7 +59,7 @@ func (ur *userRepositoryImpl) Create(ctx context.Context, params users.Employee)
 
 func (ur *userRepositoryImpl) FindByEmail(ctx context.Context, params string) (users.Employee, error) {
 	var users users.Employee
-	query := fmt.Sprintf(`SELECT id, name, password, email, created_at, update_at FROM %s WHERE email = ?`, ur.tableName)
+	query := fmt.Sprintf(`SELECT id, name, password, email, role, status, company_id, created_at, update_at FROM %s WHERE email = ?`, ur.tableName)
 	stmt, err := ur.db.PrepareContext(ctx, query)
 	if err != nil {
 		log.Println(err)
@@ -70,6 +74,9 @@ func (ur *userRepositoryImpl) FindByEmail(ctx context.Context, params string) (u
 		&users.Name,
 		&users.Password,
 		&users.Email,
+		&users.Role,
+		&users.Status,
+		&users.CompanyID,
 		&users.CreatedAt,
 		&users.UpdateAt,
 	)
@@ -79,3 +86,21 @@ func (ur *userRepositoryImpl) FindByEmail(ctx context.Context, params string) (u
 	}
 	return users, nil
 }
+
+// Activate turns a pending account active, accounts that aren't pending are
+// left as they are.
+func (ur *userRepositoryImpl) Activate(ctx context.Context, id int64, updateAt time.Time) error {
+	query := fmt.Sprintf(`UPDATE %s SET status = ?, update_at = ? WHERE id = ? AND status = ?`, ur.tableName)
+	stmt, err := ur.db.PrepareContext(ctx, query)
+	if err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+	defer stmt.Close()
+
+	if _, err := stmt.ExecContext(ctx, users.StatusActive, updateAt, id, users.StatusPending); err != nil {
+		log.Println(err)
+		return exception.ErrInternalServer
+	}
+	return nil
+}
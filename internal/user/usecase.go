@@ -2,14 +2,18 @@ package user
 
 import (
 	"context"
+	"log"
 	"time"
 
 	newJWT "github.com/dgrijalva/jwt-go"
 
 	"github.com/Risuii/config/bcrypt"
 	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/config/mailer"
 	"github.com/Risuii/helpers/exception"
 	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/company"
 	"github.com/Risuii/models/token"
 	"github.com/Risuii/models/users"
 )
@@ -18,28 +22,57 @@ type (
 	UserUseCase interface {
 		Register(ctx context.Context, params users.Employee) response.Response
 		Login(ctx context.Context, params users.EmployeeLogin) (response.Response, token.Token)
+		Verify(ctx context.Context, token string) response.Response
+		Resend(ctx context.Context, params users.ResendReq) response.Response
 	}
 
 	userUseCaseImpl struct {
 		repository UserRepository
+		companies  company.CompanyRepository
 		bcrypt     bcrypt.Bcrypt
+		mailer     mailer.Mailer
+		// registration allows anyone to sign up through /register, when off
+		// employees are only added by an admin.
+		registration users.Registration
 	}
 )
 
-func NewUserUseCase(repo UserRepository, bcrypt bcrypt.Bcrypt) UserUseCase {
+func NewUserUseCase(repo UserRepository, companies company.CompanyRepository, bcrypt bcrypt.Bcrypt, mailer mailer.Mailer, registration users.Registration) UserUseCase {
 	return &userUseCaseImpl{
-		repository: repo,
-		bcrypt:     bcrypt,
+		repository:   repo,
+		companies:    companies,
+		bcrypt:       bcrypt,
+		mailer:       mailer,
+		registration: registration,
 	}
 }
 
+// Register adds the employee to the company with the given code, emails stay
+// unique across companies since login only has the email to go by. The
+// account is pending until the owner opens the verification link mailed to
+// them.
 func (uu *userUseCaseImpl) Register(ctx context.Context, params users.Employee) response.Response {
+	if !uu.registration.Enabled {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden)
+	}
 
 	_, err := uu.repository.FindByEmail(ctx, params.Email)
 	if err == nil {
 		return response.Error(response.StatusConflicted, exception.ErrConflicted)
 	}
 
+	companyID := tenant.Default
+	if params.Company != "" {
+		company, err := uu.companies.FindByCode(ctx, params.Company)
+		if err == exception.ErrNotFound {
+			return response.Error(response.StatusNotFound, exception.ErrNotFound)
+		}
+		if err != nil {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+		companyID = company.ID
+	}
+
 	hashedPassword, err := uu.bcrypt.HashPassword(params.Password)
 	if err != nil {
 		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
@@ -50,6 +83,8 @@ func (uu *userUseCaseImpl) Register(ctx context.Context, params users.Employee)
 		Name:      params.Name,
 		Password:  hashedPassword,
 		Email:     params.Email,
+		Status:    users.StatusPending,
+		CompanyID: companyID,
 		CreatedAt: time.Now(),
 	}
 
@@ -61,6 +96,12 @@ func (uu *userUseCaseImpl) Register(ctx context.Context, params users.Employee)
 	users.ID = userID
 	users.Password = ""
 
+	// the account exists either way, a failed mail can be sent again through
+	// /verify/resend
+	if err := uu.sendVerification(ctx, users); err != nil {
+		log.Println(err)
+	}
+
 	return response.Success(response.StatusCreated, users)
 }
 
@@ -81,12 +122,35 @@ func (uu *userUseCaseImpl) Login(ctx context.Context, params users.EmployeeLogin
 		return response.Error(response.StatusUnauthorized, err), token.Token{}
 	}
 
+	if !IsActive(users) {
+		return response.Error(response.StatusForbiddend, exception.ErrForbidden), token.Token{}
+	}
+
 	users.Password = ""
 
+	company, err := uu.companies.FindByID(ctx, users.CompanyID)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer), token.Token{}
+	}
+
+	newToken, err := SignToken(users, company.Timezone)
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer), token.Token{}
+	}
+
+	return response.Success(response.StatusOK, users), newToken
+}
+
+// SignToken issues the session token of the employee, whichever way they
+// logged in.
+func SignToken(employee users.Employee, timezone string) (token.Token, error) {
 	claims := &jwt.JWTclaim{
-		ID:    users.ID,
-		Email: users.Email,
-		Name:  users.Name,
+		ID:        employee.ID,
+		CompanyID: employee.CompanyID,
+		Timezone:  timezone,
+		Email:     employee.Email,
+		Name:      employee.Name,
+		Role:      employee.Role,
 		StandardClaims: newJWT.StandardClaims{
 			IssuedAt:  time.Now().Unix(),
 			ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
@@ -97,12 +161,16 @@ func (uu *userUseCaseImpl) Login(ctx context.Context, params users.EmployeeLogin
 
 	tokens, err := tokenAlgo.SignedString(jwt.JWT_KEY)
 	if err != nil {
-		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer), token.Token{}
+		return token.Token{}, err
 	}
 
-	newToken := token.Token{
+	return token.Token{
 		Token: tokens,
-	}
+	}, nil
+}
 
-	return response.Success(response.StatusOK, users), newToken
+// IsActive tells whether the employee may log in, suspended and terminated
+// employees keep their data but get no token.
+func IsActive(employee users.Employee) bool {
+	return employee.Status == users.StatusActive
 }
@@ -0,0 +1,115 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	newJWT "github.com/dgrijalva/jwt-go"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/config/mailer"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/models/users"
+)
+
+// verifyKey signs verification links. It differs from the session key so a
+// link can't be passed off as a login token.
+var verifyKey = append([]byte("verify:"), jwt.JWT_KEY...)
+
+type verifyClaim struct {
+	ID    int64
+	Email string
+	newJWT.StandardClaims
+}
+
+// Verify activates the account the signed link was issued for. Opening the
+// link of an account that is already active succeeds as well.
+func (uu *userUseCaseImpl) Verify(ctx context.Context, token string) response.Response {
+	claims := &verifyClaim{}
+
+	parsed, err := newJWT.ParseWithClaims(token, claims, func(t *newJWT.Token) (interface{}, error) {
+		if _, ok := t.Method.(*newJWT.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		return verifyKey, nil
+	})
+	if err != nil || !parsed.Valid {
+		return response.Error(response.StatusBadRequest, exception.ErrBadRequest)
+	}
+
+	employee, err := uu.repository.FindByEmail(ctx, claims.Email)
+	if err == exception.ErrNotFound || (err == nil && employee.ID != claims.ID) {
+		return response.Error(response.StatusNotFound, exception.ErrNotFound)
+	}
+
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if employee.Status == users.StatusPending {
+		if err := uu.repository.Activate(ctx, employee.ID, time.Now()); err != nil {
+			return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+		}
+	}
+
+	msg := "Email berhasil diverifikasi, silakan login"
+
+	return response.Success(response.StatusOK, msg)
+}
+
+// Resend mails a new verification link to a pending account. The answer is
+// the same whether or not the email belongs to one so it can't be used to
+// find out who is registered.
+func (uu *userUseCaseImpl) Resend(ctx context.Context, params users.ResendReq) response.Response {
+	msg := "Jika email terdaftar dan belum diverifikasi, link verifikasi telah dikirim"
+
+	employee, err := uu.repository.FindByEmail(ctx, params.Email)
+	if err == exception.ErrNotFound {
+		return response.Success(response.StatusOK, msg)
+	}
+
+	if err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	if employee.Status != users.StatusPending {
+		return response.Success(response.StatusOK, msg)
+	}
+
+	if err := uu.sendVerification(ctx, employee); err != nil {
+		return response.Error(response.StatusInternalServerError, exception.ErrInternalServer)
+	}
+
+	return response.Success(response.StatusOK, msg)
+}
+
+func (uu *userUseCaseImpl) sendVerification(ctx context.Context, employee users.Employee) error {
+	expiresAt := time.Now().Add(uu.registration.VerifyTTL)
+
+	claims := &verifyClaim{
+		ID:    employee.ID,
+		Email: employee.Email,
+		StandardClaims: newJWT.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: expiresAt.Unix(),
+		},
+	}
+
+	token, err := newJWT.NewWithClaims(newJWT.SigningMethodHS256, claims).SignedString(verifyKey)
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s?token=%s", uu.registration.VerifyURL, url.QueryEscape(token))
+
+	return uu.mailer.Send(ctx, mailer.Message{
+		To:      employee.Email,
+		Name:    employee.Name,
+		Subject: "Verifikasi Email Akun Absensi",
+		Body: fmt.Sprintf("Halo %s,\n\nBuka link berikut untuk mengaktifkan akun anda:\n%s\n\nLink berlaku sampai %s.\n",
+			employee.Name, link, expiresAt.Format("2006-01-02 15:04")),
+	})
+}
@@ -0,0 +1,19 @@
+package absences
+
+import "time"
+
+type Absentee struct {
+	UserID  int64  `json:"userID"`
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	ShiftID int64  `json:"shiftID"`
+}
+
+type Event struct {
+	UserID  int64     `json:"userID"`
+	Name    string    `json:"name"`
+	Email   string    `json:"email"`
+	ShiftID int64     `json:"shiftID"`
+	Date    time.Time `json:"date"`
+	Status  string    `json:"status"`
+}
@@ -2,10 +2,22 @@ package absensis
 
 import "time"
 
+const (
+	StatusPresent = "present"
+	StatusAbsent  = "absent"
+	StatusWFH     = "wfh"
+)
+
 type Absensi struct {
 	ID       int64     `json:"id"`
 	UserID   int64     `json:"userID"`
 	Name     string    `json:"name"`
 	Checkin  time.Time `json:"checkin"`
 	Checkout time.Time `json:"checkout"`
+	Date     time.Time `json:"date"`
+	Status   string    `json:"status"`
+
+	OvertimeMinutes           int `json:"overtime_minutes"`
+	ApprovedOvertimeMinutes   int `json:"approved_overtime_minutes"`
+	UnapprovedOvertimeMinutes int `json:"unapproved_overtime_minutes"`
 }
@@ -1,5 +1,10 @@
 package absensis
 
+import "github.com/Risuii/models/paginations"
+
 type Riwayat struct {
-	Name string `json:"name" validate:"required"`
+	UserID int64  `json:"userID" validate:"omitempty,min=1"`
+	From   string `json:"from" validate:"omitempty,datetime=2006-01-02"`
+	To     string `json:"to" validate:"omitempty,datetime=2006-01-02"`
+	paginations.Pagination
 }
@@ -2,10 +2,36 @@ package activitys
 
 import "time"
 
+const (
+	StatusPending  = "pending"
+	StatusApproved = "approved"
+	StatusRejected = "rejected"
+)
+
+// Activity can be timed either with StartedAt and EndedAt or with a bare
+// Duration in minutes, when both times are given Duration is derived from
+// them. Untimed activities keep all three zero.
 type Activity struct {
 	ID          int64     `json:"id"`
 	UserID      int64     `json:"userID"`
+	AbsenID     int64     `json:"absenID"`
+	ProjectID   int64     `json:"projectID" validate:"min=0"`
 	Description string    `json:"deskripsi" validate:"required"`
+	Tags        []string  `json:"tags" validate:"max=10,dive,required,max=30"`
+	StartedAt   time.Time `json:"started_at"`
+	EndedAt     time.Time `json:"ended_at"`
+	Duration    int       `json:"duration_minutes" validate:"min=0,max=1440"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdateAt    time.Time `json:"update_at"`
+	DeletedAt   time.Time `json:"deleted_at"`
+	// Status is set by a reviewer, approved activities are locked.
+	Status        string    `json:"status"`
+	ReviewComment string    `json:"review_comment"`
+	ReviewedBy    int64     `json:"reviewed_by"`
+	ReviewedAt    time.Time `json:"reviewed_at"`
+}
+
+// Timed reports whether the activity occupies a known slot of its session.
+func (a Activity) Timed() bool {
+	return !a.StartedAt.IsZero() && !a.EndedAt.IsZero()
 }
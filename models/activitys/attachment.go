@@ -0,0 +1,16 @@
+package activitys
+
+import "time"
+
+// Attachment is a file uploaded to an activity, Key locates the content in
+// the attachment storage and is never sent to clients.
+type Attachment struct {
+	ID          int64     `json:"id"`
+	ActivityID  int64     `json:"activityID"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Key         string    `json:"-"`
+	UploadedBy  int64     `json:"uploaded_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -0,0 +1,22 @@
+package activitys
+
+// BulkReq adds several activities to the current session at once, every item
+// is validated on its own so the errors can point at the item.
+type BulkReq struct {
+	Activities []Activity `json:"activities" validate:"required,min=1,max=50"`
+}
+
+// ItemError tells which item of a bulk request was rejected, Index starts
+// at 0.
+type ItemError struct {
+	Index  int    `json:"index"`
+	Status string `json:"status"`
+	Reason string `json:"reason"`
+}
+
+// BulkResult holds either the added activities or the errors, nothing is
+// added when one item is rejected.
+type BulkResult struct {
+	Activities []Activity  `json:"activities"`
+	Errors     []ItemError `json:"errors"`
+}
@@ -1,6 +1,14 @@
 package activitys
 
+import "github.com/Risuii/models/paginations"
+
 type DateReq struct {
-	From string `json:"from"`
-	To   string `json:"to"`
+	From string `json:"from" validate:"omitempty,datetime=2006-01-02"`
+	To   string `json:"to" validate:"omitempty,datetime=2006-01-02"`
+	// AbsenID limits the result to activities logged during one session.
+	AbsenID   int64  `json:"absenID" validate:"omitempty,min=1"`
+	ProjectID int64  `json:"projectID" validate:"omitempty,min=1"`
+	Tag       string `json:"tag" validate:"omitempty,max=30"`
+	Status    string `json:"status" validate:"omitempty,oneof=pending approved rejected"`
+	paginations.Pagination
 }
@@ -0,0 +1,20 @@
+package activitys
+
+import "github.com/Risuii/models/paginations"
+
+// ReviewReq approves or rejects several pending activities at once, a
+// rejection has to tell the employee what to fix.
+type ReviewReq struct {
+	IDs     []int64 `json:"ids" validate:"required,min=1,max=100,dive,min=1"`
+	Status  string  `json:"status" validate:"required,oneof=approved rejected"`
+	Comment string  `json:"comment" validate:"required_if=Status rejected,max=255"`
+}
+
+type PendingReq struct {
+	UserID int64  `json:"userID" validate:"omitempty,min=1"`
+	From   string `json:"from" validate:"omitempty,datetime=2006-01-02"`
+	To     string `json:"to" validate:"omitempty,datetime=2006-01-02"`
+	// UserIDs is set by the use case to the employees a manager may review.
+	UserIDs []int64 `json:"-"`
+	paginations.Pagination
+}
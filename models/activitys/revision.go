@@ -0,0 +1,13 @@
+package activitys
+
+import "time"
+
+// Revision keeps the description an activity had before EditedBy changed it
+// at EditedAt.
+type Revision struct {
+	ID          int64     `json:"id"`
+	ActivityID  int64     `json:"activityID"`
+	Description string    `json:"deskripsi"`
+	EditedBy    int64     `json:"edited_by"`
+	EditedAt    time.Time `json:"edited_at"`
+}
@@ -0,0 +1,24 @@
+package activitys
+
+// Riwayat is the activity history page together with the minutes tracked
+// per day and per project over the whole filter, not only the current page.
+type Riwayat struct {
+	Activities []Activity     `json:"activities"`
+	Totals     []DailyTotal   `json:"totals"`
+	Projects   []ProjectTotal `json:"projects"`
+}
+
+type DailyTotal struct {
+	Date       string `json:"date"`
+	Minutes    int    `json:"minutes"`
+	Activities int    `json:"activities"`
+}
+
+// ProjectTotal groups activities without a project under ProjectID 0.
+type ProjectTotal struct {
+	ProjectID  int64  `json:"projectID"`
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	Minutes    int    `json:"minutes"`
+	Activities int    `json:"activities"`
+}
@@ -0,0 +1,28 @@
+package activitys
+
+// Standup items are stored as activities tagged TagStandup and the tag of
+// their section.
+const (
+	TagStandup   = "standup"
+	TagYesterday = "yesterday"
+	TagToday     = "today"
+	TagBlocker   = "blocker"
+)
+
+type StandupReq struct {
+	ProjectID int64    `json:"projectID" validate:"min=0"`
+	Yesterday []string `json:"yesterday" validate:"max=20,dive,required,max=255"`
+	Today     []string `json:"today" validate:"required,min=1,max=20,dive,required,max=255"`
+	Blockers  []string `json:"blockers" validate:"max=20,dive,required,max=255"`
+}
+
+type StandupFilter struct {
+	Date string `json:"date" validate:"required,datetime=2006-01-02"`
+}
+
+type Standup struct {
+	Date      string     `json:"date"`
+	Yesterday []Activity `json:"yesterday"`
+	Today     []Activity `json:"today"`
+	Blockers  []Activity `json:"blockers"`
+}
@@ -0,0 +1,22 @@
+package companies
+
+import "time"
+
+type Company struct {
+	ID        int64     `json:"id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Timezone  string    `json:"timezone"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Location is the company's timezone, falling back to UTC when the stored
+// name is unknown to the system tz database.
+func (c Company) Location() *time.Location {
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
@@ -0,0 +1,6 @@
+package companies
+
+type CompanyReq struct {
+	Name     string `json:"name" validate:"required,max=100"`
+	Timezone string `json:"timezone" validate:"required,timezone"`
+}
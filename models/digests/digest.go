@@ -0,0 +1,44 @@
+package digests
+
+import (
+	"time"
+
+	"github.com/Risuii/models/reports"
+)
+
+const (
+	PeriodDaily  = "daily"
+	PeriodWeekly = "weekly"
+)
+
+// Schedule tells when the digests go out, At is the time of day ("17:00")
+// and the weekly digest is only sent on WeeklyDay.
+type Schedule struct {
+	At        string
+	WeeklyDay time.Weekday
+}
+
+type Recipient struct {
+	UserID int64  `json:"userID"`
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+}
+
+// Member is the part of the digest about one employee.
+type Member struct {
+	Attendance      reports.Recap `json:"attendance"`
+	Activities      int           `json:"activities"`
+	ActivityMinutes int           `json:"activity_minutes"`
+	Pending         int           `json:"pending"`
+	Highlights      []string      `json:"highlights"`
+}
+
+// Digest summarizes the attendance and activities between From and To for
+// one recipient.
+type Digest struct {
+	Period    string    `json:"period"`
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Recipient Recipient `json:"recipient"`
+	Members   []Member  `json:"members"`
+}
@@ -0,0 +1,43 @@
+package identities
+
+import "time"
+
+// Identity links an account at the OpenID Connect provider to an employee.
+// Subject never changes at the provider while the email may.
+type Identity struct {
+	ID            int64     `json:"id"`
+	UserID        int64     `json:"userID"`
+	Issuer        string    `json:"issuer"`
+	Subject       string    `json:"subject"`
+	Email         string    `json:"email"`
+	EmailVerified bool      `json:"email_verified"`
+	Name          string    `json:"name"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Settings is the client registered at the provider and what happens to
+// people the provider knows but this app doesn't.
+type Settings struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	// AutoProvision creates an employee in the company with code Company for
+	// a verified email nobody uses yet, empty Company is the default company.
+	AutoProvision bool
+	Company       string
+}
+
+// Login is an authorization request in flight. State and Nonce are kept in a
+// cookie until the provider redirects back.
+type Login struct {
+	URL   string
+	State string
+	Nonce string
+}
+
+type CallbackReq struct {
+	Code  string `validate:"required"`
+	State string `validate:"required"`
+}
@@ -0,0 +1,40 @@
+package invites
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Invite lets an employee created without a password set their own. Only the
+// sha256 of the token is stored, the token itself is in the link.
+type Invite struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"userID"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	TokenHash string    `json:"-"`
+	ExpiresAt time.Time `json:"expires_at"`
+	UsedAt    time.Time `json:"used_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Token is a freshly issued invite token, Value only ever leaves the server
+// inside Link.
+type Token struct {
+	Value     string
+	Hash      string
+	ExpiresAt time.Time
+	Link      string
+}
+
+// Settings is where invite links point to and how long they stay valid.
+type Settings struct {
+	URL string
+	TTL time.Duration
+}
+
+// Link returns the URL the invitee opens, the token goes in the query.
+func (s Settings) Link(token string) string {
+	return fmt.Sprintf("%s?token=%s", s.URL, url.QueryEscape(token))
+}
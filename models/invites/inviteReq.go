@@ -0,0 +1,6 @@
+package invites
+
+type AcceptReq struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=8"`
+}
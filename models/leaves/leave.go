@@ -0,0 +1,11 @@
+package leaves
+
+import "time"
+
+type Leave struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"userID"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Type      string    `json:"type"`
+}
@@ -0,0 +1,42 @@
+package onboardings
+
+import "time"
+
+// Row is one line of the onboarding CSV. Employees are placed on a team, the
+// department only tells teams with the same name apart.
+type Row struct {
+	Line       int    `json:"line"`
+	Name       string `json:"name" validate:"required,max=255"`
+	Email      string `json:"email" validate:"required,email"`
+	Code       string `json:"code" validate:"omitempty,max=50"`
+	Department string `json:"department" validate:"required_with=Team,max=100"`
+	Team       string `json:"team" validate:"max=100"`
+	Shift      string `json:"shift" validate:"max=100"`
+	Role       string `json:"role" validate:"omitempty,oneof=employee manager admin"`
+}
+
+// Hire is a row that passed every check, TeamID and ShiftID are 0 when the
+// row leaves them empty. Mailed tells whether the invite reached the mailer.
+type Hire struct {
+	Row
+	UserID    int64     `json:"userID,omitempty"`
+	TeamID    int64     `json:"teamID"`
+	ShiftID   int64     `json:"shiftID"`
+	TokenHash string    `json:"-"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Link      string    `json:"link,omitempty"`
+	Mailed    bool      `json:"mailed"`
+}
+
+type Report struct {
+	DryRun  bool          `json:"dry_run"`
+	Lines   int           `json:"lines"`
+	Created int           `json:"created"`
+	Errors  []ImportError `json:"errors"`
+	Hires   []Hire        `json:"hires"`
+}
+
+type ImportError struct {
+	Line   int    `json:"line"`
+	Reason string `json:"reason"`
+}
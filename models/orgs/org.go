@@ -0,0 +1,27 @@
+package orgs
+
+import "time"
+
+type Department struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Team struct {
+	ID           int64     `json:"id"`
+	DepartmentID int64     `json:"departmentID"`
+	Name         string    `json:"name"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Member is an employee with their place in the org, a zero TeamID or
+// ManagerID means none is set.
+type Member struct {
+	UserID    int64  `json:"userID"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	TeamID    int64  `json:"teamID"`
+	ManagerID int64  `json:"managerID"`
+}
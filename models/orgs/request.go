@@ -0,0 +1,16 @@
+package orgs
+
+type DepartmentReq struct {
+	Name string `json:"name" validate:"required,max=100"`
+}
+
+type TeamReq struct {
+	DepartmentID int64  `json:"departmentID" validate:"required,min=1"`
+	Name         string `json:"name" validate:"required,max=100"`
+}
+
+// PlacementReq sets the team and manager of an employee, 0 clears either.
+type PlacementReq struct {
+	TeamID    int64 `json:"teamID" validate:"min=0"`
+	ManagerID int64 `json:"managerID" validate:"min=0"`
+}
@@ -0,0 +1,21 @@
+package overtimes
+
+import "time"
+
+const (
+	StatusPending  = "pending"
+	StatusApproved = "approved"
+	StatusRejected = "rejected"
+)
+
+type Overtime struct {
+	ID           int64     `json:"id"`
+	UserID       int64     `json:"userID"`
+	Date         time.Time `json:"date"`
+	PlannedHours float64   `json:"planned_hours"`
+	Reason       string    `json:"reason"`
+	Status       string    `json:"status"`
+	ReviewedBy   int64     `json:"reviewed_by"`
+	ReviewedAt   time.Time `json:"reviewed_at"`
+	CreatedAt    time.Time `json:"created_at"`
+}
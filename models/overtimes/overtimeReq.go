@@ -0,0 +1,11 @@
+package overtimes
+
+type OvertimeReq struct {
+	Date         string  `json:"date" validate:"required,datetime=2006-01-02"`
+	PlannedHours float64 `json:"planned_hours" validate:"gt=0,lte=12"`
+	Reason       string  `json:"reason" validate:"required"`
+}
+
+type ReviewReq struct {
+	Status string `json:"status" validate:"required,oneof=approved rejected"`
+}
@@ -0,0 +1,27 @@
+package paginations
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+	SortAsc      = "asc"
+	SortDesc     = "desc"
+)
+
+type Pagination struct {
+	Limit  int    `json:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor int64  `json:"cursor" validate:"omitempty,min=0"`
+	Sort   string `json:"sort" validate:"omitempty,oneof=asc desc"`
+}
+
+// Normalize fills in the default limit and sort order for empty fields.
+func (p Pagination) Normalize() Pagination {
+	if p.Limit <= 0 {
+		p.Limit = DefaultLimit
+	}
+
+	if p.Sort != SortDesc {
+		p.Sort = SortAsc
+	}
+
+	return p
+}
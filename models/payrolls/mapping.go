@@ -0,0 +1,33 @@
+package payrolls
+
+const (
+	FormatCSV   = "csv"
+	FormatFixed = "fixed"
+
+	AlignLeft  = "left"
+	AlignRight = "right"
+
+	FieldCode          = "employee_code"
+	FieldName          = "name"
+	FieldPeriod        = "period"
+	FieldWorkedDays    = "worked_days"
+	FieldOvertimeHours = "overtime_hours"
+	FieldLateMinutes   = "late_minutes"
+	FieldLateDeduction = "late_deduction"
+)
+
+// Mapping describes the file the payroll system imports: which record field
+// goes in which column, and for fixed-width files how wide each column is.
+type Mapping struct {
+	Format    string   `json:"format"`
+	Delimiter string   `json:"delimiter"`
+	Header    bool     `json:"header"`
+	Columns   []Column `json:"columns"`
+}
+
+type Column struct {
+	Field  string `json:"field"`
+	Header string `json:"header"`
+	Width  int    `json:"width"`
+	Align  string `json:"align"`
+}
@@ -0,0 +1,11 @@
+package payrolls
+
+type Record struct {
+	Code          string  `json:"employee_code"`
+	Name          string  `json:"name"`
+	Period        string  `json:"period"`
+	WorkedDays    int     `json:"worked_days"`
+	OvertimeHours float64 `json:"overtime_hours"`
+	LateMinutes   int     `json:"late_minutes"`
+	LateDeduction float64 `json:"late_deduction"`
+}
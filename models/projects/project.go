@@ -0,0 +1,12 @@
+package projects
+
+import "time"
+
+type Project struct {
+	ID        int64     `json:"id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Client    string    `json:"client"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,9 @@
+package projects
+
+type ProjectReq struct {
+	Code   string `json:"code" validate:"required,max=20"`
+	Name   string `json:"name" validate:"required,max=100"`
+	Client string `json:"client" validate:"max=100"`
+	// Active defaults to true when omitted.
+	Active *bool `json:"active"`
+}
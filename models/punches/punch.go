@@ -0,0 +1,40 @@
+package punches
+
+import (
+	"time"
+
+	"github.com/Risuii/models/absensis"
+)
+
+const (
+	StateIn  = "in"
+	StateOut = "out"
+
+	SourceDevice = "device"
+)
+
+// Punch is one line of a fingerprint device attendance log.
+type Punch struct {
+	Line         int       `json:"line"`
+	DeviceUserID string    `json:"device_user_id"`
+	Time         time.Time `json:"time"`
+	State        string    `json:"state"`
+}
+
+type DeviceUser struct {
+	DeviceUserID string `json:"device_user_id"`
+	UserID       int64  `json:"userID"`
+	Name         string `json:"name"`
+}
+
+type Checkout struct {
+	AbsenID  int64     `json:"absen_id"`
+	Checkout time.Time `json:"checkout"`
+}
+
+// Plan holds the changes an import makes to absen. New sessions may already
+// carry their checkout, Checkouts closes sessions that were already stored.
+type Plan struct {
+	Sessions  []absensis.Absensi `json:"sessions"`
+	Checkouts []Checkout         `json:"checkouts"`
+}
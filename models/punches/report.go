@@ -0,0 +1,17 @@
+package punches
+
+type Report struct {
+	DryRun     bool          `json:"dry_run"`
+	Lines      int           `json:"lines"`
+	Punches    int           `json:"punches"`
+	Duplicates int           `json:"duplicates"`
+	Created    int           `json:"created"`
+	Closed     int           `json:"closed"`
+	Errors     []ImportError `json:"errors"`
+	Plan       Plan          `json:"plan"`
+}
+
+type ImportError struct {
+	Line   int    `json:"line"`
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,19 @@
+package reports
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+
+	LangID = "id"
+	LangEN = "en"
+)
+
+type ExportReq struct {
+	From   string `json:"from" validate:"required,datetime=2006-01-02"`
+	To     string `json:"to" validate:"required,datetime=2006-01-02"`
+	UserID int64  `json:"userID" validate:"omitempty,min=1"`
+	Format string `json:"format" validate:"required,oneof=csv xlsx"`
+	Lang   string `json:"lang" validate:"omitempty,oneof=id en"`
+	// UserIDs is set by the use case to the employees a manager may export.
+	UserIDs []int64 `json:"-"`
+}
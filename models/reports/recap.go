@@ -0,0 +1,25 @@
+package reports
+
+import "github.com/Risuii/models/shifts"
+
+type Recap struct {
+	UserID        int64   `json:"userID"`
+	Code          string  `json:"code"`
+	Name          string  `json:"name"`
+	Month         string  `json:"month"`
+	DaysPresent   int     `json:"days_present"`
+	DaysLate      int     `json:"days_late"`
+	LateMinutes   int     `json:"late_minutes"`
+	DaysAbsent    int     `json:"days_absent"`
+	LeaveDays     int     `json:"leave_days"`
+	WFHDays       int     `json:"wfh_days"`
+	WorkedHours   float64 `json:"worked_hours"`
+	OvertimeHours float64 `json:"overtime_hours"`
+}
+
+type Employee struct {
+	UserID int64        `json:"userID"`
+	Code   string       `json:"code"`
+	Name   string       `json:"name"`
+	Shift  shifts.Shift `json:"shift"`
+}
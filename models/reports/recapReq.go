@@ -0,0 +1,6 @@
+package reports
+
+type RecapReq struct {
+	Month  string `json:"month" validate:"required,datetime=2006-01"`
+	UserID int64  `json:"userID" validate:"omitempty,min=1"`
+}
@@ -0,0 +1,15 @@
+package searches
+
+import "time"
+
+// Result is an activity matching a search. Snippet is an HTML escaped
+// excerpt of the description with the matched terms wrapped in <mark>.
+type Result struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"userID"`
+	Name        string    `json:"name"`
+	ProjectID   int64     `json:"projectID"`
+	Description string    `json:"deskripsi"`
+	Snippet     string    `json:"snippet"`
+	CreatedAt   time.Time `json:"created_at"`
+}
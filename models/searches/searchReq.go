@@ -0,0 +1,14 @@
+package searches
+
+import "github.com/Risuii/models/paginations"
+
+type SearchReq struct {
+	Query     string `json:"q" validate:"required,min=2,max=100"`
+	From      string `json:"from" validate:"omitempty,datetime=2006-01-02"`
+	To        string `json:"to" validate:"omitempty,datetime=2006-01-02"`
+	UserID    int64  `json:"userID" validate:"omitempty,min=1"`
+	ProjectID int64  `json:"projectID" validate:"omitempty,min=1"`
+	// UserIDs is set by the use case to the employees a manager may search.
+	UserIDs []int64 `json:"-"`
+	paginations.Pagination
+}
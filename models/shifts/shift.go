@@ -0,0 +1,42 @@
+package shifts
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultWorkDays is Monday to Friday, counted ISO style from Monday as 1.
+const DefaultWorkDays = "1,2,3,4,5"
+
+type Shift struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	StartTime    string `json:"start_time"`
+	EndTime      string `json:"end_time"`
+	GraceMinutes int    `json:"grace_minutes"`
+	WorkDays     string `json:"work_days"`
+}
+
+// IsWorkDay reports whether the shift works on the day of date. A shift
+// without work days works DefaultWorkDays.
+func (s Shift) IsWorkDay(date time.Time) bool {
+	workDays := s.WorkDays
+	if workDays == "" {
+		workDays = DefaultWorkDays
+	}
+
+	weekday := int(date.Weekday())
+	if weekday == 0 {
+		weekday = 7
+	}
+
+	for _, day := range strings.Split(workDays, ",") {
+		d, err := strconv.Atoi(strings.TrimSpace(day))
+		if err == nil && d == weekday {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,20 @@
+package templates
+
+import "time"
+
+// TemplateReq creates or replaces a template, Days (0 is Sunday) is only used
+// by a weekly recurrence.
+type TemplateReq struct {
+	Name        string         `json:"name" validate:"required,max=100"`
+	ProjectID   int64          `json:"projectID" validate:"min=0"`
+	Description string         `json:"deskripsi" validate:"required,max=255"`
+	Tags        []string       `json:"tags" validate:"max=10,dive,required,max=30"`
+	Duration    int            `json:"duration_minutes" validate:"min=0,max=1440"`
+	Recurrence  string         `json:"recurrence" validate:"omitempty,oneof=daily weekdays weekly"`
+	Days        []time.Weekday `json:"days" validate:"required_if=Recurrence weekly,max=7,dive,min=0,max=6"`
+}
+
+// ApplyReq adds the templates to the current session in the given order.
+type ApplyReq struct {
+	IDs []int64 `json:"ids" validate:"required,min=1,max=50,dive,min=1"`
+}
@@ -0,0 +1,43 @@
+package templates
+
+import "time"
+
+const (
+	RecurDaily    = "daily"
+	RecurWeekdays = "weekdays"
+	RecurWeekly   = "weekly"
+)
+
+// Template is a routine activity of one employee. Without a Recurrence it is
+// only applied on request, otherwise it is suggested on the days it recurs.
+type Template struct {
+	ID          int64          `json:"id"`
+	UserID      int64          `json:"userID"`
+	Name        string         `json:"name"`
+	ProjectID   int64          `json:"projectID"`
+	Description string         `json:"deskripsi"`
+	Tags        []string       `json:"tags"`
+	Duration    int            `json:"duration_minutes"`
+	Recurrence  string         `json:"recurrence"`
+	Days        []time.Weekday `json:"days"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdateAt    time.Time      `json:"update_at"`
+}
+
+// Due reports whether the template recurs on the day of t.
+func (tp Template) Due(t time.Time) bool {
+	switch tp.Recurrence {
+	case RecurDaily:
+		return true
+	case RecurWeekdays:
+		return t.Weekday() != time.Saturday && t.Weekday() != time.Sunday
+	case RecurWeekly:
+		for _, day := range tp.Days {
+			if day == t.Weekday() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
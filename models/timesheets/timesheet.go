@@ -0,0 +1,34 @@
+package timesheets
+
+import "time"
+
+type Timesheet struct {
+	UserID       int64     `json:"userID"`
+	Name         string    `json:"name"`
+	From         time.Time `json:"from"`
+	To           time.Time `json:"to"`
+	Days         []Day     `json:"days"`
+	WorkedHours  float64   `json:"worked_hours"`
+	BreakMinutes int       `json:"break_minutes"`
+	Activities   int       `json:"activities"`
+}
+
+// Day groups the sessions of one date. A break is the time between a
+// checkout and the next checkin on the same day.
+type Day struct {
+	Date         time.Time `json:"date"`
+	Sessions     []Session `json:"sessions"`
+	WorkedHours  float64   `json:"worked_hours"`
+	BreakMinutes int       `json:"break_minutes"`
+	Activities   []string  `json:"activities"`
+}
+
+// Session lists the activities logged while it was open, activities
+// recorded before they were linked to a session only appear on the Day.
+type Session struct {
+	ID         int64     `json:"id"`
+	Checkin    time.Time `json:"checkin"`
+	Checkout   time.Time `json:"checkout"`
+	Status     string    `json:"status"`
+	Activities []string  `json:"activities"`
+}
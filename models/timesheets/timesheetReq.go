@@ -0,0 +1,7 @@
+package timesheets
+
+type TimesheetReq struct {
+	From   string `json:"from" validate:"required,datetime=2006-01-02"`
+	To     string `json:"to" validate:"required,datetime=2006-01-02"`
+	UserID int64  `json:"userID" validate:"omitempty,min=1"`
+}
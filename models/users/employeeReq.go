@@ -0,0 +1,35 @@
+package users
+
+import "github.com/Risuii/models/paginations"
+
+// EmployeeReq is sent by an admin to create or update an employee, an empty
+// password on update keeps the current one.
+type EmployeeReq struct {
+	Name     string `json:"name" validate:"required,max=255"`
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"omitempty,min=8"`
+	Code     string `json:"code" validate:"omitempty,max=50"`
+	Role     string `json:"role" validate:"omitempty,oneof=employee manager admin"`
+}
+
+// InviteReq creates an employee without a password, the invitee sets one
+// through the link mailed to them.
+type InviteReq struct {
+	Name  string `json:"name" validate:"required,max=255"`
+	Email string `json:"email" validate:"required,email"`
+	Code  string `json:"code" validate:"omitempty,max=50"`
+	Role  string `json:"role" validate:"omitempty,oneof=employee manager admin"`
+}
+
+type StatusReq struct {
+	Status string `json:"status" validate:"required,oneof=active suspended terminated"`
+}
+
+// EmployeeList filters the employees of the company, Query matches the name,
+// email or code.
+type EmployeeList struct {
+	Query  string `json:"q" validate:"omitempty,max=100"`
+	Role   string `json:"role" validate:"omitempty,oneof=employee manager admin"`
+	Status string `json:"status" validate:"omitempty,oneof=pending active suspended terminated"`
+	paginations.Pagination
+}
@@ -0,0 +1,15 @@
+package users
+
+import "time"
+
+// Registration is how public sign up behaves. Accounts created through it
+// stay pending until the verification link mailed to them is opened.
+type Registration struct {
+	Enabled   bool
+	VerifyURL string
+	VerifyTTL time.Duration
+}
+
+type ResendReq struct {
+	Email string `json:"email" validate:"required,email"`
+}
@@ -2,13 +2,37 @@ package users
 
 import "time"
 
+const (
+	RoleEmployee = "employee"
+	RoleManager  = "manager"
+	RoleAdmin    = "admin"
+
+	StatusPending    = "pending"
+	StatusActive     = "active"
+	StatusSuspended  = "suspended"
+	StatusTerminated = "terminated"
+)
+
+// IsReviewer reports whether the role may review the work of others.
+func IsReviewer(role string) bool {
+	return role == RoleManager || role == RoleAdmin
+}
+
 type Employee struct {
-	ID       int64     `json:"id"`
-	Name     string    `json:"name" validate:"required"`
-	Password string    `json:"password" validate:"required"`
-	Email    string    `json:"email" validate:"email"`
-	Checkin  time.Time `json:"checkin"`
-	Checkout time.Time `json:"checkout"`
+	ID       int64  `json:"id"`
+	Name     string `json:"name" validate:"required"`
+	Password string `json:"password" validate:"required"`
+	Email    string `json:"email" validate:"email"`
+	Role     string `json:"role"`
+	Code     string `json:"code,omitempty"`
+	// Status is checked at login, only active employees get a token.
+	Status string `json:"status,omitempty"`
+	// Company is the code of the company to register with, empty joins the
+	// default company.
+	Company   string    `json:"company,omitempty"`
+	CompanyID int64     `json:"company_id"`
+	Checkin   time.Time `json:"checkin"`
+	Checkout  time.Time `json:"checkout"`
 	// Activity  []Activity `json:"activity" foreignkey:"userID"`
 	// Absensi   []Absensi  `json:"absen" foreignkey:"userID"`
 	CreatedAt time.Time `json:"created_at"`
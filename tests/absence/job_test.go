@@ -0,0 +1,37 @@
+package absence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/absence"
+	"github.com/Risuii/models/absences"
+	"github.com/Risuii/models/companies"
+	"github.com/Risuii/tests/absence/mocks"
+	companymocks "github.com/Risuii/tests/company/mocks"
+)
+
+func TestAbsenceJob(t *testing.T) {
+	t.Run("Run Until Cancelled", func(t *testing.T) {
+		absenceUseCase := new(mocks.AbsenceUseCase)
+
+		companyRepository := new(companymocks.CompanyRepository)
+
+		companyRepository.On("FindAll", mock.Anything).Return([]companies.Company{{ID: 3, Timezone: "Asia/Jayapura"}}, nil)
+		absenceUseCase.On("Detect", mock.MatchedBy(func(ctx context.Context) bool {
+			return tenant.ID(ctx) == 3 && tenant.Location(ctx).String() == "Asia/Jayapura"
+		}), mock.AnythingOfType("time.Time")).Return([]absences.Event{absentEvent}, nil)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		absence.NewAbsenceJob(absenceUseCase, companyRepository, 10*time.Millisecond).Run(ctx)
+
+		absenceUseCase.AssertExpectations(t)
+		companyRepository.AssertExpectations(t)
+	})
+}
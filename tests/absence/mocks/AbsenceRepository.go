@@ -0,0 +1,134 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	absences "github.com/Risuii/models/absences"
+	absensis "github.com/Risuii/models/absensis"
+	shifts "github.com/Risuii/models/shifts"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AbsenceRepository is an autogenerated mock type for the AbsenceRepository type
+type AbsenceRepository struct {
+	mock.Mock
+}
+
+// FindAbsentees provides a mock function with given fields: ctx, shiftID, date
+func (_m *AbsenceRepository) FindAbsentees(ctx context.Context, shiftID int64, date string) ([]absences.Absentee, error) {
+	ret := _m.Called(ctx, shiftID, date)
+
+	var r0 []absences.Absentee
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) []absences.Absentee); ok {
+		r0 = rf(ctx, shiftID, date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]absences.Absentee)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, shiftID, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindShifts provides a mock function with given fields: ctx
+func (_m *AbsenceRepository) FindShifts(ctx context.Context) ([]shifts.Shift, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []shifts.Shift
+	if rf, ok := ret.Get(0).(func(context.Context) []shifts.Shift); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]shifts.Shift)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsHoliday provides a mock function with given fields: ctx, date
+func (_m *AbsenceRepository) IsHoliday(ctx context.Context, date string) (bool, error) {
+	ret := _m.Called(ctx, date)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, date)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkAbsent provides a mock function with given fields: ctx, params
+func (_m *AbsenceRepository) MarkAbsent(ctx context.Context, params absensis.Absensi) (int64, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, absensis.Absensi) int64); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, absensis.Absensi) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Publish provides a mock function with given fields: ctx, params
+func (_m *AbsenceRepository) Publish(ctx context.Context, params absences.Event) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, absences.Event) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewAbsenceRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAbsenceRepository creates a new instance of AbsenceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAbsenceRepository(t mockConstructorTestingTNewAbsenceRepository) *AbsenceRepository {
+	mock := &AbsenceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
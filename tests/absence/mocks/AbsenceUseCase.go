@@ -0,0 +1,54 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	time "time"
+
+	absences "github.com/Risuii/models/absences"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AbsenceUseCase is an autogenerated mock type for the AbsenceUseCase type
+type AbsenceUseCase struct {
+	mock.Mock
+}
+
+// Detect provides a mock function with given fields: ctx, now
+func (_m *AbsenceUseCase) Detect(ctx context.Context, now time.Time) ([]absences.Event, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 []absences.Event
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []absences.Event); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]absences.Event)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewAbsenceUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAbsenceUseCase creates a new instance of AbsenceUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAbsenceUseCase(t mockConstructorTestingTNewAbsenceUseCase) *AbsenceUseCase {
+	mock := &AbsenceUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,212 @@
+package absence_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/absence"
+	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/tests/mock"
+)
+
+var currentTime = time.Date(2021, 12, 13, 9, 0, 0, 0, time.UTC)
+var absentStruct = absensis.Absensi{
+	UserID: 1,
+	Name:   "test",
+	Date:   currentTime,
+	Status: absensis.StatusAbsent,
+}
+
+func TestFindShiftsRepo(t *testing.T) {
+	t.Run("FindShifts Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absence.NewAbsenceRepositoryImpl(db, nil)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, name, start_time, end_time, grace_minutes, work_days FROM %s`, constant.TableShift)
+		rows := sqlmock.NewRows([]string{"id", "name", "start_time", "end_time", "grace_minutes", "work_days"}).AddRow(1, "pagi", "08:00:00", "17:00:00", 15, "1,2,3,4,5")
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectQuery(query).WithArgs(int64(2)).WillReturnRows(rows)
+
+		shift, err := repo.FindShifts(ctx)
+
+		assert.Len(t, shift, 1)
+		assert.Equal(t, "08:00:00", shift[0].StartTime)
+		assert.NoError(t, err)
+	})
+
+	t.Run("FindShifts Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absence.NewAbsenceRepositoryImpl(db, nil)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, name, start_time, end_time, grace_minutes, work_days FROM %s`, constant.TableShift)
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectQuery(query).WillReturnError(fmt.Errorf("error"))
+
+		shift, err := repo.FindShifts(ctx)
+
+		assert.Empty(t, shift)
+		assert.Error(t, err)
+	})
+}
+
+func TestIsHolidayRepo(t *testing.T) {
+	t.Run("IsHoliday True", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absence.NewAbsenceRepositoryImpl(db, nil)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE company_id = ? AND date = ?`, constant.TableHoliday)
+		rows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(int64(2), "2021-12-13").WillReturnRows(rows)
+
+		holiday, err := repo.IsHoliday(ctx, "2021-12-13")
+
+		assert.True(t, holiday)
+		assert.NoError(t, err)
+	})
+
+	t.Run("IsHoliday Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absence.NewAbsenceRepositoryImpl(db, nil)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE company_id = ? AND date = ?`, constant.TableHoliday)
+		rows := sqlmock.NewRows([]string{"count"})
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(int64(2), "2021-12-13").WillReturnRows(rows)
+
+		holiday, err := repo.IsHoliday(ctx, "2021-12-13")
+
+		assert.False(t, holiday)
+		assert.Error(t, err)
+	})
+}
+
+func TestFindAbsenteesRepo(t *testing.T) {
+	t.Run("FindAbsentees Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absence.NewAbsenceRepositoryImpl(db, nil)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT e.id, e.name, e.email, e.shift_id FROM %s e`, constant.TableEmployee)
+		rows := sqlmock.NewRows([]string{"id", "name", "email", "shift_id"}).AddRow(1, "test", "test@test.com", 1)
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectPrepare(query).ExpectQuery().WithArgs(int64(2), int64(1), "2021-12-13", "2021-12-13", "2021-12-13").WillReturnRows(rows)
+
+		absentee, err := repo.FindAbsentees(ctx, 1, "2021-12-13")
+
+		assert.Len(t, absentee, 1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("FindAbsentees Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absence.NewAbsenceRepositoryImpl(db, nil)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT e.id, e.name, e.email, e.shift_id FROM %s e`, constant.TableEmployee)
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectPrepare(query).ExpectQuery().WithArgs(int64(2), int64(1), "2021-12-13", "2021-12-13", "2021-12-13").WillReturnError(fmt.Errorf("error"))
+
+		absentee, err := repo.FindAbsentees(ctx, 1, "2021-12-13")
+
+		assert.Empty(t, absentee)
+		assert.Error(t, err)
+	})
+}
+
+func TestMarkAbsentRepo(t *testing.T) {
+	t.Run("MarkAbsent Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absence.NewAbsenceRepositoryImpl(db, nil)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableAbsensi)
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(absentStruct.UserID, absentStruct.Name, "2021-12-13", absentStruct.Status, int64(2), absentStruct.UserID, "2021-12-13", "2021-12-13").WillReturnResult(sqlmock.NewResult(1, 1))
+
+		ID, err := repo.MarkAbsent(ctx, absentStruct)
+
+		assert.Equal(t, int64(1), ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("MarkAbsent Already Has A Row", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absence.NewAbsenceRepositoryImpl(db, nil)
+
+		defer db.Close()
+
+		query := regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO %[1]s (userID, name, date, status, company_id) SELECT ?, ?, ?, ?, ? FROM DUAL`, constant.TableAbsensi))
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(absentStruct.UserID, absentStruct.Name, "2021-12-13", absentStruct.Status, int64(2), absentStruct.UserID, "2021-12-13", "2021-12-13").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		ID, err := repo.MarkAbsent(ctx, absentStruct)
+
+		assert.Equal(t, int64(0), ID)
+		assert.Equal(t, exception.ErrConflicted, err)
+	})
+
+	t.Run("MarkAbsent Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absence.NewAbsenceRepositoryImpl(db, nil)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableAbsensi)
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(absentStruct.UserID, absentStruct.Name, "2021-12-13", absentStruct.Status, int64(2), absentStruct.UserID, "2021-12-13", "2021-12-13").WillReturnError(fmt.Errorf("error"))
+
+		ID, err := repo.MarkAbsent(ctx, absentStruct)
+
+		assert.Equal(t, int64(0), ID)
+		assert.Error(t, err)
+	})
+}
+
+func TestPublishRepo(t *testing.T) {
+	t.Run("Publish Error Without Connection", func(t *testing.T) {
+		db, _ := mock.NewMock()
+		repo := absence.NewAbsenceRepositoryImpl(db, nil)
+
+		defer db.Close()
+
+		err := repo.Publish(context.TODO(), absentEvent)
+
+		assert.Error(t, err)
+	})
+}
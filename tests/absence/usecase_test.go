@@ -0,0 +1,171 @@
+package absence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/internal/absence"
+	"github.com/Risuii/models/absences"
+	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/shifts"
+	"github.com/Risuii/tests/absence/mocks"
+)
+
+var shiftStruct = shifts.Shift{
+	ID:           1,
+	Name:         "pagi",
+	StartTime:    "08:00:00",
+	EndTime:      "17:00:00",
+	GraceMinutes: 30,
+	WorkDays:     "1,2,3,4,5",
+}
+var absenteeStruct = absences.Absentee{
+	UserID:  1,
+	Name:    "test",
+	Email:   "test@test.com",
+	ShiftID: 1,
+}
+var absentEvent = absences.Event{
+	UserID:  1,
+	Name:    "test",
+	Email:   "test@test.com",
+	ShiftID: 1,
+	Date:    currentTime,
+	Status:  absensis.StatusAbsent,
+}
+
+func TestDetect(t *testing.T) {
+	t.Run("Detect Success", func(t *testing.T) {
+		absenceRepository := new(mocks.AbsenceRepository)
+
+		absenceRepository.On("IsHoliday", mock.Anything, "2021-12-13").Return(false, nil)
+		absenceRepository.On("FindShifts", mock.Anything).Return([]shifts.Shift{shiftStruct}, nil)
+		absenceRepository.On("FindAbsentees", mock.Anything, int64(1), "2021-12-13").Return([]absences.Absentee{absenteeStruct}, nil)
+		absenceRepository.On("Publish", mock.Anything, absentEvent).Return(nil)
+		absenceRepository.On("MarkAbsent", mock.Anything, mock.AnythingOfType("absensis.Absensi")).Return(int64(1), nil)
+
+		absenceUseCase := absence.NewAbsenceUseCase(absenceRepository)
+
+		events, err := absenceUseCase.Detect(context.TODO(), currentTime)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []absences.Event{absentEvent}, events)
+		absenceRepository.AssertExpectations(t)
+	})
+
+	t.Run("Detect Skip Holiday", func(t *testing.T) {
+		absenceRepository := new(mocks.AbsenceRepository)
+
+		absenceRepository.On("IsHoliday", mock.Anything, "2021-12-13").Return(true, nil)
+
+		absenceUseCase := absence.NewAbsenceUseCase(absenceRepository)
+
+		events, err := absenceUseCase.Detect(context.TODO(), currentTime)
+
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+		absenceRepository.AssertExpectations(t)
+	})
+
+	t.Run("Detect Skip Before Grace Period", func(t *testing.T) {
+		absenceRepository := new(mocks.AbsenceRepository)
+
+		absenceRepository.On("IsHoliday", mock.Anything, "2021-12-13").Return(false, nil)
+		absenceRepository.On("FindShifts", mock.Anything).Return([]shifts.Shift{shiftStruct}, nil)
+
+		absenceUseCase := absence.NewAbsenceUseCase(absenceRepository)
+
+		events, err := absenceUseCase.Detect(context.TODO(), time.Date(2021, 12, 13, 8, 20, 0, 0, time.UTC))
+
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+		absenceRepository.AssertExpectations(t)
+	})
+
+	t.Run("Detect Skip Non Work Day", func(t *testing.T) {
+		absenceRepository := new(mocks.AbsenceRepository)
+
+		absenceRepository.On("IsHoliday", mock.Anything, "2021-12-12").Return(false, nil)
+		absenceRepository.On("FindShifts", mock.Anything).Return([]shifts.Shift{shiftStruct}, nil)
+
+		absenceUseCase := absence.NewAbsenceUseCase(absenceRepository)
+
+		events, err := absenceUseCase.Detect(context.TODO(), time.Date(2021, 12, 12, 9, 0, 0, 0, time.UTC))
+
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+		absenceRepository.AssertExpectations(t)
+	})
+
+	t.Run("Detect Skip Already Marked", func(t *testing.T) {
+		absenceRepository := new(mocks.AbsenceRepository)
+
+		absenceRepository.On("IsHoliday", mock.Anything, "2021-12-13").Return(false, nil)
+		absenceRepository.On("FindShifts", mock.Anything).Return([]shifts.Shift{shiftStruct}, nil)
+		absenceRepository.On("FindAbsentees", mock.Anything, int64(1), "2021-12-13").Return([]absences.Absentee{absenteeStruct}, nil)
+		absenceRepository.On("MarkAbsent", mock.Anything, mock.AnythingOfType("absensis.Absensi")).Return(int64(0), exception.ErrConflicted)
+
+		absenceUseCase := absence.NewAbsenceUseCase(absenceRepository)
+
+		events, err := absenceUseCase.Detect(context.TODO(), currentTime)
+
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+		absenceRepository.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+		absenceRepository.AssertExpectations(t)
+	})
+
+	t.Run("Detect Error Mark Absent", func(t *testing.T) {
+		absenceRepository := new(mocks.AbsenceRepository)
+
+		absenceRepository.On("IsHoliday", mock.Anything, "2021-12-13").Return(false, nil)
+		absenceRepository.On("FindShifts", mock.Anything).Return([]shifts.Shift{shiftStruct}, nil)
+		absenceRepository.On("FindAbsentees", mock.Anything, int64(1), "2021-12-13").Return([]absences.Absentee{absenteeStruct}, nil)
+		absenceRepository.On("MarkAbsent", mock.Anything, mock.AnythingOfType("absensis.Absensi")).Return(int64(0), exception.ErrInternalServer)
+
+		absenceUseCase := absence.NewAbsenceUseCase(absenceRepository)
+
+		events, err := absenceUseCase.Detect(context.TODO(), currentTime)
+
+		assert.Error(t, err)
+		assert.Empty(t, events)
+		absenceRepository.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+		absenceRepository.AssertExpectations(t)
+	})
+
+	t.Run("Detect Error Publish", func(t *testing.T) {
+		absenceRepository := new(mocks.AbsenceRepository)
+
+		absenceRepository.On("IsHoliday", mock.Anything, "2021-12-13").Return(false, nil)
+		absenceRepository.On("FindShifts", mock.Anything).Return([]shifts.Shift{shiftStruct}, nil)
+		absenceRepository.On("FindAbsentees", mock.Anything, int64(1), "2021-12-13").Return([]absences.Absentee{absenteeStruct}, nil)
+		absenceRepository.On("MarkAbsent", mock.Anything, mock.AnythingOfType("absensis.Absensi")).Return(int64(1), nil)
+		absenceRepository.On("Publish", mock.Anything, absentEvent).Return(exception.ErrInternalServer)
+
+		absenceUseCase := absence.NewAbsenceUseCase(absenceRepository)
+
+		events, err := absenceUseCase.Detect(context.TODO(), currentTime)
+
+		assert.Error(t, err)
+		assert.Empty(t, events)
+		absenceRepository.AssertExpectations(t)
+	})
+
+	t.Run("Detect Error Internal Server", func(t *testing.T) {
+		absenceRepository := new(mocks.AbsenceRepository)
+
+		absenceRepository.On("IsHoliday", mock.Anything, "2021-12-13").Return(false, exception.ErrInternalServer)
+
+		absenceUseCase := absence.NewAbsenceUseCase(absenceRepository)
+
+		_, err := absenceUseCase.Detect(context.TODO(), currentTime)
+
+		assert.Error(t, err)
+		absenceRepository.AssertExpectations(t)
+	})
+}
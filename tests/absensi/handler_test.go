@@ -18,6 +18,7 @@ import (
 	"github.com/Risuii/internal/absensi"
 	"github.com/Risuii/models/absensis"
 	"github.com/Risuii/models/token"
+	"github.com/Risuii/models/users"
 	"github.com/Risuii/tests/absensi/mocks"
 )
 
@@ -49,7 +50,7 @@ func TestHandler_Checkin(t *testing.T) {
 		resp := response.Success(response.StatusOK, mockData)
 
 		checkinUseCase := new(mocks.AbsensiUseCase)
-		checkinUseCase.On("Checkin", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("string")).Return(resp, token.Token{})
+		checkinUseCase.On("Checkin", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("string"), absensis.StatusPresent).Return(resp, token.Token{})
 
 		checkinHandler := absensi.AbsensiHandler{
 			UseCase: checkinUseCase,
@@ -204,7 +205,7 @@ func TestHandler_Checkout(t *testing.T) {
 func TestHandler_Riwayat(t *testing.T) {
 	t.Run("Get Riwayat Success", func(t *testing.T) {
 		mockData := absensis.Riwayat{
-			Name: "test",
+			From: "2021-12-01",
 		}
 
 		mockToken := &jwt.JWTclaim{
@@ -228,7 +229,7 @@ func TestHandler_Riwayat(t *testing.T) {
 
 		validate := validator.New()
 		absensiUseCase := new(mocks.AbsensiUseCase)
-		absensiUseCase.On("Riwayat", mock.Anything, mock.AnythingOfType("absensis.Riwayat")).Return(resp)
+		absensiUseCase.On("Riwayat", mock.Anything, int64(1), mock.AnythingOfType("string"), mock.AnythingOfType("absensis.Riwayat")).Return(resp)
 
 		absensiHandler := absensi.AbsensiHandler{
 			Validate: validate,
@@ -269,7 +270,7 @@ func TestHandler_Riwayat(t *testing.T) {
 
 	t.Run("Get Riwayat Error Unauthorized", func(t *testing.T) {
 		mockData := absensis.Riwayat{
-			Name: "test",
+			From: "2021-12-01",
 		}
 
 		validate := validator.New()
@@ -308,9 +309,53 @@ func TestHandler_Riwayat(t *testing.T) {
 		absensiUseCase.AssertExpectations(t)
 	})
 
+	t.Run("Get Riwayat Error Forged Token", func(t *testing.T) {
+		mockData := absensis.Riwayat{
+			UserID: 2,
+		}
+
+		mockToken := &jwt.JWTclaim{
+			ID:   1,
+			Role: users.RoleAdmin,
+		}
+
+		token, err := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken).SignedString([]byte("guessed"))
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		absensiUseCase := new(mocks.AbsensiUseCase)
+
+		absensiHandler := absensi.AbsensiHandler{
+			Validate: validator.New(),
+			UseCase:  absensiUseCase,
+		}
+
+		newReq, err := json.Marshal(mockData)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: token,
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(absensiHandler.Riwayat)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+		absensiUseCase.AssertNotCalled(t, "Riwayat", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
 	t.Run("Get Riwayat Error Bad Request", func(t *testing.T) {
 		mockData := absensis.Riwayat{
-			Name: "",
+			From: "01-12-2021",
 		}
 
 		mockToken := &jwt.JWTclaim{
@@ -419,4 +464,59 @@ func TestHandler_Riwayat(t *testing.T) {
 
 		absensiUseCase.AssertExpectations(t)
 	})
+
+	t.Run("Get Riwayat Injection Payload Inert", func(t *testing.T) {
+		mockToken := &jwt.JWTclaim{
+			ID:    1,
+			Email: "test@test.com",
+			StandardClaims: newJWT.StandardClaims{
+				IssuedAt:  time.Now().Unix(),
+				ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+			},
+		}
+
+		tokenAlgo := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken)
+
+		token, err := tokenAlgo.SignedString(jwt.JWT_KEY)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		payloads := map[string]string{
+			`{"name": "x' OR '1'='1"}`:          response.StatusOK,
+			`{"userID": "1 OR 1=1"}`:            response.StatusUnprocessableEntity,
+			`{"from": "2021-12-01' OR '1'='1"}`: response.StatusBadRequest,
+		}
+
+		for payload, status := range payloads {
+			resp := response.Success(response.StatusOK, []absensis.Absensi{})
+
+			absensiUseCase := new(mocks.AbsensiUseCase)
+			absensiUseCase.On("Riwayat", mock.Anything, int64(1), mock.AnythingOfType("string"), absensis.Riwayat{}).Return(resp)
+
+			absensiHandler := absensi.AbsensiHandler{
+				Validate: validator.New(),
+				UseCase:  absensiUseCase,
+			}
+
+			r := httptest.NewRequest(http.MethodGet, "/just/for/testing", bytes.NewReader([]byte(payload)))
+			r.AddCookie(&http.Cookie{
+				Name:  "token",
+				Value: token,
+			})
+			recorder := httptest.NewRecorder()
+
+			handler := http.HandlerFunc(absensiHandler.Riwayat)
+			handler.ServeHTTP(recorder, r)
+
+			rb := response.ResponseImpl{}
+			if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+				t.Error(err)
+				return
+			}
+
+			assert.Equal(t, status, rb.Status, payload)
+		}
+	})
 }
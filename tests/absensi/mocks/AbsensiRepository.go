@@ -6,7 +6,7 @@ import (
 	context "context"
 
 	absensis "github.com/Risuii/models/absensis"
-
+	shifts "github.com/Risuii/models/shifts"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -50,6 +50,90 @@ func (_m *AbsensiRepository) Checkout(ctx context.Context, checkinID int64, para
 	return r0
 }
 
+// CountRiwayat provides a mock function with given fields: ctx, params
+func (_m *AbsensiRepository) CountRiwayat(ctx context.Context, params absensis.Riwayat) (int64, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, absensis.Riwayat) int64); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, absensis.Riwayat) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindApprovedOvertime provides a mock function with given fields: ctx, userID, date
+func (_m *AbsensiRepository) FindApprovedOvertime(ctx context.Context, userID int64, date string) (float64, error) {
+	ret := _m.Called(ctx, userID, date)
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) float64); ok {
+		r0 = rf(ctx, userID, date)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, userID, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *AbsensiRepository) FindByID(ctx context.Context, id int64) (absensis.Absensi, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 absensis.Absensi
+	if rf, ok := ret.Get(0).(func(context.Context, int64) absensis.Absensi); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(absensis.Absensi)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindShift provides a mock function with given fields: ctx, userID
+func (_m *AbsensiRepository) FindShift(ctx context.Context, userID int64) (shifts.Shift, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 shifts.Shift
+	if rf, ok := ret.Get(0).(func(context.Context, int64) shifts.Shift); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(shifts.Shift)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReceiveMsg provides a mock function with given fields:
 func (_m *AbsensiRepository) ReceiveMsg() (absensis.Absensi, error) {
 	ret := _m.Called()
@@ -71,13 +155,13 @@ func (_m *AbsensiRepository) ReceiveMsg() (absensis.Absensi, error) {
 	return r0, r1
 }
 
-// Riwayat provides a mock function with given fields: ctx, name
-func (_m *AbsensiRepository) Riwayat(ctx context.Context, name string) ([]absensis.Absensi, error) {
-	ret := _m.Called(ctx, name)
+// Riwayat provides a mock function with given fields: ctx, params
+func (_m *AbsensiRepository) Riwayat(ctx context.Context, params absensis.Riwayat) ([]absensis.Absensi, error) {
+	ret := _m.Called(ctx, params)
 
 	var r0 []absensis.Absensi
-	if rf, ok := ret.Get(0).(func(context.Context, string) []absensis.Absensi); ok {
-		r0 = rf(ctx, name)
+	if rf, ok := ret.Get(0).(func(context.Context, absensis.Riwayat) []absensis.Absensi); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]absensis.Absensi)
@@ -85,8 +169,8 @@ func (_m *AbsensiRepository) Riwayat(ctx context.Context, name string) ([]absens
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, name)
+	if rf, ok := ret.Get(1).(func(context.Context, absensis.Riwayat) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5,13 +5,10 @@ package mocks
 import (
 	context "context"
 
-	absensis "github.com/Risuii/models/absensis"
-
-	mock "github.com/stretchr/testify/mock"
-
 	response "github.com/Risuii/helpers/response"
-
+	absensis "github.com/Risuii/models/absensis"
 	token "github.com/Risuii/models/token"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // AbsensiUseCase is an autogenerated mock type for the AbsensiUseCase type
@@ -19,13 +16,13 @@ type AbsensiUseCase struct {
 	mock.Mock
 }
 
-// Checkin provides a mock function with given fields: ctx, userID, name
-func (_m *AbsensiUseCase) Checkin(ctx context.Context, userID int64, name string) (response.Response, token.Token) {
-	ret := _m.Called(ctx, userID, name)
+// Checkin provides a mock function with given fields: ctx, userID, name, status
+func (_m *AbsensiUseCase) Checkin(ctx context.Context, userID int64, name string, status string) (response.Response, token.Token) {
+	ret := _m.Called(ctx, userID, name, status)
 
 	var r0 response.Response
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string) response.Response); ok {
-		r0 = rf(ctx, userID, name)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) response.Response); ok {
+		r0 = rf(ctx, userID, name, status)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(response.Response)
@@ -33,8 +30,8 @@ func (_m *AbsensiUseCase) Checkin(ctx context.Context, userID int64, name string
 	}
 
 	var r1 token.Token
-	if rf, ok := ret.Get(1).(func(context.Context, int64, string) token.Token); ok {
-		r1 = rf(ctx, userID, name)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string) token.Token); ok {
+		r1 = rf(ctx, userID, name, status)
 	} else {
 		r1 = ret.Get(1).(token.Token)
 	}
@@ -58,13 +55,13 @@ func (_m *AbsensiUseCase) Checkout(ctx context.Context, checkinID int64) respons
 	return r0
 }
 
-// Riwayat provides a mock function with given fields: ctx, params
-func (_m *AbsensiUseCase) Riwayat(ctx context.Context, params absensis.Riwayat) response.Response {
-	ret := _m.Called(ctx, params)
+// Riwayat provides a mock function with given fields: ctx, userID, role, params
+func (_m *AbsensiUseCase) Riwayat(ctx context.Context, userID int64, role string, params absensis.Riwayat) response.Response {
+	ret := _m.Called(ctx, userID, role, params)
 
 	var r0 response.Response
-	if rf, ok := ret.Get(0).(func(context.Context, absensis.Riwayat) response.Response); ok {
-		r0 = rf(ctx, params)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, absensis.Riwayat) response.Response); ok {
+		r0 = rf(ctx, userID, role, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(response.Response)
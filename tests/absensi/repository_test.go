@@ -3,6 +3,7 @@ package absensi_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -10,8 +11,12 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
 	"github.com/Risuii/internal/absensi"
 	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/overtimes"
+	"github.com/Risuii/models/paginations"
 	"github.com/Risuii/tests/mock"
 )
 
@@ -22,6 +27,21 @@ var absensiStruct = absensis.Absensi{
 	Name:     "test",
 	Checkin:  currentTime,
 	Checkout: currentTime,
+	Status:   absensis.StatusPresent,
+
+	OvertimeMinutes:         90,
+	ApprovedOvertimeMinutes: 60,
+}
+
+var riwayatStruct = absensis.Riwayat{
+	UserID: 1,
+	From:   "2021-12-01",
+	To:     "2021-12-31",
+	Pagination: paginations.Pagination{
+		Limit:  10,
+		Cursor: 5,
+		Sort:   paginations.SortDesc,
+	},
 }
 
 func TestCheckinRepo(t *testing.T) {
@@ -32,9 +52,9 @@ func TestCheckinRepo(t *testing.T) {
 		defer db.Close()
 
 		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableAbsensi)
-		ctx := context.TODO()
+		ctx := tenant.WithID(context.TODO(), 2)
 
-		mock.ExpectPrepare(query).ExpectExec().WithArgs(absensiStruct.UserID, absensiStruct.Name, absensiStruct.Checkin).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(absensiStruct.UserID, absensiStruct.Name, absensiStruct.Checkin, "2021-12-12", absensiStruct.Status, int64(2)).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		ID, err := repo.Checkin(ctx, absensiStruct)
 
@@ -49,9 +69,9 @@ func TestCheckinRepo(t *testing.T) {
 		defer db.Close()
 
 		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableAbsensi)
-		ctx := context.TODO()
+		ctx := tenant.WithID(context.TODO(), 2)
 
-		mock.ExpectPrepare(query).ExpectExec().WithArgs(absensiStruct.UserID, absensiStruct.Name, absensiStruct.Checkin).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(absensiStruct.UserID, absensiStruct.Name, absensiStruct.Checkin, "2021-12-12", absensiStruct.Status, int64(2)).WillReturnResult(sqlmock.NewResult(0, 0))
 
 		ID, err := repo.Checkin(ctx, absensiStruct)
 
@@ -69,9 +89,9 @@ func TestCheckoutRepo(t *testing.T) {
 
 		query := fmt.Sprintf(`UPDATE %s SET`, constant.TableAbsensi)
 
-		ctx := context.TODO()
+		ctx := tenant.WithID(context.TODO(), 2)
 
-		mock.ExpectPrepare(query).ExpectExec().WithArgs(absensiStruct.Checkout).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(absensiStruct.Checkout, absensiStruct.OvertimeMinutes, absensiStruct.ApprovedOvertimeMinutes, absensiStruct.ID, int64(2)).WillReturnResult(sqlmock.NewResult(0, 1))
 
 		err := repo.Checkout(ctx, absensiStruct.ID, absensiStruct)
 
@@ -86,9 +106,9 @@ func TestCheckoutRepo(t *testing.T) {
 
 		query := fmt.Sprintf(`UPDATE %s SET`, constant.TableAbsensi)
 
-		ctx := context.TODO()
+		ctx := tenant.WithID(context.TODO(), 2)
 
-		mock.ExpectPrepare(query).ExpectExec().WithArgs(absensiStruct.Checkout).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(absensiStruct.Checkout, absensiStruct.OvertimeMinutes, absensiStruct.ApprovedOvertimeMinutes, absensiStruct.ID, int64(2)).WillReturnResult(sqlmock.NewResult(0, 0))
 
 		err := repo.Checkout(ctx, absensiStruct.ID, absensiStruct)
 
@@ -103,16 +123,38 @@ func TestRiwayatRepo(t *testing.T) {
 
 		defer db.Close()
 
-		query := fmt.Sprintf(`SELECT id, userID, name, checkin, checkout FROM %s WHERE name = '%s'`, constant.TableAbsensi, absensiStruct.Name)
-		rows := sqlmock.NewRows([]string{"id", "userID", "name", "checkin", "checkout"}).AddRow(absensiStruct.ID, absensiStruct.UserID, absensiStruct.Name, absensiStruct.Checkin, absensiStruct.Checkout)
+		query := fmt.Sprintf(`SELECT id, userID, name, checkin, checkout, date, status, overtime_minutes, approved_overtime_minutes FROM %s WHERE userID = ? AND company_id = ? AND date >= ? AND date <= ? AND id < ? ORDER BY id DESC LIMIT ?`, constant.TableAbsensi)
+		rows := sqlmock.NewRows([]string{"id", "userID", "name", "checkin", "checkout", "date", "status", "overtime_minutes", "approved_overtime_minutes"}).AddRow(absensiStruct.ID, absensiStruct.UserID, absensiStruct.Name, absensiStruct.Checkin, absensiStruct.Checkout, currentTime, absensis.StatusWFH, absensiStruct.OvertimeMinutes, absensiStruct.ApprovedOvertimeMinutes)
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(riwayatStruct.UserID, int64(2), riwayatStruct.From, riwayatStruct.To, riwayatStruct.Cursor, riwayatStruct.Limit).WillReturnRows(rows)
+
+		absensiStruct, err := repo.Riwayat(ctx, riwayatStruct)
+
+		assert.Len(t, absensiStruct, 1)
+		assert.Equal(t, currentTime, absensiStruct[0].Date)
+		assert.Equal(t, absensis.StatusWFH, absensiStruct[0].Status)
+		assert.Equal(t, 30, absensiStruct[0].UnapprovedOvertimeMinutes)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Test Riwayat Empty", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absensi.NewAbsensiRepositoryImpl(db, constant.TableAbsensi)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, userID, name, checkin, checkout, date, status, overtime_minutes, approved_overtime_minutes FROM %s WHERE userID = ? AND company_id = ? ORDER BY id ASC LIMIT ?`, constant.TableAbsensi)
+		rows := sqlmock.NewRows([]string{"id", "userID", "name", "checkin", "checkout", "date", "status", "overtime_minutes", "approved_overtime_minutes"})
 
-		ctx := context.TODO()
+		ctx := tenant.WithID(context.TODO(), 2)
 
-		mock.ExpectQuery(query).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(absensiStruct.UserID, int64(2), 20).WillReturnRows(rows)
 
-		absensiStruct, err := repo.Riwayat(ctx, absensiStruct.Name)
+		absensiStruct, err := repo.Riwayat(ctx, absensis.Riwayat{UserID: absensiStruct.UserID, Pagination: paginations.Pagination{Limit: 20}})
 
-		assert.NotNil(t, absensiStruct)
+		assert.Empty(t, absensiStruct)
 		assert.NoError(t, err)
 	})
 
@@ -122,16 +164,219 @@ func TestRiwayatRepo(t *testing.T) {
 
 		defer db.Close()
 
-		query := fmt.Sprintf(`SELECT id, userID, name, checkin, checkout FROM %s WHERE name = '%s'`, constant.TableAbsensi, absensiStruct.Name)
+		query := fmt.Sprintf(`SELECT id, userID, name, checkin, checkout, date, status, overtime_minutes, approved_overtime_minutes FROM %s`, constant.TableAbsensi)
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(fmt.Errorf("error"))
+
+		_, err := repo.Riwayat(ctx, riwayatStruct)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRiwayatRepoInjection(t *testing.T) {
+	payloads := []string{
+		"2021-12-01' OR '1'='1",
+		"2021-12-01'; DROP TABLE absen; --",
+		"2021-12-01' UNION SELECT id, email, password, 1, 1, 1, 1, 0, 0 FROM employee --",
+	}
+
+	for _, payload := range payloads {
+		t.Run(payload, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatal(err)
+			}
+			repo := absensi.NewAbsensiRepositoryImpl(db, constant.TableAbsensi)
+
+			defer db.Close()
+
+			params := absensis.Riwayat{
+				UserID: 1,
+				From:   payload,
+				Pagination: paginations.Pagination{
+					Limit: 20,
+				},
+			}
+
+			query := fmt.Sprintf(`SELECT id, userID, name, checkin, checkout, date, status, overtime_minutes, approved_overtime_minutes FROM %s WHERE userID = ? AND company_id = ? AND date >= ? ORDER BY id ASC LIMIT ?`, constant.TableAbsensi)
+			count := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE userID = ? AND company_id = ? AND date >= ?`, constant.TableAbsensi)
+			rows := sqlmock.NewRows([]string{"id", "userID", "name", "checkin", "checkout", "date", "status", "overtime_minutes", "approved_overtime_minutes"})
+
+			ctx := tenant.WithID(context.TODO(), 2)
+
+			mock.ExpectQuery(query).WithArgs(int64(1), int64(2), payload, 20).WillReturnRows(rows)
+			mock.ExpectQuery(count).WithArgs(int64(1), int64(2), payload).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+			result, err := repo.Riwayat(ctx, params)
+			assert.NoError(t, err)
+			assert.Empty(t, result)
+
+			total, err := repo.CountRiwayat(ctx, params)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(0), total)
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCountRiwayatRepo(t *testing.T) {
+	t.Run("Count Riwayat Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absensi.NewAbsensiRepositoryImpl(db, constant.TableAbsensi)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE userID = ? AND company_id = ? AND date >= ? AND date <= ?`, constant.TableAbsensi)
+		rows := sqlmock.NewRows([]string{"count"}).AddRow(42)
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(riwayatStruct.UserID, int64(2), riwayatStruct.From, riwayatStruct.To).WillReturnRows(rows)
+
+		total, err := repo.CountRiwayat(ctx, riwayatStruct)
+
+		assert.Equal(t, int64(42), total)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Count Riwayat Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absensi.NewAbsensiRepositoryImpl(db, constant.TableAbsensi)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT COUNT(*) FROM %s`, constant.TableAbsensi)
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(fmt.Errorf("error"))
+
+		total, err := repo.CountRiwayat(ctx, riwayatStruct)
+
+		assert.Equal(t, int64(0), total)
+		assert.Error(t, err)
+	})
+}
+
+func TestFindByIDRepo(t *testing.T) {
+	t.Run("FindByID Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absensi.NewAbsensiRepositoryImpl(db, constant.TableAbsensi)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, userID, name, checkin, checkout FROM %s WHERE id = ? AND company_id = ?`, constant.TableAbsensi)
+		rows := sqlmock.NewRows([]string{"id", "userID", "name", "checkin", "checkout"}).AddRow(absensiStruct.ID, absensiStruct.UserID, absensiStruct.Name, absensiStruct.Checkin, nil)
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(absensiStruct.ID, int64(2)).WillReturnRows(rows)
+
+		result, err := repo.FindByID(ctx, absensiStruct.ID)
+
+		assert.Equal(t, absensiStruct.Checkin, result.Checkin)
+		assert.True(t, result.Checkout.IsZero())
+		assert.NoError(t, err)
+	})
+
+	t.Run("FindByID Error Not Found", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absensi.NewAbsensiRepositoryImpl(db, constant.TableAbsensi)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, userID, name, checkin, checkout FROM %s WHERE id = ? AND company_id = ?`, constant.TableAbsensi)
 		rows := sqlmock.NewRows([]string{"id", "userID", "name", "checkin", "checkout"})
 
-		ctx := context.TODO()
+		ctx := tenant.WithID(context.TODO(), 2)
 
-		mock.ExpectQuery(query).WillReturnRows(rows)
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(absensiStruct.ID, int64(2)).WillReturnRows(rows)
 
-		absensiStruct, err := repo.Riwayat(ctx, absensiStruct.Name)
+		result, err := repo.FindByID(ctx, absensiStruct.ID)
 
-		assert.Empty(t, absensiStruct)
+		assert.Empty(t, result)
+		assert.Equal(t, exception.ErrNotFound, err)
+	})
+}
+
+func TestFindShiftRepo(t *testing.T) {
+	t.Run("FindShift Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absensi.NewAbsensiRepositoryImpl(db, constant.TableAbsensi)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT s.id, s.name, s.start_time, s.end_time, s.grace_minutes, s.work_days FROM %s s`, constant.TableShift)
+		rows := sqlmock.NewRows([]string{"id", "name", "start_time", "end_time", "grace_minutes", "work_days"}).AddRow(1, "pagi", "08:00:00", "17:00:00", 15, "1,2,3,4,5")
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectPrepare(query).ExpectQuery().WithArgs(absensiStruct.UserID, int64(2)).WillReturnRows(rows)
+
+		shift, err := repo.FindShift(ctx, absensiStruct.UserID)
+
+		assert.Equal(t, "17:00:00", shift.EndTime)
+		assert.NoError(t, err)
+	})
+
+	t.Run("FindShift Error Not Found", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absensi.NewAbsensiRepositoryImpl(db, constant.TableAbsensi)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT s.id, s.name, s.start_time, s.end_time, s.grace_minutes, s.work_days FROM %s s`, constant.TableShift)
+		rows := sqlmock.NewRows([]string{"id", "name", "start_time", "end_time", "grace_minutes", "work_days"})
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectPrepare(query).ExpectQuery().WithArgs(absensiStruct.UserID, int64(2)).WillReturnRows(rows)
+
+		_, err := repo.FindShift(ctx, absensiStruct.UserID)
+
+		assert.Equal(t, exception.ErrNotFound, err)
+	})
+}
+
+func TestFindApprovedOvertimeRepo(t *testing.T) {
+	t.Run("FindApprovedOvertime Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absensi.NewAbsensiRepositoryImpl(db, constant.TableAbsensi)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT COALESCE(SUM(planned_hours), 0) FROM %s`, constant.TableOvertime)
+		rows := sqlmock.NewRows([]string{"hours"}).AddRow(1.5)
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(absensiStruct.UserID, "2021-12-12", overtimes.StatusApproved).WillReturnRows(rows)
+
+		hours, err := repo.FindApprovedOvertime(ctx, absensiStruct.UserID, "2021-12-12")
+
+		assert.Equal(t, 1.5, hours)
 		assert.NoError(t, err)
 	})
+
+	t.Run("FindApprovedOvertime Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := absensi.NewAbsensiRepositoryImpl(db, constant.TableAbsensi)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT COALESCE(SUM(planned_hours), 0) FROM %s`, constant.TableOvertime)
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(absensiStruct.UserID, "2021-12-12", overtimes.StatusApproved).WillReturnError(fmt.Errorf("error"))
+
+		hours, err := repo.FindApprovedOvertime(ctx, absensiStruct.UserID, "2021-12-12")
+
+		assert.Equal(t, float64(0), hours)
+		assert.Error(t, err)
+	})
 }
@@ -5,12 +5,19 @@ import (
 	"testing"
 	"time"
 
+	newJWT "github.com/dgrijalva/jwt-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/Risuii/config/jwt"
 	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/helpers/tenant"
 	"github.com/Risuii/internal/absensi"
 	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/paginations"
+	"github.com/Risuii/models/shifts"
+	"github.com/Risuii/models/users"
 	"github.com/Risuii/tests/absensi/mocks"
 )
 
@@ -36,12 +43,38 @@ func TestCheckin(t *testing.T) {
 			Checkout: time.Time{},
 		}
 
-		resp, _ := absensiUseCase.Checkin(ctx, params.UserID, params.Name)
+		resp, _ := absensiUseCase.Checkin(ctx, params.UserID, params.Name, absensis.StatusPresent)
 
 		assert.NoError(t, resp.Err())
 		absensiRepository.AssertExpectations(t)
 	})
 
+	t.Run("Checkin Token Keeps Company", func(t *testing.T) {
+		absensiRepository := new(mocks.AbsensiRepository)
+
+		jayapura, _ := time.LoadLocation("Asia/Jayapura")
+
+		absensiRepository.On("SendMsg", mock.Anything, mock.MatchedBy(func(a absensis.Absensi) bool {
+			return a.Checkin.Location() == jayapura
+		})).Return(nil)
+		absensiRepository.On("ReceiveMsg").Return(absensis.Absensi{}, nil)
+		absensiRepository.On("Checkin", mock.Anything, mock.AnythingOfType("absensis.Absensi")).Return(int64(1), nil)
+
+		ctx := tenant.WithLocation(tenant.WithID(context.TODO(), 2), jayapura)
+
+		resp, tokens := absensi.NewAbsensiUseCase(absensiRepository).Checkin(ctx, 1, "test", absensis.StatusPresent)
+
+		claims := &jwt.JWTclaim{}
+		newJWT.ParseWithClaims(tokens.Token, claims, func(t *newJWT.Token) (interface{}, error) {
+			return jwt.JWT_KEY, nil
+		})
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, int64(2), claims.CompanyID)
+		assert.Equal(t, "Asia/Jayapura", claims.Timezone)
+		absensiRepository.AssertExpectations(t)
+	})
+
 	t.Run("Internal Server Error Send Msg", func(t *testing.T) {
 		absensiRepository := new(mocks.AbsensiRepository)
 
@@ -61,7 +94,7 @@ func TestCheckin(t *testing.T) {
 			Checkout: time.Time{},
 		}
 
-		resp, _ := absensiUseCase.Checkin(ctx, params.UserID, params.Name)
+		resp, _ := absensiUseCase.Checkin(ctx, params.UserID, params.Name, absensis.StatusPresent)
 
 		assert.Error(t, resp.Err())
 		absensiRepository.AssertExpectations(t)
@@ -87,7 +120,7 @@ func TestCheckin(t *testing.T) {
 			Checkout: time.Time{},
 		}
 
-		resp, _ := absensiUseCase.Checkin(ctx, params.UserID, params.Name)
+		resp, _ := absensiUseCase.Checkin(ctx, params.UserID, params.Name, absensis.StatusPresent)
 
 		assert.Error(t, resp.Err())
 		absensiRepository.AssertExpectations(t)
@@ -114,7 +147,7 @@ func TestCheckin(t *testing.T) {
 			Checkout: time.Time{},
 		}
 
-		resp, _ := absensiUseCase.Checkin(ctx, params.UserID, params.Name)
+		resp, _ := absensiUseCase.Checkin(ctx, params.UserID, params.Name, absensis.StatusPresent)
 
 		assert.Error(t, resp.Err())
 		absensiRepository.AssertExpectations(t)
@@ -125,6 +158,8 @@ func TestCheckout(t *testing.T) {
 	t.Run("Success Checkout", func(t *testing.T) {
 		absensiRepository := new(mocks.AbsensiRepository)
 
+		absensiRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(absensis.Absensi{ID: 1, UserID: 1, Checkin: time.Now()}, nil)
+		absensiRepository.On("FindShift", mock.Anything, mock.AnythingOfType("int64")).Return(shifts.Shift{}, exception.ErrNotFound)
 		absensiRepository.On("Checkout", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("absensis.Absensi")).Return(nil)
 
 		absensiUseCase := absensi.NewAbsensiUseCase(
@@ -147,9 +182,80 @@ func TestCheckout(t *testing.T) {
 		absensiRepository.AssertExpectations(t)
 	})
 
+	t.Run("Success Checkout With Overtime", func(t *testing.T) {
+		absensiRepository := new(mocks.AbsensiRepository)
+
+		checkin := time.Now().Add(-12 * time.Hour)
+		shift := shifts.Shift{
+			StartTime: checkin.Format("15:04:05"),
+			EndTime:   checkin.Add(8 * time.Hour).Format("15:04:05"),
+		}
+
+		absensiRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(absensis.Absensi{ID: 1, UserID: 1, Checkin: checkin}, nil)
+		absensiRepository.On("FindShift", mock.Anything, mock.AnythingOfType("int64")).Return(shift, nil)
+		absensiRepository.On("FindApprovedOvertime", mock.Anything, int64(1), checkin.Format("2006-01-02")).Return(float64(1), nil)
+		absensiRepository.On("Checkout", mock.Anything, mock.AnythingOfType("int64"), mock.MatchedBy(func(a absensis.Absensi) bool {
+			return a.OvertimeMinutes >= 239 && a.OvertimeMinutes <= 240 && a.ApprovedOvertimeMinutes == 60
+		})).Return(nil)
+
+		absensiUseCase := absensi.NewAbsensiUseCase(
+			absensiRepository,
+		)
+
+		resp := absensiUseCase.Checkout(context.TODO(), 1)
+
+		assert.NoError(t, resp.Err())
+		absensiRepository.AssertExpectations(t)
+	})
+
+	t.Run("Success Checkout With Overtime Company Timezone", func(t *testing.T) {
+		absensiRepository := new(mocks.AbsensiRepository)
+
+		jakarta, _ := time.LoadLocation("Asia/Jakarta")
+
+		// read back from the database in UTC, the shift is in WIB
+		checkin := time.Now().Add(-12 * time.Hour).UTC()
+		local := checkin.In(jakarta)
+		shift := shifts.Shift{
+			StartTime: local.Format("15:04:05"),
+			EndTime:   local.Add(8 * time.Hour).Format("15:04:05"),
+		}
+
+		absensiRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(absensis.Absensi{ID: 1, UserID: 1, Checkin: checkin}, nil)
+		absensiRepository.On("FindShift", mock.Anything, mock.AnythingOfType("int64")).Return(shift, nil)
+		absensiRepository.On("FindApprovedOvertime", mock.Anything, int64(1), local.Format("2006-01-02")).Return(float64(1), nil)
+		absensiRepository.On("Checkout", mock.Anything, mock.AnythingOfType("int64"), mock.MatchedBy(func(a absensis.Absensi) bool {
+			return a.OvertimeMinutes >= 239 && a.OvertimeMinutes <= 240 && a.ApprovedOvertimeMinutes == 60
+		})).Return(nil)
+
+		ctx := tenant.WithLocation(tenant.WithID(context.TODO(), 2), jakarta)
+
+		resp := absensi.NewAbsensiUseCase(absensiRepository).Checkout(ctx, 1)
+
+		assert.NoError(t, resp.Err())
+		absensiRepository.AssertExpectations(t)
+	})
+
+	t.Run("Error Not Found FindByID", func(t *testing.T) {
+		absensiRepository := new(mocks.AbsensiRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(absensis.Absensi{}, exception.ErrNotFound)
+
+		absensiUseCase := absensi.NewAbsensiUseCase(
+			absensiRepository,
+		)
+
+		resp := absensiUseCase.Checkout(context.TODO(), 1)
+
+		assert.Error(t, resp.Err())
+		absensiRepository.AssertExpectations(t)
+	})
+
 	t.Run("Error Not Found Checkout", func(t *testing.T) {
 		absensiRepository := new(mocks.AbsensiRepository)
 
+		absensiRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(absensis.Absensi{ID: 1, UserID: 1, Checkin: time.Now()}, nil)
+		absensiRepository.On("FindShift", mock.Anything, mock.AnythingOfType("int64")).Return(shifts.Shift{}, exception.ErrNotFound)
 		absensiRepository.On("Checkout", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("absensis.Absensi")).Return(exception.ErrNotFound)
 
 		absensiUseCase := absensi.NewAbsensiUseCase(
@@ -175,6 +281,8 @@ func TestCheckout(t *testing.T) {
 	t.Run("Internal Server Error Checkout", func(t *testing.T) {
 		absensiRepository := new(mocks.AbsensiRepository)
 
+		absensiRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(absensis.Absensi{ID: 1, UserID: 1, Checkin: time.Now()}, nil)
+		absensiRepository.On("FindShift", mock.Anything, mock.AnythingOfType("int64")).Return(shifts.Shift{}, exception.ErrNotFound)
 		absensiRepository.On("Checkout", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("absensis.Absensi")).Return(exception.ErrInternalServer)
 
 		absensiUseCase := absensi.NewAbsensiUseCase(
@@ -196,13 +304,30 @@ func TestCheckout(t *testing.T) {
 		assert.Error(t, resp.Err())
 		absensiRepository.AssertExpectations(t)
 	})
+
+	t.Run("Internal Server Error FindShift", func(t *testing.T) {
+		absensiRepository := new(mocks.AbsensiRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(absensis.Absensi{ID: 1, UserID: 1, Checkin: time.Now()}, nil)
+		absensiRepository.On("FindShift", mock.Anything, mock.AnythingOfType("int64")).Return(shifts.Shift{}, exception.ErrInternalServer)
+
+		absensiUseCase := absensi.NewAbsensiUseCase(
+			absensiRepository,
+		)
+
+		resp := absensiUseCase.Checkout(context.TODO(), 1)
+
+		assert.Error(t, resp.Err())
+		absensiRepository.AssertExpectations(t)
+	})
 }
 
 func TestRiwayat(t *testing.T) {
 	t.Run("Get Riwayat Success", func(t *testing.T) {
 		absensiRepository := new(mocks.AbsensiRepository)
 
-		absensiRepository.On("Riwayat", mock.Anything, mock.AnythingOfType("string")).Return([]absensis.Absensi{}, nil)
+		absensiRepository.On("Riwayat", mock.Anything, mock.AnythingOfType("absensis.Riwayat")).Return([]absensis.Absensi{}, nil)
+		absensiRepository.On("CountRiwayat", mock.Anything, mock.AnythingOfType("absensis.Riwayat")).Return(int64(0), nil)
 
 		absensiUseCase := absensi.NewAbsensiUseCase(
 			absensiRepository,
@@ -210,20 +335,52 @@ func TestRiwayat(t *testing.T) {
 
 		ctx := context.TODO()
 
+		params := absensis.Riwayat{}
+
+		resp := absensiUseCase.Riwayat(ctx, 1, users.RoleEmployee, params)
+
+		assert.NoError(t, resp.Err())
+		absensiRepository.AssertExpectations(t)
+	})
+
+	t.Run("Get Riwayat Success Next Cursor", func(t *testing.T) {
+		absensiRepository := new(mocks.AbsensiRepository)
+
+		expected := absensis.Riwayat{
+			UserID: 1,
+			Pagination: paginations.Pagination{
+				Limit: 2,
+				Sort:  paginations.SortAsc,
+			},
+		}
+
+		absensiRepository.On("Riwayat", mock.Anything, expected).Return([]absensis.Absensi{{ID: 3}, {ID: 7}}, nil)
+		absensiRepository.On("CountRiwayat", mock.Anything, expected).Return(int64(5), nil)
+
+		absensiUseCase := absensi.NewAbsensiUseCase(
+			absensiRepository,
+		)
+
 		params := absensis.Riwayat{
-			Name: "test",
+			Pagination: paginations.Pagination{
+				Limit: 2,
+			},
 		}
 
-		resp := absensiUseCase.Riwayat(ctx, params)
+		resp := absensiUseCase.Riwayat(context.TODO(), 1, users.RoleEmployee, params)
+
+		page := resp.(*response.ResponseImpl).Page
 
 		assert.NoError(t, resp.Err())
+		assert.Equal(t, int64(5), page.Total)
+		assert.Equal(t, int64(7), page.NextCursor)
 		absensiRepository.AssertExpectations(t)
 	})
 
 	t.Run("Not Found Error Riwayat", func(t *testing.T) {
 		absensiRepository := new(mocks.AbsensiRepository)
 
-		absensiRepository.On("Riwayat", mock.Anything, mock.AnythingOfType("string")).Return([]absensis.Absensi{}, exception.ErrNotFound)
+		absensiRepository.On("Riwayat", mock.Anything, mock.AnythingOfType("absensis.Riwayat")).Return([]absensis.Absensi{}, exception.ErrNotFound)
 
 		absensiUseCase := absensi.NewAbsensiUseCase(
 			absensiRepository,
@@ -231,11 +388,9 @@ func TestRiwayat(t *testing.T) {
 
 		ctx := context.TODO()
 
-		params := absensis.Riwayat{
-			Name: "test",
-		}
+		params := absensis.Riwayat{}
 
-		resp := absensiUseCase.Riwayat(ctx, params)
+		resp := absensiUseCase.Riwayat(ctx, 1, users.RoleEmployee, params)
 
 		assert.Error(t, resp.Err())
 		absensiRepository.AssertExpectations(t)
@@ -244,7 +399,7 @@ func TestRiwayat(t *testing.T) {
 	t.Run("Internal Server Error Riwayat", func(t *testing.T) {
 		absensiRepository := new(mocks.AbsensiRepository)
 
-		absensiRepository.On("Riwayat", mock.Anything, mock.AnythingOfType("string")).Return([]absensis.Absensi{}, exception.ErrInternalServer)
+		absensiRepository.On("Riwayat", mock.Anything, mock.AnythingOfType("absensis.Riwayat")).Return([]absensis.Absensi{}, exception.ErrInternalServer)
 
 		absensiUseCase := absensi.NewAbsensiUseCase(
 			absensiRepository,
@@ -252,13 +407,80 @@ func TestRiwayat(t *testing.T) {
 
 		ctx := context.TODO()
 
-		params := absensis.Riwayat{
-			Name: "test",
-		}
+		params := absensis.Riwayat{}
 
-		resp := absensiUseCase.Riwayat(ctx, params)
+		resp := absensiUseCase.Riwayat(ctx, 1, users.RoleEmployee, params)
 
 		assert.Error(t, resp.Err())
 		absensiRepository.AssertExpectations(t)
 	})
+
+	t.Run("Internal Server Error Count Riwayat", func(t *testing.T) {
+		absensiRepository := new(mocks.AbsensiRepository)
+
+		absensiRepository.On("Riwayat", mock.Anything, mock.AnythingOfType("absensis.Riwayat")).Return([]absensis.Absensi{}, nil)
+		absensiRepository.On("CountRiwayat", mock.Anything, mock.AnythingOfType("absensis.Riwayat")).Return(int64(0), exception.ErrInternalServer)
+
+		absensiUseCase := absensi.NewAbsensiUseCase(
+			absensiRepository,
+		)
+
+		resp := absensiUseCase.Riwayat(context.TODO(), 1, users.RoleEmployee, absensis.Riwayat{})
+
+		assert.Error(t, resp.Err())
+		absensiRepository.AssertExpectations(t)
+	})
+
+	t.Run("Forbidden Error Riwayat Other Employee", func(t *testing.T) {
+		absensiRepository := new(mocks.AbsensiRepository)
+
+		absensiUseCase := absensi.NewAbsensiUseCase(
+			absensiRepository,
+		)
+
+		resp := absensiUseCase.Riwayat(context.TODO(), 1, users.RoleEmployee, absensis.Riwayat{UserID: 2})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		absensiRepository.AssertExpectations(t)
+	})
+
+	t.Run("Get Riwayat Success Admin Other Employee", func(t *testing.T) {
+		absensiRepository := new(mocks.AbsensiRepository)
+
+		scoped := mock.MatchedBy(func(params absensis.Riwayat) bool {
+			return params.UserID == 2
+		})
+
+		absensiRepository.On("Riwayat", mock.Anything, scoped).Return([]absensis.Absensi{}, nil)
+		absensiRepository.On("CountRiwayat", mock.Anything, scoped).Return(int64(0), nil)
+
+		absensiUseCase := absensi.NewAbsensiUseCase(
+			absensiRepository,
+		)
+
+		resp := absensiUseCase.Riwayat(context.TODO(), 1, users.RoleAdmin, absensis.Riwayat{UserID: 2})
+
+		assert.NoError(t, resp.Err())
+		absensiRepository.AssertExpectations(t)
+	})
+
+	t.Run("Get Riwayat Scoped To Token User", func(t *testing.T) {
+		absensiRepository := new(mocks.AbsensiRepository)
+
+		scoped := mock.MatchedBy(func(params absensis.Riwayat) bool {
+			return params.UserID == 1
+		})
+
+		absensiRepository.On("Riwayat", mock.Anything, scoped).Return([]absensis.Absensi{}, nil)
+		absensiRepository.On("CountRiwayat", mock.Anything, scoped).Return(int64(0), nil)
+
+		absensiUseCase := absensi.NewAbsensiUseCase(
+			absensiRepository,
+		)
+
+		resp := absensiUseCase.Riwayat(context.TODO(), 1, users.RoleEmployee, absensis.Riwayat{})
+
+		assert.NoError(t, resp.Err())
+		absensiRepository.AssertExpectations(t)
+	})
 }
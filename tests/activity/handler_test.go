@@ -10,6 +10,7 @@ import (
 
 	newJWT "github.com/dgrijalva/jwt-go"
 	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/Risuii/helpers/response"
 	"github.com/Risuii/internal/activity"
 	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/users"
 	"github.com/Risuii/tests/activity/mocks"
 )
 
@@ -52,7 +54,7 @@ func TestHandler_AddActivity(t *testing.T) {
 
 		validate := validator.New()
 		activityUseCase := new(mocks.ActivityUseCase)
-		activityUseCase.On("AddActivity", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.Activity")).Return(resp)
+		activityUseCase.On("AddActivity", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.Activity")).Return(resp)
 
 		activityHandler := activity.ActivityHandler{
 			Validate: validate,
@@ -463,8 +465,8 @@ func TestHandler_UpdateActivity(t *testing.T) {
 func TestHandler_ReadActivity(t *testing.T) {
 	t.Run("Read Activity Success", func(t *testing.T) {
 		mockData := activitys.DateReq{
-			From: "test",
-			To:   "test",
+			From: "2000-01-01",
+			To:   "2000-01-02",
 		}
 
 		mockToken := &jwt.JWTclaim{
@@ -491,7 +493,8 @@ func TestHandler_ReadActivity(t *testing.T) {
 		activityUseCase.On("Riwayat", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.DateReq")).Return(resp)
 
 		activityHandler := activity.ActivityHandler{
-			UseCase: activityUseCase,
+			Validate: validator.New(),
+			UseCase:  activityUseCase,
 		}
 
 		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", bytes.NewReader(newReq))
@@ -516,15 +519,16 @@ func TestHandler_ReadActivity(t *testing.T) {
 
 	t.Run("Read Activity Error Unauthorized", func(t *testing.T) {
 		mockData := activitys.DateReq{
-			From: "test",
-			To:   "test",
+			From: "2000-01-01",
+			To:   "2000-01-02",
 		}
 
 		newReq, _ := json.Marshal(mockData)
 		activityUseCase := new(mocks.ActivityUseCase)
 
 		activityHandler := activity.ActivityHandler{
-			UseCase: activityUseCase,
+			Validate: validator.New(),
+			UseCase:  activityUseCase,
 		}
 
 		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", bytes.NewReader(newReq))
@@ -568,7 +572,8 @@ func TestHandler_ReadActivity(t *testing.T) {
 		activityUseCase := new(mocks.ActivityUseCase)
 
 		activityHandler := activity.ActivityHandler{
-			UseCase: activityUseCase,
+			Validate: validator.New(),
+			UseCase:  activityUseCase,
 		}
 
 		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", nil)
@@ -590,6 +595,58 @@ func TestHandler_ReadActivity(t *testing.T) {
 		assert.Equal(t, response.StatusUnprocessableEntity, rb.Status)
 		assert.Nil(t, rb.Data)
 	})
+
+	t.Run("Read Activity Error Bad Request", func(t *testing.T) {
+		mockData := activitys.DateReq{
+			From: "01-01-2000",
+		}
+
+		mockToken := &jwt.JWTclaim{
+			ID:    1,
+			Email: "test@test.com",
+			StandardClaims: newJWT.StandardClaims{
+				IssuedAt:  time.Now().Unix(),
+				ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+			},
+		}
+
+		tokenAlgo := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken)
+
+		token, err := tokenAlgo.SignedString(jwt.JWT_KEY)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		newReq, _ := json.Marshal(mockData)
+		activityUseCase := new(mocks.ActivityUseCase)
+
+		activityHandler := activity.ActivityHandler{
+			Validate: validator.New(),
+			UseCase:  activityUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: token,
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(activityHandler.ReadActivity)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusBadRequest, rb.Status)
+		assert.Nil(t, rb.Data)
+
+		activityUseCase.AssertExpectations(t)
+	})
 }
 
 func TestHandler_DeleteActivity(t *testing.T) {
@@ -674,3 +731,542 @@ func TestHandler_DeleteActivity(t *testing.T) {
 		assert.Nil(t, rb.Data)
 	})
 }
+
+func TestHandler_RestoreActivity(t *testing.T) {
+	t.Run("Restore Activity Success", func(t *testing.T) {
+		mockToken := &jwt.JWTclaim{
+			ID:    1,
+			Email: "test@test.com",
+			StandardClaims: newJWT.StandardClaims{
+				IssuedAt:  time.Now().Unix(),
+				ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+			},
+		}
+
+		tokenAlgo := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken)
+
+		token, err := tokenAlgo.SignedString(jwt.JWT_KEY)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		resp := response.Success(response.StatusOK, activitys.Activity{ID: 3, UserID: 1})
+		activityUseCase := new(mocks.ActivityUseCase)
+		activityUseCase.On("RestoreActivity", mock.Anything, int64(3), int64(1)).Return(resp)
+
+		activityHandler := activity.ActivityHandler{
+			UseCase: activityUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": "3"})
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: token,
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(activityHandler.RestoreActivity)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusOK, rb.Status)
+		activityUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Restore Activity Error Unauthorized", func(t *testing.T) {
+		activityUseCase := new(mocks.ActivityUseCase)
+
+		activityHandler := activity.ActivityHandler{
+			UseCase: activityUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", nil)
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(activityHandler.RestoreActivity)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusUnauthorized, rb.Status)
+		assert.Nil(t, rb.Data)
+	})
+}
+
+func TestHandler_History(t *testing.T) {
+	t.Run("History Success", func(t *testing.T) {
+		mockToken := &jwt.JWTclaim{
+			ID:    2,
+			Email: "test@test.com",
+			Role:  users.RoleManager,
+			StandardClaims: newJWT.StandardClaims{
+				IssuedAt:  time.Now().Unix(),
+				ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+			},
+		}
+
+		tokenAlgo := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken)
+
+		token, err := tokenAlgo.SignedString(jwt.JWT_KEY)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		resp := response.Success(response.StatusOK, []activitys.Revision{{ID: 1, ActivityID: 3, Description: "first"}})
+		activityUseCase := new(mocks.ActivityUseCase)
+		activityUseCase.On("History", mock.Anything, int64(3), int64(2), users.RoleManager).Return(resp)
+
+		activityHandler := activity.ActivityHandler{
+			UseCase: activityUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": "3"})
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: token,
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(activityHandler.History)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusOK, rb.Status)
+		assert.NotNil(t, rb.Data)
+		activityUseCase.AssertExpectations(t)
+	})
+
+	t.Run("History Forbidden", func(t *testing.T) {
+		mockToken := &jwt.JWTclaim{
+			ID:    2,
+			Email: "test@test.com",
+			Role:  users.RoleEmployee,
+			StandardClaims: newJWT.StandardClaims{
+				IssuedAt:  time.Now().Unix(),
+				ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+			},
+		}
+
+		tokenAlgo := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken)
+
+		token, err := tokenAlgo.SignedString(jwt.JWT_KEY)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		resp := response.Error(response.StatusForbiddend, exception.ErrForbidden)
+		activityUseCase := new(mocks.ActivityUseCase)
+		activityUseCase.On("History", mock.Anything, int64(3), int64(2), users.RoleEmployee).Return(resp)
+
+		activityHandler := activity.ActivityHandler{
+			UseCase: activityUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": "3"})
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: token,
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(activityHandler.History)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusForbiddend, rb.Status)
+	})
+}
+
+func TestHandler_Review(t *testing.T) {
+	mockToken := &jwt.JWTclaim{
+		ID:    9,
+		Email: "manager@test.com",
+		Role:  users.RoleManager,
+		StandardClaims: newJWT.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+		},
+	}
+
+	tokenAlgo := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken)
+
+	token, err := tokenAlgo.SignedString(jwt.JWT_KEY)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Review Success", func(t *testing.T) {
+		newReq, _ := json.Marshal(activitys.ReviewReq{
+			IDs:     []int64{1, 2},
+			Status:  activitys.StatusRejected,
+			Comment: "add detail",
+		})
+
+		resp := response.Success(response.StatusOK, []activitys.Activity{})
+		activityUseCase := new(mocks.ActivityUseCase)
+		activityUseCase.On("Review", mock.Anything, int64(9), users.RoleManager, mock.AnythingOfType("activitys.ReviewReq")).Return(resp)
+
+		activityHandler := activity.ActivityHandler{
+			Validate: validator.New(),
+			UseCase:  activityUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPatch, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: token,
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(activityHandler.Review)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusOK, rb.Status)
+		activityUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Review Reject Without Comment", func(t *testing.T) {
+		newReq, _ := json.Marshal(activitys.ReviewReq{
+			IDs:    []int64{1},
+			Status: activitys.StatusRejected,
+		})
+
+		activityUseCase := new(mocks.ActivityUseCase)
+
+		activityHandler := activity.ActivityHandler{
+			Validate: validator.New(),
+			UseCase:  activityUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPatch, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: token,
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(activityHandler.Review)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusBadRequest, rb.Status)
+		activityUseCase.AssertNotCalled(t, "Review", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Review Without IDs", func(t *testing.T) {
+		newReq, _ := json.Marshal(activitys.ReviewReq{
+			Status: activitys.StatusApproved,
+		})
+
+		activityHandler := activity.ActivityHandler{
+			Validate: validator.New(),
+			UseCase:  new(mocks.ActivityUseCase),
+		}
+
+		r := httptest.NewRequest(http.MethodPatch, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: token,
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(activityHandler.Review)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusBadRequest, rb.Status)
+	})
+}
+
+func TestHandler_Pending(t *testing.T) {
+	t.Run("Pending Success", func(t *testing.T) {
+		mockToken := &jwt.JWTclaim{
+			ID:    9,
+			Email: "manager@test.com",
+			Role:  users.RoleManager,
+			StandardClaims: newJWT.StandardClaims{
+				IssuedAt:  time.Now().Unix(),
+				ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+			},
+		}
+
+		tokenAlgo := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken)
+
+		token, err := tokenAlgo.SignedString(jwt.JWT_KEY)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		newReq, _ := json.Marshal(activitys.PendingReq{UserID: 5})
+
+		resp := response.SuccessWithPage(response.StatusOK, []activitys.Activity{}, response.Page{Limit: 20, Sort: "asc"})
+		activityUseCase := new(mocks.ActivityUseCase)
+		activityUseCase.On("Pending", mock.Anything, int64(9), users.RoleManager, activitys.PendingReq{UserID: 5}).Return(resp)
+
+		activityHandler := activity.ActivityHandler{
+			Validate: validator.New(),
+			UseCase:  activityUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: token,
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(activityHandler.Pending)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusOK, rb.Status)
+		activityUseCase.AssertExpectations(t)
+	})
+}
+
+func TestHandler_AddActivities(t *testing.T) {
+	mockToken := &jwt.JWTclaim{
+		ID:        1,
+		CheckinID: 1,
+		Email:     "test@test.com",
+		StandardClaims: newJWT.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+		},
+	}
+
+	token, err := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken).SignedString(jwt.JWT_KEY)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Add Activities Success", func(t *testing.T) {
+		newReq, _ := json.Marshal(activitys.BulkReq{
+			Activities: []activitys.Activity{{Description: "first"}, {Description: "second"}},
+		})
+
+		resp := response.Success(response.StatusOK, activitys.BulkResult{})
+		activityUseCase := new(mocks.ActivityUseCase)
+		activityUseCase.On("AddActivities", mock.Anything, int64(1), int64(1), mock.MatchedBy(func(items []activitys.Activity) bool {
+			return len(items) == 2
+		})).Return(resp)
+
+		activityHandler := activity.ActivityHandler{
+			Validate: validator.New(),
+			UseCase:  activityUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "checkin-token",
+			Value: token,
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(activityHandler.AddActivities)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusOK, rb.Status)
+		activityUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Add Activities Invalid Items", func(t *testing.T) {
+		newReq, _ := json.Marshal(activitys.BulkReq{
+			Activities: []activitys.Activity{{Description: "ok"}, {}, {Description: "long", Duration: 2000}},
+		})
+
+		activityUseCase := new(mocks.ActivityUseCase)
+
+		activityHandler := activity.ActivityHandler{
+			Validate: validator.New(),
+			UseCase:  activityUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "checkin-token",
+			Value: token,
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(activityHandler.AddActivities)
+		handler.ServeHTTP(recorder, r)
+
+		var rb struct {
+			Status string               `json:"status"`
+			Data   activitys.BulkResult `json:"data"`
+		}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Len(t, rb.Data.Errors, 2)
+		assert.Equal(t, 1, rb.Data.Errors[0].Index)
+		assert.Contains(t, rb.Data.Errors[0].Reason, "Description")
+		assert.Equal(t, 2, rb.Data.Errors[1].Index)
+		activityUseCase.AssertNotCalled(t, "AddActivities", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Add Activities Empty", func(t *testing.T) {
+		newReq, _ := json.Marshal(activitys.BulkReq{})
+
+		activityHandler := activity.ActivityHandler{
+			Validate: validator.New(),
+			UseCase:  new(mocks.ActivityUseCase),
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "checkin-token",
+			Value: token,
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(activityHandler.AddActivities)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_Standup(t *testing.T) {
+	mockToken := &jwt.JWTclaim{
+		ID:        1,
+		CheckinID: 1,
+		Email:     "test@test.com",
+		StandardClaims: newJWT.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+		},
+	}
+
+	token, err := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken).SignedString(jwt.JWT_KEY)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Standup Success", func(t *testing.T) {
+		newReq, _ := json.Marshal(activitys.StandupReq{Today: []string{"review PR"}})
+
+		activityUseCase := new(mocks.ActivityUseCase)
+		activityUseCase.On("Standup", mock.Anything, int64(1), int64(1), activitys.StandupReq{Today: []string{"review PR"}}).Return(response.Success(response.StatusOK, activitys.Standup{}))
+
+		activityHandler := activity.ActivityHandler{
+			Validate: validator.New(),
+			UseCase:  activityUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "checkin-token",
+			Value: token,
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(activityHandler.Standup)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		activityUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Standup Without Today", func(t *testing.T) {
+		newReq, _ := json.Marshal(activitys.StandupReq{Yesterday: []string{"fixed login"}})
+
+		activityHandler := activity.ActivityHandler{
+			Validate: validator.New(),
+			UseCase:  new(mocks.ActivityUseCase),
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "checkin-token",
+			Value: token,
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(activityHandler.Standup)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Find Standup Invalid Date", func(t *testing.T) {
+		newReq, _ := json.Marshal(activitys.StandupFilter{Date: "12-12-2021"})
+
+		activityHandler := activity.ActivityHandler{
+			Validate: validator.New(),
+			UseCase:  new(mocks.ActivityUseCase),
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: token,
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(activityHandler.FindStandup)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
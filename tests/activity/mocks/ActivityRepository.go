@@ -4,9 +4,9 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	activitys "github.com/Risuii/models/activitys"
-
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -15,6 +15,29 @@ type ActivityRepository struct {
 	mock.Mock
 }
 
+// AddActivities provides a mock function with given fields: ctx, userID, params
+func (_m *ActivityRepository) AddActivities(ctx context.Context, userID int64, params []activitys.Activity) ([]int64, error) {
+	ret := _m.Called(ctx, userID, params)
+
+	var r0 []int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []activitys.Activity) []int64); ok {
+		r0 = rf(ctx, userID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []activitys.Activity) error); ok {
+		r1 = rf(ctx, userID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddActivity provides a mock function with given fields: ctx, userID, params
 func (_m *ActivityRepository) AddActivity(ctx context.Context, userID int64, params activitys.Activity) (int64, error) {
 	ret := _m.Called(ctx, userID, params)
@@ -36,13 +59,78 @@ func (_m *ActivityRepository) AddActivity(ctx context.Context, userID int64, par
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *ActivityRepository) Delete(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// CountPending provides a mock function with given fields: ctx, params
+func (_m *ActivityRepository) CountPending(ctx context.Context, params activitys.PendingReq) (int64, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, activitys.PendingReq) int64); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, activitys.PendingReq) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountRiwayat provides a mock function with given fields: ctx, userID, params
+func (_m *ActivityRepository) CountRiwayat(ctx context.Context, userID int64, params activitys.DateReq) (int64, error) {
+	ret := _m.Called(ctx, userID, params)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, activitys.DateReq) int64); ok {
+		r0 = rf(ctx, userID, params)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, activitys.DateReq) error); ok {
+		r1 = rf(ctx, userID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DailyTotals provides a mock function with given fields: ctx, userID, params
+func (_m *ActivityRepository) DailyTotals(ctx context.Context, userID int64, params activitys.DateReq) ([]activitys.DailyTotal, error) {
+	ret := _m.Called(ctx, userID, params)
+
+	var r0 []activitys.DailyTotal
+	if rf, ok := ret.Get(0).(func(context.Context, int64, activitys.DateReq) []activitys.DailyTotal); ok {
+		r0 = rf(ctx, userID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]activitys.DailyTotal)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, activitys.DateReq) error); ok {
+		r1 = rf(ctx, userID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id, deletedAt
+func (_m *ActivityRepository) Delete(ctx context.Context, id int64, deletedAt time.Time) error {
+	ret := _m.Called(ctx, id, deletedAt)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = rf(ctx, id, deletedAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -71,6 +159,172 @@ func (_m *ActivityRepository) FindByID(ctx context.Context, id int64) (activitys
 	return r0, r1
 }
 
+// FindByIDs provides a mock function with given fields: ctx, ids
+func (_m *ActivityRepository) FindByIDs(ctx context.Context, ids []int64) ([]activitys.Activity, error) {
+	ret := _m.Called(ctx, ids)
+
+	var r0 []activitys.Activity
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []activitys.Activity); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]activitys.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindBySession provides a mock function with given fields: ctx, absenID
+func (_m *ActivityRepository) FindBySession(ctx context.Context, absenID int64) ([]activitys.Activity, error) {
+	ret := _m.Called(ctx, absenID)
+
+	var r0 []activitys.Activity
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []activitys.Activity); ok {
+		r0 = rf(ctx, absenID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]activitys.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, absenID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTags provides a mock function with given fields: ctx, activityIDs
+func (_m *ActivityRepository) FindTags(ctx context.Context, activityIDs []int64) (map[int64][]string, error) {
+	ret := _m.Called(ctx, activityIDs)
+
+	var r0 map[int64][]string
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) map[int64][]string); ok {
+		r0 = rf(ctx, activityIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64][]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, activityIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Pending provides a mock function with given fields: ctx, params
+func (_m *ActivityRepository) Pending(ctx context.Context, params activitys.PendingReq) ([]activitys.Activity, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []activitys.Activity
+	if rf, ok := ret.Get(0).(func(context.Context, activitys.PendingReq) []activitys.Activity); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]activitys.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, activitys.PendingReq) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectTotals provides a mock function with given fields: ctx, userID, params
+func (_m *ActivityRepository) ProjectTotals(ctx context.Context, userID int64, params activitys.DateReq) ([]activitys.ProjectTotal, error) {
+	ret := _m.Called(ctx, userID, params)
+
+	var r0 []activitys.ProjectTotal
+	if rf, ok := ret.Get(0).(func(context.Context, int64, activitys.DateReq) []activitys.ProjectTotal); ok {
+		r0 = rf(ctx, userID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]activitys.ProjectTotal)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, activitys.DateReq) error); ok {
+		r1 = rf(ctx, userID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *ActivityRepository) Restore(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Review provides a mock function with given fields: ctx, ids, params
+func (_m *ActivityRepository) Review(ctx context.Context, ids []int64, params activitys.Activity) error {
+	ret := _m.Called(ctx, ids, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, activitys.Activity) error); ok {
+		r0 = rf(ctx, ids, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Revisions provides a mock function with given fields: ctx, activityID
+func (_m *ActivityRepository) Revisions(ctx context.Context, activityID int64) ([]activitys.Revision, error) {
+	ret := _m.Called(ctx, activityID)
+
+	var r0 []activitys.Revision
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []activitys.Revision); ok {
+		r0 = rf(ctx, activityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]activitys.Revision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, activityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Riwayat provides a mock function with given fields: ctx, userID, params
 func (_m *ActivityRepository) Riwayat(ctx context.Context, userID int64, params activitys.DateReq) ([]activitys.Activity, error) {
 	ret := _m.Called(ctx, userID, params)
@@ -94,13 +348,27 @@ func (_m *ActivityRepository) Riwayat(ctx context.Context, userID int64, params
 	return r0, r1
 }
 
-// UpdateActivity provides a mock function with given fields: ctx, id, params
-func (_m *ActivityRepository) UpdateActivity(ctx context.Context, id int64, params activitys.Activity) error {
-	ret := _m.Called(ctx, id, params)
+// SetTags provides a mock function with given fields: ctx, activityID, tags
+func (_m *ActivityRepository) SetTags(ctx context.Context, activityID int64, tags []string) error {
+	ret := _m.Called(ctx, activityID, tags)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) error); ok {
+		r0 = rf(ctx, activityID, tags)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateActivity provides a mock function with given fields: ctx, id, params, revision
+func (_m *ActivityRepository) UpdateActivity(ctx context.Context, id int64, params activitys.Activity, revision activitys.Revision) error {
+	ret := _m.Called(ctx, id, params, revision)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, activitys.Activity) error); ok {
-		r0 = rf(ctx, id, params)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, activitys.Activity, activitys.Revision) error); ok {
+		r0 = rf(ctx, id, params, revision)
 	} else {
 		r0 = ret.Error(0)
 	}
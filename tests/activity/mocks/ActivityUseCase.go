@@ -5,11 +5,9 @@ package mocks
 import (
 	context "context"
 
+	response "github.com/Risuii/helpers/response"
 	activitys "github.com/Risuii/models/activitys"
-
 	mock "github.com/stretchr/testify/mock"
-
-	response "github.com/Risuii/helpers/response"
 )
 
 // ActivityUseCase is an autogenerated mock type for the ActivityUseCase type
@@ -17,13 +15,29 @@ type ActivityUseCase struct {
 	mock.Mock
 }
 
-// AddActivity provides a mock function with given fields: ctx, userID, params
-func (_m *ActivityUseCase) AddActivity(ctx context.Context, userID int64, params activitys.Activity) response.Response {
-	ret := _m.Called(ctx, userID, params)
+// AddActivities provides a mock function with given fields: ctx, userID, checkinID, params
+func (_m *ActivityUseCase) AddActivities(ctx context.Context, userID int64, checkinID int64, params []activitys.Activity) response.Response {
+	ret := _m.Called(ctx, userID, checkinID, params)
 
 	var r0 response.Response
-	if rf, ok := ret.Get(0).(func(context.Context, int64, activitys.Activity) response.Response); ok {
-		r0 = rf(ctx, userID, params)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []activitys.Activity) response.Response); ok {
+		r0 = rf(ctx, userID, checkinID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// AddActivity provides a mock function with given fields: ctx, userID, checkinID, params
+func (_m *ActivityUseCase) AddActivity(ctx context.Context, userID int64, checkinID int64, params activitys.Activity) response.Response {
+	ret := _m.Called(ctx, userID, checkinID, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, activitys.Activity) response.Response); ok {
+		r0 = rf(ctx, userID, checkinID, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(response.Response)
@@ -49,6 +63,86 @@ func (_m *ActivityUseCase) DeleteActivity(ctx context.Context, id int64, userID
 	return r0
 }
 
+// FindStandup provides a mock function with given fields: ctx, userID, params
+func (_m *ActivityUseCase) FindStandup(ctx context.Context, userID int64, params activitys.StandupFilter) response.Response {
+	ret := _m.Called(ctx, userID, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, activitys.StandupFilter) response.Response); ok {
+		r0 = rf(ctx, userID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// History provides a mock function with given fields: ctx, id, userID, role
+func (_m *ActivityUseCase) History(ctx context.Context, id int64, userID int64, role string) response.Response {
+	ret := _m.Called(ctx, id, userID, role)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string) response.Response); ok {
+		r0 = rf(ctx, id, userID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Pending provides a mock function with given fields: ctx, reviewerID, role, params
+func (_m *ActivityUseCase) Pending(ctx context.Context, reviewerID int64, role string, params activitys.PendingReq) response.Response {
+	ret := _m.Called(ctx, reviewerID, role, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, activitys.PendingReq) response.Response); ok {
+		r0 = rf(ctx, reviewerID, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// RestoreActivity provides a mock function with given fields: ctx, id, userID
+func (_m *ActivityUseCase) RestoreActivity(ctx context.Context, id int64, userID int64) response.Response {
+	ret := _m.Called(ctx, id, userID)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) response.Response); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Review provides a mock function with given fields: ctx, reviewerID, role, params
+func (_m *ActivityUseCase) Review(ctx context.Context, reviewerID int64, role string, params activitys.ReviewReq) response.Response {
+	ret := _m.Called(ctx, reviewerID, role, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, activitys.ReviewReq) response.Response); ok {
+		r0 = rf(ctx, reviewerID, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
 // Riwayat provides a mock function with given fields: ctx, userID, params
 func (_m *ActivityUseCase) Riwayat(ctx context.Context, userID int64, params activitys.DateReq) response.Response {
 	ret := _m.Called(ctx, userID, params)
@@ -65,6 +159,22 @@ func (_m *ActivityUseCase) Riwayat(ctx context.Context, userID int64, params act
 	return r0
 }
 
+// Standup provides a mock function with given fields: ctx, userID, checkinID, params
+func (_m *ActivityUseCase) Standup(ctx context.Context, userID int64, checkinID int64, params activitys.StandupReq) response.Response {
+	ret := _m.Called(ctx, userID, checkinID, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, activitys.StandupReq) response.Response); ok {
+		r0 = rf(ctx, userID, checkinID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
 // UpdateActivity provides a mock function with given fields: ctx, id, userID, params
 func (_m *ActivityUseCase) UpdateActivity(ctx context.Context, id int64, userID int64, params activitys.Activity) response.Response {
 	ret := _m.Called(ctx, id, userID, params)
@@ -11,8 +11,11 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
 	"github.com/Risuii/internal/activity"
 	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/paginations"
 	"github.com/Risuii/tests/mock"
 )
 
@@ -20,6 +23,7 @@ var currentTime = time.Date(2021, 12, 12, 0, 0, 0, 0, &time.Location{})
 var activityStruct = activitys.Activity{
 	ID:          1,
 	UserID:      1,
+	AbsenID:     1,
 	Description: "test",
 	CreatedAt:   currentTime,
 	UpdateAt:    currentTime,
@@ -27,6 +31,11 @@ var activityStruct = activitys.Activity{
 var dateStruct = activitys.DateReq{
 	From: "2000-01-01",
 	To:   "2000-01-02",
+	Pagination: paginations.Pagination{
+		Limit:  10,
+		Cursor: 5,
+		Sort:   paginations.SortAsc,
+	},
 }
 
 func TestAddActivityRepo(t *testing.T) {
@@ -37,9 +46,9 @@ func TestAddActivityRepo(t *testing.T) {
 		defer db.Close()
 
 		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableActivity)
-		ctx := context.TODO()
+		ctx := tenant.WithID(context.TODO(), 2)
 
-		mock.ExpectPrepare(query).ExpectExec().WithArgs(activityStruct.UserID, activityStruct.Description, activityStruct.CreatedAt).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(activityStruct.UserID, activityStruct.AbsenID, nil, activityStruct.Description, nil, nil, activityStruct.Duration, activityStruct.CreatedAt, int64(2)).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		ID, err := repo.AddActivity(ctx, activityStruct.ID, activityStruct)
 
@@ -54,9 +63,9 @@ func TestAddActivityRepo(t *testing.T) {
 		defer db.Close()
 
 		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableActivity)
-		ctx := context.TODO()
+		ctx := tenant.WithID(context.TODO(), 2)
 
-		mock.ExpectPrepare(query).ExpectExec().WithArgs(activityStruct.UserID, activityStruct.Description, activityStruct.CreatedAt).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(activityStruct.UserID, activityStruct.AbsenID, nil, activityStruct.Description, nil, nil, activityStruct.Duration, activityStruct.CreatedAt, int64(2)).WillReturnResult(sqlmock.NewResult(0, 0))
 
 		ID, err := repo.AddActivity(ctx, activityStruct.ID, activityStruct)
 
@@ -66,38 +75,91 @@ func TestAddActivityRepo(t *testing.T) {
 }
 
 func TestUpdateActivityRepo(t *testing.T) {
+	revision := activitys.Revision{
+		Description: "old",
+		EditedBy:    activityStruct.UserID,
+		EditedAt:    currentTime,
+	}
+
 	t.Run("Update Activity Success", func(t *testing.T) {
 		db, mock := mock.NewMock()
 		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
 
 		defer db.Close()
 
-		query := fmt.Sprintf(`UPDATE %s SET`, constant.TableActivity)
+		ctx := tenant.WithID(context.TODO(), 2)
 
-		ctx := context.TODO()
+		params := activityStruct
+		params.Tags = []string{"meeting"}
 
-		mock.ExpectPrepare(query).ExpectExec().WithArgs(activityStruct.Description, activityStruct.UpdateAt).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO %s (activityID, deskripsi, edited_by, edited_at) VALUES (?, ?, ?, ?)`, constant.TableRevision))).WithArgs(activityStruct.ID, revision.Description, revision.EditedBy, revision.EditedAt).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`UPDATE %s SET projectID = ?, deskripsi = ?, started_at = ?, ended_at = ?, duration_minutes = ?, update_at = ?, status = ?, review_comment = NULL, reviewed_by = NULL, reviewed_at = NULL WHERE id = ? AND company_id = ? AND deleted_at IS NULL AND status <> ?`, constant.TableActivity))).WithArgs(nil, activityStruct.Description, nil, nil, activityStruct.Duration, activityStruct.UpdateAt, activitys.StatusPending, activityStruct.ID, int64(2), activitys.StatusApproved).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`DELETE FROM %s WHERE activityID = ?`, constant.TableTag))).WithArgs(activityStruct.ID).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO %s (activityID, tag) VALUES (?, ?)`, constant.TableTag))).WithArgs(activityStruct.ID, "meeting").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
 
-		err := repo.UpdateActivity(ctx, activityStruct.ID, activityStruct)
+		err := repo.UpdateActivity(ctx, activityStruct.ID, params, revision)
 
 		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Update Activity Tag Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO %s`, constant.TableRevision))).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`UPDATE %s SET`, constant.TableActivity))).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`DELETE FROM %s`, constant.TableTag))).WillReturnError(fmt.Errorf("error"))
+		mock.ExpectRollback()
+
+		err := repo.UpdateActivity(ctx, activityStruct.ID, activityStruct, revision)
+
+		assert.Equal(t, exception.ErrInternalServer, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
-	t.Run("Update Activity Error", func(t *testing.T) {
+	t.Run("Update Activity Not Found", func(t *testing.T) {
 		db, mock := mock.NewMock()
 		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
 
 		defer db.Close()
 
-		query := fmt.Sprintf(`UPDATE %s SET`, constant.TableActivity)
+		ctx := tenant.WithID(context.TODO(), 2)
 
-		ctx := context.TODO()
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO %s`, constant.TableRevision))).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`UPDATE %s SET`, constant.TableActivity))).WithArgs(nil, activityStruct.Description, nil, nil, activityStruct.Duration, activityStruct.UpdateAt, activitys.StatusPending, int64(0), int64(2), activitys.StatusApproved).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
 
-		mock.ExpectPrepare(query).ExpectExec().WithArgs(activityStruct.Description, activityStruct.UpdateAt).WillReturnResult(sqlmock.NewResult(0, 0))
+		err := repo.UpdateActivity(ctx, int64(0), activityStruct, revision)
 
-		err := repo.UpdateActivity(ctx, int64(0), activityStruct)
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Update Activity Revision Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO %s`, constant.TableRevision))).WillReturnError(fmt.Errorf("insert failed"))
+		mock.ExpectRollback()
+
+		err := repo.UpdateActivity(ctx, activityStruct.ID, activityStruct, revision)
 
 		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
 
@@ -108,12 +170,12 @@ func TestFindByIDActivityRepo(t *testing.T) {
 
 		defer db.Close()
 
-		query := fmt.Sprintf(`SELECT id, userID, deskripsi, created_at, update_at FROM %s WHERE id = ?`, constant.TableActivity)
-		rows := sqlmock.NewRows([]string{"id", "userID", "deskripsi", "created_at", "update_at"}).AddRow(activityStruct.ID, activityStruct.UserID, activityStruct.Description, activityStruct.CreatedAt, activityStruct.UpdateAt)
+		query := fmt.Sprintf(`SELECT id, userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, update_at, deleted_at, status, review_comment, reviewed_by, reviewed_at FROM %s WHERE id = ? AND company_id = ?`, constant.TableActivity)
+		rows := sqlmock.NewRows([]string{"id", "userID", "absenID", "projectID", "deskripsi", "started_at", "ended_at", "duration_minutes", "created_at", "update_at", "deleted_at", "status", "review_comment", "reviewed_by", "reviewed_at"}).AddRow(activityStruct.ID, activityStruct.UserID, activityStruct.AbsenID, nil, activityStruct.Description, nil, nil, activityStruct.Duration, activityStruct.CreatedAt, activityStruct.UpdateAt, nil, activitys.StatusPending, nil, nil, nil)
 
-		ctx := context.TODO()
+		ctx := tenant.WithID(context.TODO(), 2)
 
-		mock.ExpectPrepare(query).ExpectQuery().WithArgs(activityStruct.ID).WillReturnRows(rows)
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(activityStruct.ID, int64(2)).WillReturnRows(rows)
 
 		activityStruct, err := repo.FindByID(ctx, activityStruct.ID)
 
@@ -127,12 +189,12 @@ func TestFindByIDActivityRepo(t *testing.T) {
 
 		defer db.Close()
 
-		query := fmt.Sprintf(`SELECT id, userID, deskripsi, created_at, update_at FROM %s WHERE id = ?`, constant.TableActivity)
-		rows := sqlmock.NewRows([]string{"id", "userID", "deskripsi", "created_at", "update_at"})
+		query := fmt.Sprintf(`SELECT id, userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, update_at, deleted_at, status, review_comment, reviewed_by, reviewed_at FROM %s WHERE id = ? AND company_id = ?`, constant.TableActivity)
+		rows := sqlmock.NewRows([]string{"id", "userID", "absenID", "projectID", "deskripsi", "started_at", "ended_at", "duration_minutes", "created_at", "update_at", "deleted_at", "status", "review_comment", "reviewed_by", "reviewed_at"})
 
-		ctx := context.TODO()
+		ctx := tenant.WithID(context.TODO(), 2)
 
-		mock.ExpectPrepare(query).ExpectQuery().WithArgs(activityStruct.ID).WillReturnRows(rows)
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(activityStruct.ID, int64(2)).WillReturnRows(rows)
 
 		activityStruct, err := repo.FindByID(ctx, activityStruct.ID)
 
@@ -148,13 +210,13 @@ func TestDeleteRepo(t *testing.T) {
 
 		defer db.Close()
 
-		query := fmt.Sprintf(`DELETE FROM %s WHERE id = ?`, constant.TableActivity)
+		query := regexp.QuoteMeta(fmt.Sprintf(`UPDATE %s SET deleted_at = ? WHERE id = ? AND company_id = ? AND deleted_at IS NULL AND status <> ?`, constant.TableActivity))
 
-		ctx := context.TODO()
+		ctx := tenant.WithID(context.TODO(), 2)
 
-		mock.ExpectPrepare(query).ExpectExec().WithArgs().WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(currentTime, activityStruct.ID, int64(2), activitys.StatusApproved).WillReturnResult(sqlmock.NewResult(0, 1))
 
-		err := repo.Delete(ctx, activityStruct.ID)
+		err := repo.Delete(ctx, activityStruct.ID, currentTime)
 
 		assert.NoError(t, err)
 	})
@@ -165,13 +227,89 @@ func TestDeleteRepo(t *testing.T) {
 
 		defer db.Close()
 
-		query := fmt.Sprintf(`DELETE FROM %s WHERE id = ?`, constant.TableActivity)
+		query := regexp.QuoteMeta(fmt.Sprintf(`UPDATE %s SET deleted_at = ? WHERE id = ? AND company_id = ? AND deleted_at IS NULL AND status <> ?`, constant.TableActivity))
 
-		ctx := context.TODO()
+		ctx := tenant.WithID(context.TODO(), 2)
 
-		mock.ExpectPrepare(query).ExpectExec().WithArgs().WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(currentTime, activityStruct.ID, int64(2), activitys.StatusApproved).WillReturnResult(sqlmock.NewResult(0, 0))
 
-		err := repo.Delete(ctx, activityStruct.ID)
+		err := repo.Delete(ctx, activityStruct.ID, currentTime)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRestoreRepo(t *testing.T) {
+	t.Run("Restore Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		query := regexp.QuoteMeta(fmt.Sprintf(`UPDATE %s SET deleted_at = NULL WHERE id = ? AND company_id = ? AND deleted_at IS NOT NULL`, constant.TableActivity))
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(activityStruct.ID, int64(2)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Restore(ctx, activityStruct.ID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Restore Not Deleted", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		query := regexp.QuoteMeta(fmt.Sprintf(`UPDATE %s SET deleted_at = NULL WHERE id = ? AND company_id = ? AND deleted_at IS NOT NULL`, constant.TableActivity))
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(activityStruct.ID, int64(2)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Restore(ctx, activityStruct.ID)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRevisionsRepo(t *testing.T) {
+	t.Run("Revisions Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		query := regexp.QuoteMeta(fmt.Sprintf(`SELECT id, activityID, deskripsi, edited_by, edited_at FROM %s WHERE activityID = ? ORDER BY edited_at, id`, constant.TableRevision))
+		rows := sqlmock.NewRows([]string{"id", "activityID", "deskripsi", "edited_by", "edited_at"}).
+			AddRow(1, activityStruct.ID, "first", activityStruct.UserID, currentTime).
+			AddRow(2, activityStruct.ID, nil, activityStruct.UserID, currentTime)
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectQuery(query).WithArgs(activityStruct.ID).WillReturnRows(rows)
+
+		revisions, err := repo.Revisions(ctx, activityStruct.ID)
+
+		assert.NoError(t, err)
+		assert.Len(t, revisions, 2)
+		assert.Equal(t, "first", revisions[0].Description)
+		assert.Equal(t, "", revisions[1].Description)
+	})
+
+	t.Run("Revisions Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, activityID`)).WillReturnError(fmt.Errorf("query failed"))
+
+		_, err := repo.Revisions(ctx, activityStruct.ID)
 
 		assert.Error(t, err)
 	})
@@ -184,13 +322,13 @@ func TestRiwayatRepo(t *testing.T) {
 
 		defer db.Close()
 
-		query := fmt.Sprintf(`SELECT id, userID, deskripsi, created_at, update_at FROM %s WHERE DATE(created_at) BETWEEN '%s' AND '%s' ORDER BY created_at asc`, constant.TableActivity, dateStruct.From, dateStruct.To)
-		rows := sqlmock.NewRows([]string{"id", "userID", "deskripsi", "created_at", "update_at"}).AddRow(activityStruct.ID, activityStruct.UserID, activityStruct.Description, activityStruct.CreatedAt, activityStruct.UpdateAt)
-		ctx := context.TODO()
+		query := fmt.Sprintf(`SELECT id, userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, update_at, deleted_at, status, review_comment, reviewed_by, reviewed_at FROM %s WHERE userID = ? AND company_id = ? AND deleted_at IS NULL AND DATE(created_at) >= ? AND DATE(created_at) <= ? AND id > ? ORDER BY id ASC LIMIT ?`, constant.TableActivity)
+		rows := sqlmock.NewRows([]string{"id", "userID", "absenID", "projectID", "deskripsi", "started_at", "ended_at", "duration_minutes", "created_at", "update_at", "deleted_at", "status", "review_comment", "reviewed_by", "reviewed_at"}).AddRow(activityStruct.ID, activityStruct.UserID, activityStruct.AbsenID, nil, activityStruct.Description, nil, nil, activityStruct.Duration, activityStruct.CreatedAt, activityStruct.UpdateAt, nil, activitys.StatusPending, nil, nil, nil)
+		ctx := tenant.WithID(context.TODO(), 2)
 
-		mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(activityStruct.UserID, int64(2), dateStruct.From, dateStruct.To, dateStruct.Cursor, dateStruct.Limit).WillReturnRows(rows)
 
-		activityStruct, err := repo.Riwayat(ctx, activityStruct.ID, dateStruct)
+		activityStruct, err := repo.Riwayat(ctx, activityStruct.UserID, dateStruct)
 
 		assert.NotEmpty(t, activityStruct)
 		assert.NoError(t, err)
@@ -202,18 +340,53 @@ func TestRiwayatRepo(t *testing.T) {
 
 		defer db.Close()
 
-		query := fmt.Sprintf(`SELECT id, userID, deskripsi, created_at, update_at FROM %s WHERE DATE(created_at) BETWEEN '%s' AND '%s' ORDER BY created_at asc`, constant.TableActivity, dateStruct.From, dateStruct.To)
-		rows := sqlmock.NewRows([]string{"id", "userID", "deskripsi", "created_at", "update_at"}).AddRow(activityStruct.ID, activityStruct.UserID, activityStruct.Description, activityStruct.CreatedAt, activityStruct.UpdateAt)
-		ctx := context.TODO()
+		params := activitys.DateReq{
+			From: dateStruct.From,
+			Pagination: paginations.Pagination{
+				Limit: 20,
+				Sort:  paginations.SortDesc,
+			},
+		}
 
-		mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(rows)
+		query := fmt.Sprintf(`SELECT id, userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, update_at, deleted_at, status, review_comment, reviewed_by, reviewed_at FROM %s WHERE userID = ? AND company_id = ? AND deleted_at IS NULL AND DATE(created_at) >= ? ORDER BY id DESC LIMIT ?`, constant.TableActivity)
+		rows := sqlmock.NewRows([]string{"id", "userID", "absenID", "projectID", "deskripsi", "started_at", "ended_at", "duration_minutes", "created_at", "update_at", "deleted_at", "status", "review_comment", "reviewed_by", "reviewed_at"}).AddRow(activityStruct.ID, activityStruct.UserID, activityStruct.AbsenID, nil, activityStruct.Description, nil, nil, activityStruct.Duration, activityStruct.CreatedAt, activityStruct.UpdateAt, nil, activitys.StatusPending, nil, nil, nil)
+		ctx := tenant.WithID(context.TODO(), 2)
 
-		activityStruct, err := repo.Riwayat(ctx, activityStruct.ID, dateStruct)
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(activityStruct.UserID, int64(2), params.From, params.Limit).WillReturnRows(rows)
+
+		activityStruct, err := repo.Riwayat(ctx, activityStruct.UserID, params)
 
 		assert.NotEmpty(t, activityStruct)
 		assert.NoError(t, err)
 	})
 
+	t.Run("Riwayat Filter By Session", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		params := activitys.DateReq{
+			AbsenID: activityStruct.AbsenID,
+			Pagination: paginations.Pagination{
+				Limit: 20,
+				Sort:  paginations.SortDesc,
+			},
+		}
+
+		query := fmt.Sprintf(`SELECT id, userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, update_at, deleted_at, status, review_comment, reviewed_by, reviewed_at FROM %s WHERE userID = ? AND company_id = ? AND deleted_at IS NULL AND absenID = ? ORDER BY id DESC LIMIT ?`, constant.TableActivity)
+		rows := sqlmock.NewRows([]string{"id", "userID", "absenID", "projectID", "deskripsi", "started_at", "ended_at", "duration_minutes", "created_at", "update_at", "deleted_at", "status", "review_comment", "reviewed_by", "reviewed_at"}).AddRow(activityStruct.ID, activityStruct.UserID, activityStruct.AbsenID, nil, activityStruct.Description, nil, nil, activityStruct.Duration, activityStruct.CreatedAt, activityStruct.UpdateAt, nil, activitys.StatusPending, nil, nil, nil)
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(activityStruct.UserID, int64(2), params.AbsenID, params.Limit).WillReturnRows(rows)
+
+		activityStruct, err := repo.Riwayat(ctx, activityStruct.UserID, params)
+
+		assert.Len(t, activityStruct, 1)
+		assert.Equal(t, int64(1), activityStruct[0].AbsenID)
+		assert.NoError(t, err)
+	})
+
 	t.Run("Riwayat Error", func(t *testing.T) {
 		db, mock := mock.NewMock()
 		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
@@ -221,9 +394,9 @@ func TestRiwayatRepo(t *testing.T) {
 		defer db.Close()
 
 		query := fmt.Sprintf(`SELECT * FROM %s WHERE DATE(created_at) BETWEEN '%s' AND '%s' ORDER BY created_at asc`, constant.TableActivity, dateStruct.From, dateStruct.To)
-		rows := sqlmock.NewRows([]string{"id", "userID", "deskripsi", "created_at", "update_at"}).AddRow(activityStruct.ID, activityStruct.UserID, activityStruct.Description, activityStruct.CreatedAt, activityStruct.UpdateAt)
+		rows := sqlmock.NewRows([]string{"id", "userID", "absenID", "projectID", "deskripsi", "started_at", "ended_at", "duration_minutes", "created_at", "update_at", "deleted_at", "status", "review_comment", "reviewed_by", "reviewed_at"}).AddRow(activityStruct.ID, activityStruct.UserID, activityStruct.AbsenID, nil, activityStruct.Description, nil, nil, activityStruct.Duration, activityStruct.CreatedAt, activityStruct.UpdateAt, nil, activitys.StatusPending, nil, nil, nil)
 
-		ctx := context.TODO()
+		ctx := tenant.WithID(context.TODO(), 2)
 
 		mock.ExpectPrepare(query).ExpectQuery().WithArgs().WillReturnRows(rows)
 
@@ -232,3 +405,446 @@ func TestRiwayatRepo(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestCountRiwayatRepo(t *testing.T) {
+	t.Run("Count Riwayat Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE userID = ? AND company_id = ? AND deleted_at IS NULL AND DATE(created_at) >= ? AND DATE(created_at) <= ?`, constant.TableActivity)
+		rows := sqlmock.NewRows([]string{"count"}).AddRow(3)
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(activityStruct.UserID, int64(2), dateStruct.From, dateStruct.To).WillReturnRows(rows)
+
+		total, err := repo.CountRiwayat(ctx, activityStruct.UserID, dateStruct)
+
+		assert.Equal(t, int64(3), total)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Count Riwayat Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT COUNT(*) FROM %s`, constant.TableActivity)
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(fmt.Errorf("error"))
+
+		total, err := repo.CountRiwayat(ctx, activityStruct.UserID, dateStruct)
+
+		assert.Equal(t, int64(0), total)
+		assert.Error(t, err)
+	})
+}
+
+func TestFindBySessionRepo(t *testing.T) {
+	t.Run("FindBySession Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		startedAt := currentTime.Add(8 * time.Hour)
+		endedAt := startedAt.Add(time.Hour)
+
+		query := fmt.Sprintf(`SELECT id, userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, update_at, deleted_at, status, review_comment, reviewed_by, reviewed_at FROM %s WHERE absenID = ? AND company_id = ? AND deleted_at IS NULL ORDER BY started_at`, constant.TableActivity)
+		rows := sqlmock.NewRows([]string{"id", "userID", "absenID", "projectID", "deskripsi", "started_at", "ended_at", "duration_minutes", "created_at", "update_at", "deleted_at", "status", "review_comment", "reviewed_by", "reviewed_at"}).AddRow(activityStruct.ID, activityStruct.UserID, activityStruct.AbsenID, nil, activityStruct.Description, startedAt, endedAt, 60, activityStruct.CreatedAt, activityStruct.UpdateAt, nil, activitys.StatusPending, nil, nil, nil)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(activityStruct.AbsenID, int64(2)).WillReturnRows(rows)
+
+		activityStruct, err := repo.FindBySession(tenant.WithID(context.TODO(), 2), activityStruct.AbsenID)
+
+		assert.NoError(t, err)
+		assert.Len(t, activityStruct, 1)
+		assert.True(t, activityStruct[0].Timed())
+		assert.Equal(t, 60, activityStruct[0].Duration)
+	})
+
+	t.Run("FindBySession Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, update_at, deleted_at, status, review_comment, reviewed_by, reviewed_at FROM %s WHERE absenID = ? AND company_id = ? AND deleted_at IS NULL ORDER BY started_at`, constant.TableActivity)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(activityStruct.AbsenID, int64(2)).WillReturnError(fmt.Errorf("error"))
+
+		_, err := repo.FindBySession(tenant.WithID(context.TODO(), 2), activityStruct.AbsenID)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestDailyTotalsRepo(t *testing.T) {
+	t.Run("Daily Totals Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT DATE(COALESCE(started_at, created_at)) AS day, SUM(duration_minutes), COUNT(*) FROM %s WHERE userID = ? AND company_id = ? AND deleted_at IS NULL AND DATE(created_at) >= ? AND DATE(created_at) <= ? GROUP BY day ORDER BY day`, constant.TableActivity)
+		rows := sqlmock.NewRows([]string{"day", "SUM(duration_minutes)", "COUNT(*)"}).AddRow(currentTime, 90, 2)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(activityStruct.UserID, int64(2), dateStruct.From, dateStruct.To).WillReturnRows(rows)
+
+		totals, err := repo.DailyTotals(tenant.WithID(context.TODO(), 2), activityStruct.UserID, dateStruct)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []activitys.DailyTotal{{Date: "2021-12-12", Minutes: 90, Activities: 2}}, totals)
+	})
+
+	t.Run("Daily Totals Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		query := `SELECT DATE(COALESCE(started_at, created_at)) AS day`
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(fmt.Errorf("error"))
+
+		_, err := repo.DailyTotals(tenant.WithID(context.TODO(), 2), activityStruct.UserID, dateStruct)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestProjectTotalsRepo(t *testing.T) {
+	t.Run("Project Totals Filter By Project And Tag", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		params := activitys.DateReq{
+			ProjectID: 2,
+			Tag:       "meeting",
+		}
+
+		query := fmt.Sprintf(`SELECT t.projectID, p.code, p.name, t.minutes, t.activities FROM (SELECT projectID, SUM(duration_minutes) AS minutes, COUNT(*) AS activities FROM %s WHERE userID = ? AND company_id = ? AND deleted_at IS NULL AND projectID = ? AND id IN (SELECT activityID FROM %s WHERE tag = ?) GROUP BY projectID) t LEFT JOIN %s p ON p.id = t.projectID ORDER BY p.code`, constant.TableActivity, constant.TableTag, constant.TableProject)
+		rows := sqlmock.NewRows([]string{"projectID", "code", "name", "minutes", "activities"}).AddRow(2, "ACME", "Acme Portal", 120, 3)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(activityStruct.UserID, int64(2), params.ProjectID, params.Tag).WillReturnRows(rows)
+
+		totals, err := repo.ProjectTotals(tenant.WithID(context.TODO(), 2), activityStruct.UserID, params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []activitys.ProjectTotal{{ProjectID: 2, Code: "ACME", Name: "Acme Portal", Minutes: 120, Activities: 3}}, totals)
+	})
+
+	t.Run("Project Totals Without Project", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"projectID", "code", "name", "minutes", "activities"}).AddRow(nil, nil, nil, 30, 1)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT t.projectID`)).WillReturnRows(rows)
+
+		totals, err := repo.ProjectTotals(tenant.WithID(context.TODO(), 2), activityStruct.UserID, activitys.DateReq{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []activitys.ProjectTotal{{Minutes: 30, Activities: 1}}, totals)
+	})
+
+	t.Run("Project Totals Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT t.projectID`)).WillReturnError(fmt.Errorf("error"))
+
+		_, err := repo.ProjectTotals(tenant.WithID(context.TODO(), 2), activityStruct.UserID, activitys.DateReq{})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestSetTagsRepo(t *testing.T) {
+	t.Run("Set Tags Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`DELETE FROM %s WHERE activityID = ?`, constant.TableTag))).WithArgs(activityStruct.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO %s (activityID, tag) VALUES (?, ?)`, constant.TableTag))).WithArgs(activityStruct.ID, "meeting").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO %s (activityID, tag) VALUES (?, ?)`, constant.TableTag))).WithArgs(activityStruct.ID, "client").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.SetTags(tenant.WithID(context.TODO(), 2), activityStruct.ID, []string{"meeting", "client"})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Set Tags Error Rolls Back", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`DELETE FROM %s WHERE activityID = ?`, constant.TableTag))).WithArgs(activityStruct.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO %s (activityID, tag) VALUES (?, ?)`, constant.TableTag))).WithArgs(activityStruct.ID, "meeting").WillReturnError(fmt.Errorf("error"))
+		mock.ExpectRollback()
+
+		err := repo.SetTags(tenant.WithID(context.TODO(), 2), activityStruct.ID, []string{"meeting"})
+
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestFindTagsRepo(t *testing.T) {
+	t.Run("Find Tags Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT activityID, tag FROM %s WHERE activityID IN (?, ?) ORDER BY activityID, tag`, constant.TableTag)
+		rows := sqlmock.NewRows([]string{"activityID", "tag"}).AddRow(1, "client").AddRow(1, "meeting")
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(int64(1), int64(2)).WillReturnRows(rows)
+
+		tags, err := repo.FindTags(tenant.WithID(context.TODO(), 2), []int64{1, 2})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[int64][]string{1: {"client", "meeting"}}, tags)
+	})
+
+	t.Run("Find Tags Empty", func(t *testing.T) {
+		db, _ := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		tags, err := repo.FindTags(tenant.WithID(context.TODO(), 2), []int64{})
+
+		assert.NoError(t, err)
+		assert.Empty(t, tags)
+	})
+}
+
+func TestFindByIDsRepo(t *testing.T) {
+	t.Run("FindByIDs Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		query := regexp.QuoteMeta(fmt.Sprintf(`SELECT id, userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, update_at, deleted_at, status, review_comment, reviewed_by, reviewed_at FROM %s WHERE id IN (?, ?) AND company_id = ? ORDER BY id`, constant.TableActivity))
+		rows := sqlmock.NewRows([]string{"id", "userID", "absenID", "projectID", "deskripsi", "started_at", "ended_at", "duration_minutes", "created_at", "update_at", "deleted_at", "status", "review_comment", "reviewed_by", "reviewed_at"}).
+			AddRow(1, 2, 1, nil, "test", nil, nil, 0, currentTime, currentTime, nil, activitys.StatusPending, nil, nil, nil).
+			AddRow(2, 2, 1, nil, "test", nil, nil, 0, currentTime, currentTime, nil, activitys.StatusRejected, "too short", 3, currentTime)
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectQuery(query).WithArgs(int64(1), int64(2), int64(2)).WillReturnRows(rows)
+
+		activity, err := repo.FindByIDs(ctx, []int64{1, 2})
+
+		assert.NoError(t, err)
+		assert.Len(t, activity, 2)
+		assert.Equal(t, activitys.StatusRejected, activity[1].Status)
+		assert.Equal(t, "too short", activity[1].ReviewComment)
+		assert.Equal(t, int64(3), activity[1].ReviewedBy)
+	})
+
+	t.Run("FindByIDs Empty", func(t *testing.T) {
+		db, _ := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		activity, err := repo.FindByIDs(tenant.WithID(context.TODO(), 2), nil)
+
+		assert.NoError(t, err)
+		assert.Empty(t, activity)
+	})
+}
+
+func TestPendingRepo(t *testing.T) {
+	t.Run("Pending Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		params := activitys.PendingReq{
+			UserID: 2,
+			From:   "2000-01-01",
+			Pagination: paginations.Pagination{
+				Limit:  10,
+				Cursor: 5,
+				Sort:   paginations.SortAsc,
+			},
+		}
+
+		query := regexp.QuoteMeta(fmt.Sprintf(`SELECT id, userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, update_at, deleted_at, status, review_comment, reviewed_by, reviewed_at FROM %s WHERE status = ? AND company_id = ? AND deleted_at IS NULL AND userID = ? AND DATE(created_at) >= ? AND id > ? ORDER BY id ASC LIMIT ?`, constant.TableActivity))
+		rows := sqlmock.NewRows([]string{"id", "userID", "absenID", "projectID", "deskripsi", "started_at", "ended_at", "duration_minutes", "created_at", "update_at", "deleted_at", "status", "review_comment", "reviewed_by", "reviewed_at"}).
+			AddRow(6, 2, 1, nil, "test", nil, nil, 0, currentTime, currentTime, nil, activitys.StatusPending, nil, nil, nil)
+
+		ctx := tenant.WithID(context.TODO(), 2)
+
+		mock.ExpectQuery(query).WithArgs(activitys.StatusPending, int64(2), params.UserID, params.From, params.Cursor, params.Limit).WillReturnRows(rows)
+
+		activity, err := repo.Pending(ctx, params)
+
+		assert.NoError(t, err)
+		assert.Len(t, activity, 1)
+	})
+
+	t.Run("Pending Reports", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		params := activitys.PendingReq{
+			UserIDs:    []int64{5, 6},
+			Pagination: paginations.Pagination{Limit: 10},
+		}
+
+		query := regexp.QuoteMeta(fmt.Sprintf(`FROM %s WHERE status = ? AND company_id = ? AND deleted_at IS NULL AND userID IN (?, ?) ORDER BY id ASC LIMIT ?`, constant.TableActivity))
+
+		mock.ExpectQuery(query).WithArgs(activitys.StatusPending, int64(2), int64(5), int64(6), 10).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		activity, err := repo.Pending(tenant.WithID(context.TODO(), 2), params)
+
+		assert.NoError(t, err)
+		assert.Empty(t, activity)
+	})
+
+	t.Run("Pending Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, userID`)).WillReturnError(fmt.Errorf("query failed"))
+
+		_, err := repo.Pending(tenant.WithID(context.TODO(), 2), activitys.PendingReq{Pagination: paginations.Pagination{Limit: 10}})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCountPendingRepo(t *testing.T) {
+	db, mock := mock.NewMock()
+	repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+	defer db.Close()
+
+	query := regexp.QuoteMeta(fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE status = ? AND company_id = ? AND deleted_at IS NULL AND DATE(created_at) <= ?`, constant.TableActivity))
+	rows := sqlmock.NewRows([]string{"count"}).AddRow(4)
+
+	mock.ExpectQuery(query).WithArgs(activitys.StatusPending, int64(2), "2000-01-02").WillReturnRows(rows)
+
+	total, err := repo.CountPending(tenant.WithID(context.TODO(), 2), activitys.PendingReq{To: "2000-01-02"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), total)
+}
+
+func TestReviewRepo(t *testing.T) {
+	review := activitys.Activity{
+		Status:        activitys.StatusRejected,
+		ReviewComment: "too short",
+		ReviewedBy:    3,
+		ReviewedAt:    currentTime,
+	}
+	query := regexp.QuoteMeta(fmt.Sprintf(`UPDATE %s SET status = ?, review_comment = ?, reviewed_by = ?, reviewed_at = ? WHERE id IN (?, ?) AND company_id = ? AND status = ? AND deleted_at IS NULL`, constant.TableActivity))
+
+	t.Run("Review Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(query).WithArgs(review.Status, review.ReviewComment, review.ReviewedBy, review.ReviewedAt, int64(1), int64(2), int64(2), activitys.StatusPending).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		err := repo.Review(tenant.WithID(context.TODO(), 2), []int64{1, 2}, review)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Review Already Reviewed", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectRollback()
+
+		err := repo.Review(tenant.WithID(context.TODO(), 2), []int64{1, 2}, review)
+
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAddActivitiesRepo(t *testing.T) {
+	activityQuery := regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO %s (userID, absenID, projectID, deskripsi, started_at, ended_at, duration_minutes, created_at, company_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, constant.TableActivity))
+	tagQuery := regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO %s (activityID, tag) VALUES (?, ?)`, constant.TableTag))
+
+	items := []activitys.Activity{
+		{AbsenID: 1, Description: "first", Tags: []string{"standup", "today"}, CreatedAt: currentTime},
+		{AbsenID: 1, ProjectID: 2, Description: "second", CreatedAt: currentTime},
+	}
+
+	t.Run("Add Activities Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(activityQuery).WithArgs(int64(1), int64(1), nil, "first", nil, nil, 0, currentTime, int64(2)).WillReturnResult(sqlmock.NewResult(10, 1))
+		mock.ExpectExec(tagQuery).WithArgs(int64(10), "standup").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(tagQuery).WithArgs(int64(10), "today").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(activityQuery).WithArgs(int64(1), int64(1), int64(2), "second", nil, nil, 0, currentTime, int64(2)).WillReturnResult(sqlmock.NewResult(11, 1))
+		mock.ExpectCommit()
+
+		ids, err := repo.AddActivities(tenant.WithID(context.TODO(), 2), 1, items)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{10, 11}, ids)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Add Activities Rolls Back", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := activity.NewActivityRepositoryImpl(db, constant.TableActivity)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(activityQuery).WillReturnResult(sqlmock.NewResult(10, 1))
+		mock.ExpectExec(tagQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(tagQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(activityQuery).WillReturnError(fmt.Errorf("insert failed"))
+		mock.ExpectRollback()
+
+		_, err := repo.AddActivities(tenant.WithID(context.TODO(), 2), 1, items)
+
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
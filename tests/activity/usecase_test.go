@@ -9,19 +9,41 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
 	"github.com/Risuii/internal/activity"
+	"github.com/Risuii/models/absensis"
 	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/orgs"
+	"github.com/Risuii/models/projects"
+	"github.com/Risuii/models/users"
+	absensiMocks "github.com/Risuii/tests/absensi/mocks"
 	"github.com/Risuii/tests/activity/mocks"
+	orgMocks "github.com/Risuii/tests/org/mocks"
+	projectMocks "github.com/Risuii/tests/project/mocks"
 )
 
 func TestAddActivity(t *testing.T) {
+	openSession := absensis.Absensi{
+		ID:      1,
+		UserID:  1,
+		Checkin: time.Now(),
+	}
+
 	t.Run("Add Activity Success", func(t *testing.T) {
 		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
 
-		activityRepository.On("AddActivity", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.Activity")).Return(int64(1), nil)
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(openSession, nil)
+		activityRepository.On("AddActivity", mock.Anything, int64(1), mock.MatchedBy(func(a activitys.Activity) bool {
+			return a.AbsenID == 1
+		})).Return(int64(1), nil)
 
 		activityUseCase := activity.NewActivityUseCaseImpl(
 			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
 		)
 
 		ctx := context.TODO()
@@ -34,30 +56,103 @@ func TestAddActivity(t *testing.T) {
 			UpdateAt:    time.Time{},
 		}
 
-		resp := activityUseCase.AddActivity(ctx, params.UserID, params)
+		resp := activityUseCase.AddActivity(ctx, params.UserID, 1, params)
 
 		assert.NoError(t, resp.Err())
 		activityRepository.AssertExpectations(t)
+		absensiRepository.AssertExpectations(t)
 	})
 
 	t.Run("Add Activity Error", func(t *testing.T) {
 		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
 
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(openSession, nil)
 		activityRepository.On("AddActivity", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.Activity")).Return(int64(0), exception.ErrInternalServer)
 
 		activityUseCase := activity.NewActivityUseCaseImpl(
 			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
 		)
 
 		ctx := context.TODO()
 
 		params := activitys.Activity{}
 
-		resp := activityUseCase.AddActivity(ctx, params.UserID, params)
+		resp := activityUseCase.AddActivity(ctx, 1, 1, params)
 
 		assert.Error(t, resp.Err())
 		activityRepository.AssertExpectations(t)
 	})
+
+	t.Run("Add Activity Error Closed Session", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		closed := openSession
+		closed.Checkout = time.Now()
+
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(closed, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.AddActivity(context.TODO(), 1, 1, activitys.Activity{Description: "test"})
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+		activityRepository.AssertExpectations(t)
+		absensiRepository.AssertExpectations(t)
+	})
+
+	t.Run("Add Activity Error Other Users Session", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(openSession, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.AddActivity(context.TODO(), 2, 1, activitys.Activity{Description: "test"})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		activityRepository.AssertExpectations(t)
+		absensiRepository.AssertExpectations(t)
+	})
+
+	t.Run("Add Activity Error Session Not Found", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, int64(0)).Return(absensis.Absensi{}, exception.ErrNotFound)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.AddActivity(context.TODO(), 1, 0, activitys.Activity{Description: "test"})
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+		activityRepository.AssertExpectations(t)
+		absensiRepository.AssertExpectations(t)
+	})
 }
 
 func TestUpdateActivity(t *testing.T) {
@@ -72,12 +167,17 @@ func TestUpdateActivity(t *testing.T) {
 		}
 
 		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
 
 		activityRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(mockData, nil)
-		activityRepository.On("UpdateActivity", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.Activity")).Return(nil)
+		activityRepository.On("UpdateActivity", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.Activity"), mock.AnythingOfType("activitys.Revision")).Return(nil)
 
 		activityUseCase := activity.NewActivityUseCaseImpl(
 			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
 		)
 
 		ctx := context.TODO()
@@ -104,11 +204,16 @@ func TestUpdateActivity(t *testing.T) {
 	t.Run("Update Activity Error NotFound", func(t *testing.T) {
 
 		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
 
 		activityRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(activitys.Activity{}, exception.ErrNotFound)
 
 		activityUseCase := activity.NewActivityUseCaseImpl(
 			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
 		)
 
 		ctx := context.TODO()
@@ -129,11 +234,16 @@ func TestUpdateActivity(t *testing.T) {
 	t.Run("Update Activity Error Internal Server", func(t *testing.T) {
 
 		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
 
 		activityRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(activitys.Activity{}, exception.ErrInternalServer)
 
 		activityUseCase := activity.NewActivityUseCaseImpl(
 			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
 		)
 
 		ctx := context.TODO()
@@ -161,12 +271,17 @@ func TestUpdateActivity(t *testing.T) {
 		}
 
 		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
 
 		activityRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(mockData, nil)
-		activityRepository.On("UpdateActivity", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.Activity")).Return(nil)
+		activityRepository.On("UpdateActivity", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.Activity"), mock.AnythingOfType("activitys.Revision")).Return(nil)
 
 		activityUseCase := activity.NewActivityUseCaseImpl(
 			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
 		)
 
 		ctx := context.TODO()
@@ -200,12 +315,17 @@ func TestUpdateActivity(t *testing.T) {
 		}
 
 		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
 
 		activityRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(mockData, nil)
-		activityRepository.On("UpdateActivity", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.Activity")).Return(exception.ErrInternalServer)
+		activityRepository.On("UpdateActivity", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.Activity"), mock.AnythingOfType("activitys.Revision")).Return(exception.ErrInternalServer)
 
 		activityUseCase := activity.NewActivityUseCaseImpl(
 			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
 		)
 
 		ctx := context.TODO()
@@ -236,12 +356,17 @@ func TestDeleteActivity(t *testing.T) {
 			UserID: 1,
 		}
 		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
 
 		activityRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(mockData, nil)
-		activityRepository.On("Delete", mock.Anything, mock.AnythingOfType("int64")).Return(nil)
+		activityRepository.On("Delete", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("time.Time")).Return(nil)
 
 		activityUseCase := activity.NewActivityUseCaseImpl(
 			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
 		)
 
 		ctx := context.TODO()
@@ -264,11 +389,16 @@ func TestDeleteActivity(t *testing.T) {
 
 	t.Run("Delete Activity Error Not Found", func(t *testing.T) {
 		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
 
 		activityRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(activitys.Activity{}, exception.ErrNotFound)
 
 		activityUseCase := activity.NewActivityUseCaseImpl(
 			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
 		)
 
 		ctx := context.TODO()
@@ -286,11 +416,16 @@ func TestDeleteActivity(t *testing.T) {
 
 	t.Run("Delete Activity Error Internal Server", func(t *testing.T) {
 		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
 
 		activityRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(activitys.Activity{}, exception.ErrInternalServer)
 
 		activityUseCase := activity.NewActivityUseCaseImpl(
 			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
 		)
 
 		ctx := context.TODO()
@@ -312,12 +447,17 @@ func TestDeleteActivity(t *testing.T) {
 			UserID: 1,
 		}
 		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
 
 		activityRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(mockData, nil)
-		activityRepository.On("Delete", mock.Anything, mock.AnythingOfType("int64")).Return(nil)
+		activityRepository.On("Delete", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("time.Time")).Return(nil)
 
 		activityUseCase := activity.NewActivityUseCaseImpl(
 			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
 		)
 
 		ctx := context.TODO()
@@ -344,12 +484,17 @@ func TestDeleteActivity(t *testing.T) {
 			UserID: 1,
 		}
 		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
 
 		activityRepository.On("FindByID", mock.Anything, mock.AnythingOfType("int64")).Return(mockData, nil)
-		activityRepository.On("Delete", mock.Anything, mock.AnythingOfType("int64")).Return(exception.ErrInternalServer)
+		activityRepository.On("Delete", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("time.Time")).Return(exception.ErrInternalServer)
 
 		activityUseCase := activity.NewActivityUseCaseImpl(
 			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
 		)
 
 		ctx := context.TODO()
@@ -374,11 +519,20 @@ func TestDeleteActivity(t *testing.T) {
 func TestRiwayatActivity(t *testing.T) {
 	t.Run("Riwayat Success", func(t *testing.T) {
 		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
 
-		activityRepository.On("Riwayat", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.DateReq")).Return([]activitys.Activity{}, nil)
+		activityRepository.On("Riwayat", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.DateReq")).Return([]activitys.Activity{{ID: 3, UserID: 1, Description: "test"}}, nil)
+		activityRepository.On("CountRiwayat", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.DateReq")).Return(int64(1), nil)
+		activityRepository.On("DailyTotals", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.DateReq")).Return([]activitys.DailyTotal{{Date: "2000-01-01", Minutes: 90, Activities: 2}}, nil)
+		activityRepository.On("ProjectTotals", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.DateReq")).Return([]activitys.ProjectTotal{{ProjectID: 2, Code: "ACME", Minutes: 90, Activities: 2}}, nil)
+		activityRepository.On("FindTags", mock.Anything, []int64{3}).Return(map[int64][]string{3: {"meeting"}}, nil)
 
 		activityUseCase := activity.NewActivityUseCaseImpl(
 			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
 		)
 
 		params := activitys.Activity{
@@ -386,8 +540,8 @@ func TestRiwayatActivity(t *testing.T) {
 		}
 
 		data := activitys.DateReq{
-			From: "test",
-			To:   "test",
+			From: "2000-01-01",
+			To:   "2000-01-02",
 		}
 
 		ctx := context.TODO()
@@ -395,16 +549,25 @@ func TestRiwayatActivity(t *testing.T) {
 		resp := activityUseCase.Riwayat(ctx, params.UserID, data)
 
 		assert.NoError(t, resp.Err())
+		riwayat := resp.(*response.ResponseImpl).Data.(activitys.Riwayat)
+		assert.Equal(t, 90, riwayat.Totals[0].Minutes)
+		assert.Equal(t, "ACME", riwayat.Projects[0].Code)
+		assert.Equal(t, []string{"meeting"}, riwayat.Activities[0].Tags)
 		activityRepository.AssertExpectations(t)
 	})
 
 	t.Run("Riwayat Error Not Found", func(t *testing.T) {
 		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
 
 		activityRepository.On("Riwayat", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.DateReq")).Return([]activitys.Activity{}, exception.ErrNotFound)
 
 		activityUseCase := activity.NewActivityUseCaseImpl(
 			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
 		)
 
 		params := activitys.Activity{
@@ -412,8 +575,8 @@ func TestRiwayatActivity(t *testing.T) {
 		}
 
 		data := activitys.DateReq{
-			From: "test",
-			To:   "test",
+			From: "2000-01-01",
+			To:   "2000-01-02",
 		}
 
 		ctx := context.TODO()
@@ -426,17 +589,1368 @@ func TestRiwayatActivity(t *testing.T) {
 
 	t.Run("Riwayat Error Internal Server", func(t *testing.T) {
 		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
 
 		activityRepository.On("Riwayat", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.DateReq")).Return([]activitys.Activity{}, exception.ErrInternalServer)
 
 		activityUseCase := activity.NewActivityUseCaseImpl(
 			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
 		)
 		ctx := context.TODO()
 
 		resp := activityUseCase.Riwayat(ctx, 0, activitys.DateReq{})
 
+		assert.Error(t, resp.Err())
+		activityRepository.AssertExpectations(t)
+	})
+}
+
+func TestCountRiwayatActivity(t *testing.T) {
+	t.Run("Count Riwayat Error Internal Server", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("Riwayat", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.DateReq")).Return([]activitys.Activity{}, nil)
+		activityRepository.On("CountRiwayat", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.DateReq")).Return(int64(0), exception.ErrInternalServer)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.Riwayat(context.TODO(), 1, activitys.DateReq{})
+
+		assert.Error(t, resp.Err())
+		activityRepository.AssertExpectations(t)
+	})
+}
+
+func TestDailyTotalsActivity(t *testing.T) {
+	t.Run("Daily Totals Error Internal Server", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("Riwayat", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.DateReq")).Return([]activitys.Activity{}, nil)
+		activityRepository.On("CountRiwayat", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.DateReq")).Return(int64(0), nil)
+		activityRepository.On("DailyTotals", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("activitys.DateReq")).Return([]activitys.DailyTotal{}, exception.ErrInternalServer)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.Riwayat(context.TODO(), 1, activitys.DateReq{})
+
+		assert.Error(t, resp.Err())
+		activityRepository.AssertExpectations(t)
+	})
+}
+
+func TestTrackActivity(t *testing.T) {
+	checkin := time.Now().Add(-4 * time.Hour).Truncate(time.Minute)
+	session := absensis.Absensi{
+		ID:      1,
+		UserID:  1,
+		Checkin: checkin,
+	}
+	logged := []activitys.Activity{
+		{ID: 7, UserID: 1, AbsenID: 1, StartedAt: checkin.Add(time.Hour), EndedAt: checkin.Add(2 * time.Hour), Duration: 60},
+	}
+
+	t.Run("Track Start And Duration", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+		activityRepository.On("FindBySession", mock.Anything, int64(1)).Return(logged, nil)
+		activityRepository.On("AddActivity", mock.Anything, int64(1), mock.MatchedBy(func(a activitys.Activity) bool {
+			return a.EndedAt.Equal(checkin.Add(30*time.Minute)) && a.Duration == 30
+		})).Return(int64(8), nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.AddActivity(context.TODO(), 1, 1, activitys.Activity{
+			Description: "test",
+			StartedAt:   checkin,
+			Duration:    30,
+		})
+
+		assert.NoError(t, resp.Err())
+		activityRepository.AssertExpectations(t)
+	})
+
+	t.Run("Track Error Overlap", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+		activityRepository.On("FindBySession", mock.Anything, int64(1)).Return(logged, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.AddActivity(context.TODO(), 1, 1, activitys.Activity{
+			Description: "test",
+			StartedAt:   checkin.Add(90 * time.Minute),
+			EndedAt:     checkin.Add(150 * time.Minute),
+		})
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+		activityRepository.AssertExpectations(t)
+	})
+
+	t.Run("Track Error Before Checkin", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.AddActivity(context.TODO(), 1, 1, activitys.Activity{
+			Description: "test",
+			StartedAt:   checkin.Add(-time.Hour),
+			EndedAt:     checkin.Add(time.Hour),
+		})
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+		activityRepository.AssertExpectations(t)
+	})
+
+	t.Run("Track Error End Before Start", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.AddActivity(context.TODO(), 1, 1, activitys.Activity{
+			Description: "test",
+			StartedAt:   checkin.Add(2 * time.Hour),
+			EndedAt:     checkin.Add(time.Hour),
+		})
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+	})
+
+	t.Run("Track Error Duration Longer Than Session", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.AddActivity(context.TODO(), 1, 1, activitys.Activity{
+			Description: "test",
+			Duration:    300,
+		})
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+	})
+
+	t.Run("Track Update Keeps Own Slot", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(7)).Return(logged[0], nil)
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+		activityRepository.On("FindBySession", mock.Anything, int64(1)).Return(logged, nil)
+		activityRepository.On("UpdateActivity", mock.Anything, int64(7), mock.AnythingOfType("activitys.Activity"), mock.AnythingOfType("activitys.Revision")).Return(nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.UpdateActivity(context.TODO(), 7, 1, activitys.Activity{
+			Description: "test",
+			StartedAt:   checkin.Add(time.Hour),
+			EndedAt:     checkin.Add(150 * time.Minute),
+		})
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, 90, resp.(*response.ResponseImpl).Data.(activitys.Activity).Duration)
+		activityRepository.AssertExpectations(t)
+	})
+
+	t.Run("Track Update Error Without Session", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(2)).Return(activitys.Activity{ID: 2, UserID: 1}, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.UpdateActivity(context.TODO(), 2, 1, activitys.Activity{
+			Description: "test",
+			Duration:    30,
+		})
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+		activityRepository.AssertExpectations(t)
+	})
+}
+
+func TestProjectActivity(t *testing.T) {
+	session := absensis.Absensi{
+		ID:      1,
+		UserID:  1,
+		Checkin: time.Now(),
+	}
+
+	t.Run("Project And Tags Success", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+		projectRepository.On("FindByID", mock.Anything, int64(2)).Return(projects.Project{ID: 2, Code: "ACME", Active: true}, nil)
+		activityRepository.On("AddActivity", mock.Anything, int64(1), mock.MatchedBy(func(a activitys.Activity) bool {
+			return a.ProjectID == 2
+		})).Return(int64(5), nil)
+		activityRepository.On("SetTags", mock.Anything, int64(5), []string{"meeting", "client"}).Return(nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.AddActivity(context.TODO(), 1, 1, activitys.Activity{
+			Description: "test",
+			ProjectID:   2,
+			Tags:        []string{"Meeting", " meeting", "client", ""},
+		})
+
+		assert.NoError(t, resp.Err())
+		activityRepository.AssertExpectations(t)
+		projectRepository.AssertExpectations(t)
+	})
+
+	t.Run("Project Error Inactive", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+		projectRepository.On("FindByID", mock.Anything, int64(2)).Return(projects.Project{ID: 2, Code: "ACME"}, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.AddActivity(context.TODO(), 1, 1, activitys.Activity{
+			Description: "test",
+			ProjectID:   2,
+		})
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+		activityRepository.AssertExpectations(t)
+	})
+
+	t.Run("Project Error Not Found", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+		projectRepository.On("FindByID", mock.Anything, int64(2)).Return(projects.Project{}, exception.ErrNotFound)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.AddActivity(context.TODO(), 1, 1, activitys.Activity{
+			Description: "test",
+			ProjectID:   2,
+		})
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+	})
+
+	t.Run("Project Update Keeps Inactive Project", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(5)).Return(activitys.Activity{ID: 5, UserID: 1, ProjectID: 2}, nil)
+		activityRepository.On("UpdateActivity", mock.Anything, int64(5), mock.MatchedBy(func(a activitys.Activity) bool {
+			return assert.ObjectsAreEqual([]string{"review"}, a.Tags)
+		}), mock.AnythingOfType("activitys.Revision")).Return(nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.UpdateActivity(context.TODO(), 5, 1, activitys.Activity{
+			Description: "test",
+			ProjectID:   2,
+			Tags:        []string{"review"},
+		})
+
+		assert.NoError(t, resp.Err())
+		activityRepository.AssertExpectations(t)
+		projectRepository.AssertExpectations(t)
+	})
+}
+
+func TestSoftDeleteActivity(t *testing.T) {
+	deletedAt := time.Date(2021, 12, 12, 10, 0, 0, 0, time.UTC)
+
+	t.Run("Update Deleted Activity Not Found", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1, DeletedAt: deletedAt}, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.UpdateActivity(context.TODO(), 3, 1, activitys.Activity{Description: "test"})
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+		activityRepository.AssertNotCalled(t, "UpdateActivity", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Update Keeps Previous Description", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1, Description: "old"}, nil)
+		activityRepository.On("UpdateActivity", mock.Anything, int64(3), mock.AnythingOfType("activitys.Activity"), mock.MatchedBy(func(r activitys.Revision) bool {
+			return r.ActivityID == 3 && r.Description == "old" && r.EditedBy == 1 && !r.EditedAt.IsZero()
+		})).Return(nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.UpdateActivity(context.TODO(), 3, 1, activitys.Activity{Description: "new"})
+
 		assert.NoError(t, resp.Err())
 		activityRepository.AssertExpectations(t)
 	})
+
+	t.Run("Delete Already Deleted Activity", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1, DeletedAt: deletedAt}, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.DeleteActivity(context.TODO(), 3, 1)
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+		activityRepository.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestRestoreActivity(t *testing.T) {
+	deletedAt := time.Date(2021, 12, 12, 10, 0, 0, 0, time.UTC)
+
+	t.Run("Restore Activity Success", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1, DeletedAt: deletedAt}, nil)
+		activityRepository.On("Restore", mock.Anything, int64(3)).Return(nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.RestoreActivity(context.TODO(), 3, 1)
+
+		assert.NoError(t, resp.Err())
+		restored := resp.(*response.ResponseImpl).Data.(activitys.Activity)
+		assert.True(t, restored.DeletedAt.IsZero())
+		activityRepository.AssertExpectations(t)
+	})
+
+	t.Run("Restore Timed Activity Slot Still Free", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		checkin := time.Now().Add(-4 * time.Hour).Truncate(time.Minute)
+		session := absensis.Absensi{ID: 1, UserID: 1, Checkin: checkin}
+		deleted := activitys.Activity{ID: 3, UserID: 1, AbsenID: 1, StartedAt: checkin.Add(time.Hour), EndedAt: checkin.Add(2 * time.Hour), Duration: 60, DeletedAt: deletedAt}
+		logged := []activitys.Activity{
+			{ID: 8, UserID: 1, AbsenID: 1, StartedAt: checkin.Add(2 * time.Hour), EndedAt: checkin.Add(3 * time.Hour), Duration: 60},
+		}
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(deleted, nil)
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+		activityRepository.On("FindBySession", mock.Anything, int64(1)).Return(logged, nil)
+		activityRepository.On("Restore", mock.Anything, int64(3)).Return(nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.RestoreActivity(context.TODO(), 3, 1)
+
+		assert.NoError(t, resp.Err())
+		activityRepository.AssertExpectations(t)
+		absensiRepository.AssertExpectations(t)
+	})
+
+	t.Run("Restore Timed Activity Error Slot Taken", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		checkin := time.Now().Add(-4 * time.Hour).Truncate(time.Minute)
+		session := absensis.Absensi{ID: 1, UserID: 1, Checkin: checkin}
+		deleted := activitys.Activity{ID: 3, UserID: 1, AbsenID: 1, StartedAt: checkin.Add(time.Hour), EndedAt: checkin.Add(2 * time.Hour), Duration: 60, DeletedAt: deletedAt}
+		// logged after the delete, in the same slot
+		logged := []activitys.Activity{
+			{ID: 8, UserID: 1, AbsenID: 1, StartedAt: checkin.Add(90 * time.Minute), EndedAt: checkin.Add(150 * time.Minute), Duration: 60},
+		}
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(deleted, nil)
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+		activityRepository.On("FindBySession", mock.Anything, int64(1)).Return(logged, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.RestoreActivity(context.TODO(), 3, 1)
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+		activityRepository.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Restore Activity Not Deleted", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1}, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.RestoreActivity(context.TODO(), 3, 1)
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+	})
+
+	t.Run("Restore Activity Other User", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 2, DeletedAt: deletedAt}, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.RestoreActivity(context.TODO(), 3, 1)
+
+		assert.Equal(t, exception.ErrUnauthorized, resp.Err())
+		activityRepository.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Restore Activity Not Found", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{}, exception.ErrNotFound)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.RestoreActivity(context.TODO(), 3, 1)
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+	})
+}
+
+func TestHistoryActivity(t *testing.T) {
+	revisions := []activitys.Revision{
+		{ID: 1, ActivityID: 3, Description: "first", EditedBy: 1},
+	}
+
+	t.Run("History Owner", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1}, nil)
+		activityRepository.On("Revisions", mock.Anything, int64(3)).Return(revisions, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.History(context.TODO(), 3, 1, users.RoleEmployee)
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, revisions, resp.(*response.ResponseImpl).Data)
+	})
+
+	t.Run("History Manager", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+		chain := new(orgMocks.Chain)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1}, nil)
+		activityRepository.On("Revisions", mock.Anything, int64(3)).Return(revisions, nil)
+		chain.On("InChain", mock.Anything, int64(2), int64(1)).Return(true, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			chain,
+		)
+
+		resp := activityUseCase.History(context.TODO(), 3, 2, users.RoleManager)
+
+		assert.NoError(t, resp.Err())
+		chain.AssertExpectations(t)
+	})
+
+	t.Run("History Manager Outside Chain", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+		chain := new(orgMocks.Chain)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1}, nil)
+		chain.On("InChain", mock.Anything, int64(2), int64(1)).Return(false, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			chain,
+		)
+
+		resp := activityUseCase.History(context.TODO(), 3, 2, users.RoleManager)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		activityRepository.AssertNotCalled(t, "Revisions", mock.Anything, mock.Anything)
+	})
+
+	t.Run("History Admin", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+		chain := new(orgMocks.Chain)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1}, nil)
+		activityRepository.On("Revisions", mock.Anything, int64(3)).Return(revisions, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			chain,
+		)
+
+		resp := activityUseCase.History(context.TODO(), 3, 2, users.RoleAdmin)
+
+		assert.NoError(t, resp.Err())
+		chain.AssertNotCalled(t, "InChain", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("History Other Employee", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1}, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.History(context.TODO(), 3, 2, users.RoleEmployee)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		activityRepository.AssertNotCalled(t, "Revisions", mock.Anything, mock.Anything)
+	})
+}
+
+func TestApprovedActivityLocked(t *testing.T) {
+	approved := activitys.Activity{ID: 3, UserID: 1, Description: "test", Status: activitys.StatusApproved}
+
+	t.Run("Update Approved Activity", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(approved, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.UpdateActivity(context.TODO(), 3, 1, activitys.Activity{Description: "new"})
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+		activityRepository.AssertNotCalled(t, "UpdateActivity", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Delete Approved Activity", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(approved, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.DeleteActivity(context.TODO(), 3, 1)
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+		activityRepository.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Update Rejected Activity Back To Pending", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		rejected := activitys.Activity{ID: 3, UserID: 1, Description: "test", Status: activitys.StatusRejected, ReviewComment: "too short", ReviewedBy: 2}
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(rejected, nil)
+		activityRepository.On("UpdateActivity", mock.Anything, int64(3), mock.AnythingOfType("activitys.Activity"), mock.AnythingOfType("activitys.Revision")).Return(nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.UpdateActivity(context.TODO(), 3, 1, activitys.Activity{Description: "longer description"})
+
+		assert.NoError(t, resp.Err())
+		updated := resp.(*response.ResponseImpl).Data.(activitys.Activity)
+		assert.Equal(t, activitys.StatusPending, updated.Status)
+		assert.Empty(t, updated.ReviewComment)
+		assert.Zero(t, updated.ReviewedBy)
+	})
+}
+
+func TestReviewActivity(t *testing.T) {
+	pending := func() []activitys.Activity {
+		return []activitys.Activity{
+			{ID: 1, UserID: 5, Status: activitys.StatusPending},
+			{ID: 2, UserID: 6, Status: activitys.StatusPending},
+		}
+	}
+
+	// reports puts employees 5 and 6 below manager 9
+	reports := func() *orgMocks.Chain {
+		chain := new(orgMocks.Chain)
+		chain.On("InChain", mock.Anything, int64(9), int64(5)).Return(true, nil)
+		chain.On("InChain", mock.Anything, int64(9), int64(6)).Return(true, nil)
+		return chain
+	}
+
+	t.Run("Review Approve Success", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByIDs", mock.Anything, []int64{1, 2}).Return(pending(), nil)
+		activityRepository.On("Review", mock.Anything, []int64{1, 2}, mock.MatchedBy(func(a activitys.Activity) bool {
+			return a.Status == activitys.StatusApproved && a.ReviewedBy == 9 && a.ReviewComment == "" && !a.ReviewedAt.IsZero()
+		})).Return(nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			reports(),
+		)
+
+		resp := activityUseCase.Review(context.TODO(), 9, users.RoleManager, activitys.ReviewReq{
+			IDs:     []int64{1, 2, 1},
+			Status:  activitys.StatusApproved,
+			Comment: "ignored",
+		})
+
+		assert.NoError(t, resp.Err())
+		reviewed := resp.(*response.ResponseImpl).Data.([]activitys.Activity)
+		assert.Len(t, reviewed, 2)
+		assert.Equal(t, activitys.StatusApproved, reviewed[0].Status)
+		activityRepository.AssertExpectations(t)
+	})
+
+	t.Run("Review Reject With Comment", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByIDs", mock.Anything, []int64{1, 2}).Return(pending(), nil)
+		activityRepository.On("Review", mock.Anything, []int64{1, 2}, mock.MatchedBy(func(a activitys.Activity) bool {
+			return a.Status == activitys.StatusRejected && a.ReviewComment == "add detail"
+		})).Return(nil)
+
+		chain := new(orgMocks.Chain)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			chain,
+		)
+
+		resp := activityUseCase.Review(context.TODO(), 9, users.RoleAdmin, activitys.ReviewReq{
+			IDs:     []int64{1, 2},
+			Status:  activitys.StatusRejected,
+			Comment: " add detail ",
+		})
+
+		assert.NoError(t, resp.Err())
+		activityRepository.AssertExpectations(t)
+		chain.AssertNotCalled(t, "InChain", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Review Employee Forbidden", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.Review(context.TODO(), 9, users.RoleEmployee, activitys.ReviewReq{
+			IDs:    []int64{1},
+			Status: activitys.StatusApproved,
+		})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+	})
+
+	t.Run("Review Own Activity Forbidden", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		chain := new(orgMocks.Chain)
+		chain.On("InChain", mock.Anything, int64(6), int64(5)).Return(true, nil)
+
+		activityRepository.On("FindByIDs", mock.Anything, []int64{1, 2}).Return(pending(), nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			chain,
+		)
+
+		resp := activityUseCase.Review(context.TODO(), 6, users.RoleManager, activitys.ReviewReq{
+			IDs:    []int64{1, 2},
+			Status: activitys.StatusApproved,
+		})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		activityRepository.AssertNotCalled(t, "Review", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Review Missing Activity", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByIDs", mock.Anything, []int64{1, 2, 3}).Return(pending(), nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.Review(context.TODO(), 9, users.RoleManager, activitys.ReviewReq{
+			IDs:    []int64{1, 2, 3},
+			Status: activitys.StatusApproved,
+		})
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+	})
+
+	t.Run("Review Already Reviewed", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByIDs", mock.Anything, []int64{1}).Return([]activitys.Activity{
+			{ID: 1, UserID: 5, Status: activitys.StatusApproved},
+		}, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			reports(),
+		)
+
+		resp := activityUseCase.Review(context.TODO(), 9, users.RoleManager, activitys.ReviewReq{
+			IDs:    []int64{1},
+			Status: activitys.StatusRejected,
+		})
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+	})
+
+	t.Run("Review Conflict While Saving", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("FindByIDs", mock.Anything, []int64{1, 2}).Return(pending(), nil)
+		activityRepository.On("Review", mock.Anything, []int64{1, 2}, mock.AnythingOfType("activitys.Activity")).Return(exception.ErrConflicted)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			reports(),
+		)
+
+		resp := activityUseCase.Review(context.TODO(), 9, users.RoleManager, activitys.ReviewReq{
+			IDs:    []int64{1, 2},
+			Status: activitys.StatusApproved,
+		})
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+	})
+
+	t.Run("Review Outside Chain Forbidden", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		chain := new(orgMocks.Chain)
+		chain.On("InChain", mock.Anything, int64(9), int64(5)).Return(true, nil)
+		chain.On("InChain", mock.Anything, int64(9), int64(6)).Return(false, nil)
+
+		activityRepository.On("FindByIDs", mock.Anything, []int64{1, 2}).Return(pending(), nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			chain,
+		)
+
+		resp := activityUseCase.Review(context.TODO(), 9, users.RoleManager, activitys.ReviewReq{
+			IDs:    []int64{1, 2},
+			Status: activitys.StatusApproved,
+		})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		activityRepository.AssertNotCalled(t, "Review", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPendingActivity(t *testing.T) {
+	t.Run("Pending Success", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		chain := new(orgMocks.Chain)
+		chain.On("Reports", mock.Anything, int64(9)).Return([]orgs.Member{{UserID: 5}, {UserID: 6}}, nil)
+
+		activityRepository.On("Pending", mock.Anything, mock.MatchedBy(func(p activitys.PendingReq) bool {
+			return p.Limit == 1 && p.Sort == "asc" && len(p.UserIDs) == 2 && p.UserIDs[0] == 5 && p.UserIDs[1] == 6
+		})).Return([]activitys.Activity{{ID: 4, UserID: 5, Status: activitys.StatusPending}}, nil)
+		activityRepository.On("CountPending", mock.Anything, mock.AnythingOfType("activitys.PendingReq")).Return(int64(3), nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			chain,
+		)
+
+		params := activitys.PendingReq{}
+		params.Limit = 1
+
+		resp := activityUseCase.Pending(context.TODO(), 9, users.RoleManager, params)
+
+		assert.NoError(t, resp.Err())
+		page := resp.(*response.ResponseImpl).Page
+		assert.Equal(t, int64(3), page.Total)
+		assert.Equal(t, int64(4), page.NextCursor)
+		activityRepository.AssertExpectations(t)
+	})
+
+	t.Run("Pending Admin Sees Everyone", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		chain := new(orgMocks.Chain)
+
+		activityRepository.On("Pending", mock.Anything, mock.MatchedBy(func(p activitys.PendingReq) bool {
+			return p.UserIDs == nil
+		})).Return([]activitys.Activity{}, nil)
+		activityRepository.On("CountPending", mock.Anything, mock.AnythingOfType("activitys.PendingReq")).Return(int64(0), nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			chain,
+		)
+
+		resp := activityUseCase.Pending(context.TODO(), 1, users.RoleAdmin, activitys.PendingReq{})
+
+		assert.NoError(t, resp.Err())
+		activityRepository.AssertExpectations(t)
+		chain.AssertNotCalled(t, "Reports", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Pending Without Reports", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		chain := new(orgMocks.Chain)
+		chain.On("Reports", mock.Anything, int64(9)).Return([]orgs.Member{}, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			chain,
+		)
+
+		resp := activityUseCase.Pending(context.TODO(), 9, users.RoleManager, activitys.PendingReq{})
+
+		assert.NoError(t, resp.Err())
+		assert.Empty(t, resp.(*response.ResponseImpl).Data)
+		activityRepository.AssertNotCalled(t, "Pending", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Pending Outside Chain Forbidden", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		chain := new(orgMocks.Chain)
+		chain.On("InChain", mock.Anything, int64(9), int64(7)).Return(false, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			chain,
+		)
+
+		resp := activityUseCase.Pending(context.TODO(), 9, users.RoleManager, activitys.PendingReq{UserID: 7})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		activityRepository.AssertNotCalled(t, "Pending", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Pending Employee Forbidden", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.Pending(context.TODO(), 1, users.RoleEmployee, activitys.PendingReq{})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+	})
+}
+
+func TestAddActivities(t *testing.T) {
+	checkin := time.Now().Add(-4 * time.Hour).Truncate(time.Minute)
+	session := absensis.Absensi{
+		ID:      1,
+		UserID:  1,
+		Checkin: checkin,
+	}
+	logged := []activitys.Activity{
+		{ID: 7, UserID: 1, AbsenID: 1, StartedAt: checkin.Add(time.Hour), EndedAt: checkin.Add(2 * time.Hour), Duration: 60},
+	}
+
+	t.Run("Add Activities Success", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+		activityRepository.On("FindBySession", mock.Anything, int64(1)).Return(logged, nil)
+		projectRepository.On("FindByID", mock.Anything, int64(2)).Return(projects.Project{ID: 2, Active: true}, nil).Once()
+		activityRepository.On("AddActivities", mock.Anything, int64(1), mock.MatchedBy(func(items []activitys.Activity) bool {
+			return len(items) == 3 && items[0].AbsenID == 1 && items[0].Duration == 30 && items[2].Tags[0] == "meeting"
+		})).Return([]int64{10, 11, 12}, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.AddActivities(context.TODO(), 1, 1, []activitys.Activity{
+			{Description: "first", ProjectID: 2, StartedAt: checkin, Duration: 30},
+			{Description: "second", ProjectID: 2, StartedAt: checkin.Add(30 * time.Minute), EndedAt: checkin.Add(time.Hour)},
+			{Description: "third", Tags: []string{" Meeting "}},
+		})
+
+		assert.NoError(t, resp.Err())
+		result := resp.(*response.ResponseImpl).Data.(activitys.BulkResult)
+		assert.Len(t, result.Activities, 3)
+		assert.Equal(t, int64(12), result.Activities[2].ID)
+		assert.Equal(t, activitys.StatusPending, result.Activities[0].Status)
+		assert.Empty(t, result.Errors)
+		activityRepository.AssertExpectations(t)
+		projectRepository.AssertExpectations(t)
+	})
+
+	t.Run("Add Activities Reports Every Rejected Item", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+		activityRepository.On("FindBySession", mock.Anything, int64(1)).Return(logged, nil)
+		projectRepository.On("FindByID", mock.Anything, int64(9)).Return(projects.Project{}, exception.ErrNotFound)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.AddActivities(context.TODO(), 1, 1, []activitys.Activity{
+			{Description: "ok", StartedAt: checkin, Duration: 30},
+			{Description: "overlaps first item", StartedAt: checkin.Add(15 * time.Minute), Duration: 30},
+			{Description: "overlaps logged", StartedAt: checkin.Add(90 * time.Minute), Duration: 60},
+			{Description: "missing project", ProjectID: 9},
+			{Description: "before checkin", StartedAt: checkin.Add(-time.Hour), Duration: 30},
+		})
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+		result := resp.(*response.ResponseImpl).Data.(activitys.BulkResult)
+		assert.Equal(t, []activitys.ItemError{
+			{Index: 1, Status: response.StatusConflicted, Reason: exception.ErrConflicted.Error()},
+			{Index: 2, Status: response.StatusConflicted, Reason: exception.ErrConflicted.Error()},
+			{Index: 3, Status: response.StatusNotFound, Reason: exception.ErrNotFound.Error()},
+			{Index: 4, Status: response.StatusBadRequest, Reason: exception.ErrBadRequest.Error()},
+		}, result.Errors)
+		activityRepository.AssertNotCalled(t, "AddActivities", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Add Activities Closed Session", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		closed := session
+		closed.Checkout = time.Now()
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(closed, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.AddActivities(context.TODO(), 1, 1, []activitys.Activity{{Description: "test"}})
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+	})
+
+	t.Run("Add Activities Insert Error", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+		activityRepository.On("FindBySession", mock.Anything, int64(1)).Return([]activitys.Activity{}, nil)
+		activityRepository.On("AddActivities", mock.Anything, int64(1), mock.Anything).Return(nil, exception.ErrInternalServer)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.AddActivities(context.TODO(), 1, 1, []activitys.Activity{{Description: "test"}})
+
+		assert.Equal(t, exception.ErrInternalServer, resp.Err())
+	})
+}
+
+func TestStandup(t *testing.T) {
+	checkin := time.Date(2021, 12, 12, 8, 0, 0, 0, time.UTC)
+	session := absensis.Absensi{
+		ID:      1,
+		UserID:  1,
+		Checkin: checkin,
+	}
+
+	t.Run("Standup Success", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+		activityRepository.On("Riwayat", mock.Anything, int64(1), mock.MatchedBy(func(p activitys.DateReq) bool {
+			return p.AbsenID == 1 && p.Tag == activitys.TagStandup
+		})).Return([]activitys.Activity{}, nil)
+		activityRepository.On("FindBySession", mock.Anything, int64(1)).Return([]activitys.Activity{}, nil)
+		activityRepository.On("AddActivities", mock.Anything, int64(1), mock.MatchedBy(func(items []activitys.Activity) bool {
+			return len(items) == 4 &&
+				items[0].Description == "fixed login" && items[0].Tags[1] == activitys.TagYesterday &&
+				items[3].Tags[1] == activitys.TagBlocker
+		})).Return([]int64{1, 2, 3, 4}, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.Standup(context.TODO(), 1, 1, activitys.StandupReq{
+			Yesterday: []string{" fixed login ", "  "},
+			Today:     []string{"review PR", "write tests"},
+			Blockers:  []string{"waiting for API key"},
+		})
+
+		assert.NoError(t, resp.Err())
+		standup := resp.(*response.ResponseImpl).Data.(activitys.Standup)
+		assert.Equal(t, "2021-12-12", standup.Date)
+		assert.Len(t, standup.Yesterday, 1)
+		assert.Len(t, standup.Today, 2)
+		assert.Len(t, standup.Blockers, 1)
+		assert.Equal(t, int64(4), standup.Blockers[0].ID)
+	})
+
+	t.Run("Standup Already Submitted", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+		activityRepository.On("Riwayat", mock.Anything, int64(1), mock.AnythingOfType("activitys.DateReq")).Return([]activitys.Activity{{ID: 5}}, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.Standup(context.TODO(), 1, 1, activitys.StandupReq{Today: []string{"review PR"}})
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+	})
+
+	t.Run("Standup Without Today", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		absensiRepository.On("FindByID", mock.Anything, int64(1)).Return(session, nil)
+		activityRepository.On("Riwayat", mock.Anything, int64(1), mock.AnythingOfType("activitys.DateReq")).Return([]activitys.Activity{}, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.Standup(context.TODO(), 1, 1, activitys.StandupReq{
+			Yesterday: []string{"fixed login"},
+			Today:     []string{" "},
+		})
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+	})
+}
+
+func TestFindStandup(t *testing.T) {
+	t.Run("Find Standup Success", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("Riwayat", mock.Anything, int64(1), mock.MatchedBy(func(p activitys.DateReq) bool {
+			return p.From == "2021-12-12" && p.To == "2021-12-12" && p.Tag == activitys.TagStandup
+		})).Return([]activitys.Activity{{ID: 1}, {ID: 2}, {ID: 3}}, nil)
+		activityRepository.On("FindTags", mock.Anything, []int64{1, 2, 3}).Return(map[int64][]string{
+			1: {activitys.TagStandup, activitys.TagYesterday},
+			2: {activitys.TagStandup, activitys.TagToday},
+			3: {activitys.TagBlocker, activitys.TagStandup},
+		}, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.FindStandup(context.TODO(), 1, activitys.StandupFilter{Date: "2021-12-12"})
+
+		assert.NoError(t, resp.Err())
+		standup := resp.(*response.ResponseImpl).Data.(activitys.Standup)
+		assert.Equal(t, int64(1), standup.Yesterday[0].ID)
+		assert.Equal(t, int64(2), standup.Today[0].ID)
+		assert.Equal(t, int64(3), standup.Blockers[0].ID)
+	})
+
+	t.Run("Find Standup Not Found", func(t *testing.T) {
+		activityRepository := new(mocks.ActivityRepository)
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		projectRepository := new(projectMocks.ProjectRepository)
+
+		activityRepository.On("Riwayat", mock.Anything, int64(1), mock.AnythingOfType("activitys.DateReq")).Return([]activitys.Activity{}, nil)
+
+		activityUseCase := activity.NewActivityUseCaseImpl(
+			activityRepository,
+			absensiRepository,
+			projectRepository,
+			new(orgMocks.Chain),
+		)
+
+		resp := activityUseCase.FindStandup(context.TODO(), 1, activitys.StandupFilter{Date: "2021-12-12"})
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+	})
 }
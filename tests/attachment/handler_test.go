@@ -0,0 +1,226 @@
+package attachment_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	newJWT "github.com/dgrijalva/jwt-go"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/attachment"
+	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/attachment/mocks"
+)
+
+func newToken(t *testing.T, role string) string {
+	mockToken := &jwt.JWTclaim{
+		ID:    1,
+		Email: "test@test.com",
+		Role:  role,
+		StandardClaims: newJWT.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+		},
+	}
+
+	token, err := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken).SignedString(jwt.JWT_KEY)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestHandler_Upload(t *testing.T) {
+	t.Run("Upload Success", func(t *testing.T) {
+		var body bytes.Buffer
+		form := multipart.NewWriter(&body)
+		part, _ := form.CreateFormFile("file", "screen.png")
+		part.Write(pngContent)
+		form.Close()
+
+		resp := response.Success(response.StatusOK, activitys.Attachment{ID: 7})
+		attachmentUseCase := new(mocks.AttachmentUseCase)
+		attachmentUseCase.On("Upload", mock.Anything, int64(3), int64(1), "screen.png", mock.MatchedBy(func(r io.Reader) bool {
+			content, _ := io.ReadAll(r)
+			return bytes.Equal(content, pngContent)
+		})).Return(resp)
+
+		attachmentHandler := attachment.AttachmentHandler{
+			UseCase: attachmentUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", &body)
+		r = mux.SetURLVars(r, map[string]string{"id": "3"})
+		r.Header.Set("Content-Type", form.FormDataContentType())
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleEmployee),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(attachmentHandler.Upload)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusOK, rb.Status)
+		attachmentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Upload Without File", func(t *testing.T) {
+		attachmentUseCase := new(mocks.AttachmentUseCase)
+
+		attachmentHandler := attachment.AttachmentHandler{
+			UseCase: attachmentUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader([]byte(`{}`)))
+		r = mux.SetURLVars(r, map[string]string{"id": "3"})
+		r.Header.Set("Content-Type", "application/json")
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleEmployee),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(attachmentHandler.Upload)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusUnprocessableEntity, rb.Status)
+		attachmentUseCase.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Upload Unauthorized", func(t *testing.T) {
+		attachmentHandler := attachment.AttachmentHandler{
+			UseCase: new(mocks.AttachmentUseCase),
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", nil)
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(attachmentHandler.Upload)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusUnauthorized, rb.Status)
+	})
+}
+
+func TestHandler_Download(t *testing.T) {
+	t.Run("Download Streams File", func(t *testing.T) {
+		attachmentUseCase := new(mocks.AttachmentUseCase)
+		attachmentUseCase.On("Download", mock.Anything, int64(3), int64(1), int64(1), users.RoleManager, mock.Anything).
+			Run(func(args mock.Arguments) {
+				w := args.Get(5).(attachment.FileWriter)
+				w.SetFile("image/png", "screen.png")
+				w.Write(pngContent)
+			}).
+			Return(response.Success(response.StatusOK, activitys.Attachment{ID: 1}))
+
+		attachmentHandler := attachment.AttachmentHandler{
+			UseCase: attachmentUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": "3", "attachmentID": "1"})
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleManager),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(attachmentHandler.Download)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "image/png", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, "nosniff", recorder.Header().Get("X-Content-Type-Options"))
+		assert.Equal(t, pngContent, recorder.Body.Bytes())
+	})
+
+	t.Run("Download Forbidden", func(t *testing.T) {
+		attachmentUseCase := new(mocks.AttachmentUseCase)
+		attachmentUseCase.On("Download", mock.Anything, int64(3), int64(1), int64(1), users.RoleEmployee, mock.Anything).
+			Return(response.Error(response.StatusForbiddend, exception.ErrForbidden))
+
+		attachmentHandler := attachment.AttachmentHandler{
+			UseCase: attachmentUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": "3", "attachmentID": "1"})
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleEmployee),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(attachmentHandler.Download)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusForbiddend, rb.Status)
+	})
+}
+
+func TestHandler_Delete(t *testing.T) {
+	attachmentUseCase := new(mocks.AttachmentUseCase)
+	attachmentUseCase.On("Delete", mock.Anything, int64(3), int64(1), int64(1)).Return(response.Success(response.StatusOK, "Berhasil Menghapus Lampiran"))
+
+	attachmentHandler := attachment.AttachmentHandler{
+		UseCase: attachmentUseCase,
+	}
+
+	r := httptest.NewRequest(http.MethodDelete, "/just/for/testing", nil)
+	r = mux.SetURLVars(r, map[string]string{"id": "3", "attachmentID": "1"})
+	r.AddCookie(&http.Cookie{
+		Name:  "token",
+		Value: newToken(t, users.RoleEmployee),
+	})
+	recorder := httptest.NewRecorder()
+
+	handler := http.HandlerFunc(attachmentHandler.Delete)
+	handler.ServeHTTP(recorder, r)
+
+	rb := response.ResponseImpl{}
+	if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+		t.Error(err)
+		return
+	}
+
+	assert.Equal(t, response.StatusOK, rb.Status)
+	attachmentUseCase.AssertExpectations(t)
+}
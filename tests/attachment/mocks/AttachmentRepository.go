@@ -0,0 +1,110 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	activitys "github.com/Risuii/models/activitys"
+
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttachmentRepository is an autogenerated mock type for the AttachmentRepository type
+type AttachmentRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, params
+func (_m *AttachmentRepository) Create(ctx context.Context, params activitys.Attachment) (int64, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, activitys.Attachment) int64); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, activitys.Attachment) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *AttachmentRepository) Delete(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByActivity provides a mock function with given fields: ctx, activityID
+func (_m *AttachmentRepository) FindByActivity(ctx context.Context, activityID int64) ([]activitys.Attachment, error) {
+	ret := _m.Called(ctx, activityID)
+
+	var r0 []activitys.Attachment
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []activitys.Attachment); ok {
+		r0 = rf(ctx, activityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]activitys.Attachment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, activityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *AttachmentRepository) FindByID(ctx context.Context, id int64) (activitys.Attachment, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 activitys.Attachment
+	if rf, ok := ret.Get(0).(func(context.Context, int64) activitys.Attachment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(activitys.Attachment)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewAttachmentRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAttachmentRepository creates a new instance of AttachmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAttachmentRepository(t mockConstructorTestingTNewAttachmentRepository) *AttachmentRepository {
+	mock := &AttachmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
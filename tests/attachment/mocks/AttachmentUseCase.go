@@ -0,0 +1,96 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	response "github.com/Risuii/helpers/response"
+	attachment "github.com/Risuii/internal/attachment"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttachmentUseCase is an autogenerated mock type for the AttachmentUseCase type
+type AttachmentUseCase struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, activityID, id, userID
+func (_m *AttachmentUseCase) Delete(ctx context.Context, activityID int64, id int64, userID int64) response.Response {
+	ret := _m.Called(ctx, activityID, id, userID)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) response.Response); ok {
+		r0 = rf(ctx, activityID, id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Download provides a mock function with given fields: ctx, activityID, id, userID, role, w
+func (_m *AttachmentUseCase) Download(ctx context.Context, activityID int64, id int64, userID int64, role string, w attachment.FileWriter) response.Response {
+	ret := _m.Called(ctx, activityID, id, userID, role, w)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64, string, attachment.FileWriter) response.Response); ok {
+		r0 = rf(ctx, activityID, id, userID, role, w)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: ctx, activityID, userID, role
+func (_m *AttachmentUseCase) List(ctx context.Context, activityID int64, userID int64, role string) response.Response {
+	ret := _m.Called(ctx, activityID, userID, role)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string) response.Response); ok {
+		r0 = rf(ctx, activityID, userID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Upload provides a mock function with given fields: ctx, activityID, userID, fileName, file
+func (_m *AttachmentUseCase) Upload(ctx context.Context, activityID int64, userID int64, fileName string, file io.Reader) response.Response {
+	ret := _m.Called(ctx, activityID, userID, fileName, file)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string, io.Reader) response.Response); ok {
+		r0 = rf(ctx, activityID, userID, fileName, file)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewAttachmentUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAttachmentUseCase creates a new instance of AttachmentUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAttachmentUseCase(t mockConstructorTestingTNewAttachmentUseCase) *AttachmentUseCase {
+	mock := &AttachmentUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,145 @@
+package attachment_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/internal/attachment"
+	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/tests/mock"
+)
+
+var currentTime = time.Date(2021, 12, 12, 0, 0, 0, 0, time.UTC)
+var attachmentStruct = activitys.Attachment{
+	ID:          1,
+	ActivityID:  3,
+	FileName:    "screenshot.png",
+	ContentType: "image/png",
+	Size:        120,
+	Key:         "3/abc.png",
+	UploadedBy:  1,
+	CreatedAt:   currentTime,
+}
+
+func TestCreateRepo(t *testing.T) {
+	t.Run("Create Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := attachment.NewAttachmentRepositoryImpl(db, constant.TableAttachment)
+
+		defer db.Close()
+
+		query := regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO %s (activityID, file_name, content_type, size, storage_key, uploaded_by, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)`, constant.TableAttachment))
+
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(attachmentStruct.ActivityID, attachmentStruct.FileName, attachmentStruct.ContentType, attachmentStruct.Size, attachmentStruct.Key, attachmentStruct.UploadedBy, attachmentStruct.CreatedAt).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		ID, err := repo.Create(context.TODO(), attachmentStruct)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), ID)
+	})
+
+	t.Run("Create Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := attachment.NewAttachmentRepositoryImpl(db, constant.TableAttachment)
+
+		defer db.Close()
+
+		mock.ExpectPrepare(regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO %s`, constant.TableAttachment))).ExpectExec().WillReturnError(fmt.Errorf("insert failed"))
+
+		_, err := repo.Create(context.TODO(), attachmentStruct)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestFindByIDRepo(t *testing.T) {
+	query := regexp.QuoteMeta(fmt.Sprintf(`SELECT id, activityID, file_name, content_type, size, storage_key, uploaded_by, created_at FROM %s WHERE id = ?`, constant.TableAttachment))
+
+	t.Run("FindByID Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := attachment.NewAttachmentRepositoryImpl(db, constant.TableAttachment)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "activityID", "file_name", "content_type", "size", "storage_key", "uploaded_by", "created_at"}).
+			AddRow(attachmentStruct.ID, attachmentStruct.ActivityID, attachmentStruct.FileName, attachmentStruct.ContentType, attachmentStruct.Size, attachmentStruct.Key, attachmentStruct.UploadedBy, attachmentStruct.CreatedAt)
+
+		mock.ExpectPrepare(query).ExpectQuery().WithArgs(attachmentStruct.ID).WillReturnRows(rows)
+
+		result, err := repo.FindByID(context.TODO(), attachmentStruct.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, attachmentStruct, result)
+	})
+
+	t.Run("FindByID Not Found", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := attachment.NewAttachmentRepositoryImpl(db, constant.TableAttachment)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "activityID", "file_name", "content_type", "size", "storage_key", "uploaded_by", "created_at"})
+
+		mock.ExpectPrepare(query).ExpectQuery().WithArgs(attachmentStruct.ID).WillReturnRows(rows)
+
+		_, err := repo.FindByID(context.TODO(), attachmentStruct.ID)
+
+		assert.Equal(t, exception.ErrNotFound, err)
+	})
+}
+
+func TestFindByActivityRepo(t *testing.T) {
+	db, mock := mock.NewMock()
+	repo := attachment.NewAttachmentRepositoryImpl(db, constant.TableAttachment)
+
+	defer db.Close()
+
+	query := regexp.QuoteMeta(fmt.Sprintf(`SELECT id, activityID, file_name, content_type, size, storage_key, uploaded_by, created_at FROM %s WHERE activityID = ? ORDER BY id`, constant.TableAttachment))
+	rows := sqlmock.NewRows([]string{"id", "activityID", "file_name", "content_type", "size", "storage_key", "uploaded_by", "created_at"}).
+		AddRow(attachmentStruct.ID, attachmentStruct.ActivityID, attachmentStruct.FileName, attachmentStruct.ContentType, attachmentStruct.Size, attachmentStruct.Key, attachmentStruct.UploadedBy, attachmentStruct.CreatedAt)
+
+	mock.ExpectQuery(query).WithArgs(attachmentStruct.ActivityID).WillReturnRows(rows)
+
+	result, err := repo.FindByActivity(context.TODO(), attachmentStruct.ActivityID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []activitys.Attachment{attachmentStruct}, result)
+}
+
+func TestDeleteRepo(t *testing.T) {
+	query := regexp.QuoteMeta(fmt.Sprintf(`DELETE FROM %s WHERE id = ?`, constant.TableAttachment))
+
+	t.Run("Delete Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := attachment.NewAttachmentRepositoryImpl(db, constant.TableAttachment)
+
+		defer db.Close()
+
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(attachmentStruct.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Delete(context.TODO(), attachmentStruct.ID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Delete Not Found", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := attachment.NewAttachmentRepositoryImpl(db, constant.TableAttachment)
+
+		defer db.Close()
+
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(attachmentStruct.ID).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Delete(context.TODO(), attachmentStruct.ID)
+
+		assert.Equal(t, exception.ErrNotFound, err)
+	})
+}
@@ -0,0 +1,69 @@
+package attachment_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/internal/attachment"
+)
+
+func TestLocalStorage(t *testing.T) {
+	t.Run("Save Open Delete", func(t *testing.T) {
+		dir := t.TempDir()
+		storage := attachment.NewLocalStorage(dir)
+		ctx := context.TODO()
+
+		size, err := storage.Save(ctx, "1/abc.txt", strings.NewReader("hello"))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), size)
+
+		file, err := storage.Open(ctx, "1/abc.txt")
+		assert.NoError(t, err)
+		content, _ := io.ReadAll(file)
+		file.Close()
+		assert.Equal(t, "hello", string(content))
+
+		assert.NoError(t, storage.Delete(ctx, "1/abc.txt"))
+		_, err = os.Stat(filepath.Join(dir, "1", "abc.txt"))
+		assert.True(t, os.IsNotExist(err))
+
+		// deleting twice is not an error
+		assert.NoError(t, storage.Delete(ctx, "1/abc.txt"))
+	})
+
+	t.Run("Save Leaves No Partial File", func(t *testing.T) {
+		dir := t.TempDir()
+		storage := attachment.NewLocalStorage(dir)
+
+		_, err := storage.Save(context.TODO(), "1/abc.txt", io.MultiReader(strings.NewReader("hel"), errReader{}))
+		assert.Error(t, err)
+
+		entries, _ := os.ReadDir(filepath.Join(dir, "1"))
+		assert.Empty(t, entries)
+	})
+
+	t.Run("Invalid Key", func(t *testing.T) {
+		storage := attachment.NewLocalStorage(t.TempDir())
+		ctx := context.TODO()
+
+		for _, key := range []string{"", "/etc/passwd", "../secret", "1/../../secret", "."} {
+			_, err := storage.Save(ctx, key, strings.NewReader("x"))
+			assert.ErrorIs(t, err, attachment.ErrInvalidKey, key)
+
+			_, err = storage.Open(ctx, key)
+			assert.ErrorIs(t, err, attachment.ErrInvalidKey, key)
+		}
+	})
+}
+
+type errReader struct{}
+
+func (errReader) Read(p []byte) (int, error) {
+	return 0, io.ErrClosedPipe
+}
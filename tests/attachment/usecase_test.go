@@ -0,0 +1,357 @@
+package attachment_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/export"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/attachment"
+	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/users"
+	activityMocks "github.com/Risuii/tests/activity/mocks"
+	"github.com/Risuii/tests/attachment/mocks"
+	orgMocks "github.com/Risuii/tests/org/mocks"
+)
+
+var pngContent = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 64)...)
+
+func TestUpload(t *testing.T) {
+	owned := activitys.Activity{ID: 3, UserID: 1, Status: activitys.StatusPending}
+
+	t.Run("Upload Success", func(t *testing.T) {
+		dir := t.TempDir()
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(owned, nil)
+		attachmentRepository.On("FindByActivity", mock.Anything, int64(3)).Return([]activitys.Attachment{}, nil)
+		attachmentRepository.On("Create", mock.Anything, mock.MatchedBy(func(a activitys.Attachment) bool {
+			return a.ActivityID == 3 && a.FileName == "screen.png" && a.ContentType == "image/png" &&
+				a.Size == int64(len(pngContent)) && strings.HasPrefix(a.Key, "3/") && strings.HasSuffix(a.Key, ".png") && a.UploadedBy == 1
+		})).Return(int64(7), nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, attachment.NewLocalStorage(dir), new(orgMocks.Chain), 1<<20)
+
+		resp := attachmentUseCase.Upload(context.TODO(), 3, 1, `C:\Users\me\screen.png`, bytes.NewReader(pngContent))
+
+		assert.NoError(t, resp.Err())
+		upload := resp.(*response.ResponseImpl).Data.(activitys.Attachment)
+		assert.Equal(t, int64(7), upload.ID)
+
+		stored, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(upload.Key)))
+		assert.NoError(t, err)
+		assert.Equal(t, pngContent, stored)
+		attachmentRepository.AssertExpectations(t)
+	})
+
+	t.Run("Upload Office Document", func(t *testing.T) {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		w, _ := zw.Create("word/document.xml")
+		w.Write([]byte("<w:document/>"))
+		zw.Close()
+
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(owned, nil)
+		attachmentRepository.On("FindByActivity", mock.Anything, int64(3)).Return([]activitys.Attachment{}, nil)
+		attachmentRepository.On("Create", mock.Anything, mock.MatchedBy(func(a activitys.Attachment) bool {
+			return strings.HasSuffix(a.Key, ".docx") && strings.Contains(a.ContentType, "wordprocessingml")
+		})).Return(int64(8), nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, attachment.NewLocalStorage(t.TempDir()), new(orgMocks.Chain), 1<<20)
+
+		resp := attachmentUseCase.Upload(context.TODO(), 3, 1, "notes.docx", &buf)
+
+		assert.NoError(t, resp.Err())
+	})
+
+	t.Run("Upload Type Not Allowed", func(t *testing.T) {
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+		storage := new(mocks.Storage)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(owned, nil)
+		attachmentRepository.On("FindByActivity", mock.Anything, int64(3)).Return([]activitys.Attachment{}, nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, storage, new(orgMocks.Chain), 1<<20)
+
+		resp := attachmentUseCase.Upload(context.TODO(), 3, 1, "run.exe", bytes.NewReader([]byte("MZ\x90\x00\x03\x00\x00\x00")))
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+		storage.AssertNotCalled(t, "Save", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Upload Too Large", func(t *testing.T) {
+		dir := t.TempDir()
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(owned, nil)
+		attachmentRepository.On("FindByActivity", mock.Anything, int64(3)).Return([]activitys.Attachment{}, nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, attachment.NewLocalStorage(dir), new(orgMocks.Chain), 32)
+
+		resp := attachmentUseCase.Upload(context.TODO(), 3, 1, "screen.png", bytes.NewReader(pngContent))
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+		attachmentRepository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+
+		entries, _ := os.ReadDir(filepath.Join(dir, "3"))
+		assert.Empty(t, entries)
+	})
+
+	t.Run("Upload Empty File", func(t *testing.T) {
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(owned, nil)
+		attachmentRepository.On("FindByActivity", mock.Anything, int64(3)).Return([]activitys.Attachment{}, nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, new(mocks.Storage), new(orgMocks.Chain), 1<<20)
+
+		resp := attachmentUseCase.Upload(context.TODO(), 3, 1, "empty.txt", bytes.NewReader(nil))
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+	})
+
+	t.Run("Upload Too Many Attachments", func(t *testing.T) {
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(owned, nil)
+		attachmentRepository.On("FindByActivity", mock.Anything, int64(3)).Return(make([]activitys.Attachment, attachment.MaxAttachments), nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, new(mocks.Storage), new(orgMocks.Chain), 1<<20)
+
+		resp := attachmentUseCase.Upload(context.TODO(), 3, 1, "screen.png", bytes.NewReader(pngContent))
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+	})
+
+	t.Run("Upload Other User", func(t *testing.T) {
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(owned, nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, new(mocks.Storage), new(orgMocks.Chain), 1<<20)
+
+		resp := attachmentUseCase.Upload(context.TODO(), 3, 2, "screen.png", bytes.NewReader(pngContent))
+
+		assert.Equal(t, exception.ErrUnauthorized, resp.Err())
+	})
+
+	t.Run("Upload Approved Activity", func(t *testing.T) {
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1, Status: activitys.StatusApproved}, nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, new(mocks.Storage), new(orgMocks.Chain), 1<<20)
+
+		resp := attachmentUseCase.Upload(context.TODO(), 3, 1, "screen.png", bytes.NewReader(pngContent))
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+	})
+
+	t.Run("Upload Create Error Removes File", func(t *testing.T) {
+		dir := t.TempDir()
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(owned, nil)
+		attachmentRepository.On("FindByActivity", mock.Anything, int64(3)).Return([]activitys.Attachment{}, nil)
+		attachmentRepository.On("Create", mock.Anything, mock.AnythingOfType("activitys.Attachment")).Return(int64(0), exception.ErrInternalServer)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, attachment.NewLocalStorage(dir), new(orgMocks.Chain), 1<<20)
+
+		resp := attachmentUseCase.Upload(context.TODO(), 3, 1, "screen.png", bytes.NewReader(pngContent))
+
+		assert.Equal(t, exception.ErrInternalServer, resp.Err())
+
+		entries, _ := os.ReadDir(filepath.Join(dir, "3"))
+		assert.Empty(t, entries)
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("List Manager", func(t *testing.T) {
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+		chain := new(orgMocks.Chain)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1}, nil)
+		attachmentRepository.On("FindByActivity", mock.Anything, int64(3)).Return([]activitys.Attachment{attachmentStruct}, nil)
+		chain.On("InChain", mock.Anything, int64(9), int64(1)).Return(true, nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, new(mocks.Storage), chain, 1<<20)
+
+		resp := attachmentUseCase.List(context.TODO(), 3, 9, users.RoleManager)
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, []activitys.Attachment{attachmentStruct}, resp.(*response.ResponseImpl).Data)
+		chain.AssertExpectations(t)
+	})
+
+	t.Run("List Manager Outside Chain", func(t *testing.T) {
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+		chain := new(orgMocks.Chain)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1}, nil)
+		chain.On("InChain", mock.Anything, int64(9), int64(1)).Return(false, nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, new(mocks.Storage), chain, 1<<20)
+
+		resp := attachmentUseCase.List(context.TODO(), 3, 9, users.RoleManager)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		attachmentRepository.AssertNotCalled(t, "FindByActivity", mock.Anything, mock.Anything)
+	})
+
+	t.Run("List Other Employee", func(t *testing.T) {
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1}, nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, new(mocks.Storage), new(orgMocks.Chain), 1<<20)
+
+		resp := attachmentUseCase.List(context.TODO(), 3, 2, users.RoleEmployee)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+	})
+
+	t.Run("List Deleted Activity", func(t *testing.T) {
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1, DeletedAt: currentTime}, nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, new(mocks.Storage), new(orgMocks.Chain), 1<<20)
+
+		resp := attachmentUseCase.List(context.TODO(), 3, 1, users.RoleEmployee)
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+	})
+}
+
+func TestDownload(t *testing.T) {
+	t.Run("Download Success", func(t *testing.T) {
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+		storage := new(mocks.Storage)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1}, nil)
+		attachmentRepository.On("FindByID", mock.Anything, int64(1)).Return(attachmentStruct, nil)
+		storage.On("Open", mock.Anything, attachmentStruct.Key).Return(io.NopCloser(bytes.NewReader(pngContent)), nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, storage, new(orgMocks.Chain), 1<<20)
+
+		recorder := httptest.NewRecorder()
+		file := export.NewFileWriter(recorder, "application/octet-stream", "attachment")
+
+		resp := attachmentUseCase.Download(context.TODO(), 3, 1, 1, users.RoleEmployee, file)
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, "image/png", recorder.Header().Get("Content-Type"))
+		assert.Contains(t, recorder.Header().Get("Content-Disposition"), "screenshot.png")
+		assert.Equal(t, pngContent, recorder.Body.Bytes())
+	})
+
+	t.Run("Download Attachment Of Other Activity", func(t *testing.T) {
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+		storage := new(mocks.Storage)
+
+		activityRepository.On("FindByID", mock.Anything, int64(4)).Return(activitys.Activity{ID: 4, UserID: 1}, nil)
+		attachmentRepository.On("FindByID", mock.Anything, int64(1)).Return(attachmentStruct, nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, storage, new(orgMocks.Chain), 1<<20)
+
+		file := export.NewFileWriter(httptest.NewRecorder(), "application/octet-stream", "attachment")
+
+		resp := attachmentUseCase.Download(context.TODO(), 4, 1, 1, users.RoleEmployee, file)
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+		assert.False(t, file.Started())
+		storage.AssertNotCalled(t, "Open", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Download Other Employee", func(t *testing.T) {
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1}, nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, new(mocks.Storage), new(orgMocks.Chain), 1<<20)
+
+		file := export.NewFileWriter(httptest.NewRecorder(), "application/octet-stream", "attachment")
+
+		resp := attachmentUseCase.Download(context.TODO(), 3, 1, 2, users.RoleEmployee, file)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		assert.False(t, file.Started())
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("Delete Success", func(t *testing.T) {
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+		storage := new(mocks.Storage)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1}, nil)
+		attachmentRepository.On("FindByID", mock.Anything, int64(1)).Return(attachmentStruct, nil)
+		attachmentRepository.On("Delete", mock.Anything, int64(1)).Return(nil)
+		storage.On("Delete", mock.Anything, attachmentStruct.Key).Return(nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, storage, new(orgMocks.Chain), 1<<20)
+
+		resp := attachmentUseCase.Delete(context.TODO(), 3, 1, 1)
+
+		assert.NoError(t, resp.Err())
+		storage.AssertExpectations(t)
+	})
+
+	t.Run("Delete Manager Not Owner", func(t *testing.T) {
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1}, nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, new(mocks.Storage), new(orgMocks.Chain), 1<<20)
+
+		resp := attachmentUseCase.Delete(context.TODO(), 3, 1, 9)
+
+		assert.Equal(t, exception.ErrUnauthorized, resp.Err())
+		attachmentRepository.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Delete Approved Activity", func(t *testing.T) {
+		attachmentRepository := new(mocks.AttachmentRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		activityRepository.On("FindByID", mock.Anything, int64(3)).Return(activitys.Activity{ID: 3, UserID: 1, Status: activitys.StatusApproved}, nil)
+
+		attachmentUseCase := attachment.NewAttachmentUseCaseImpl(attachmentRepository, activityRepository, new(mocks.Storage), new(orgMocks.Chain), 1<<20)
+
+		resp := attachmentUseCase.Delete(context.TODO(), 3, 1, 1)
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+	})
+}
@@ -0,0 +1,173 @@
+package company_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	newJWT "github.com/dgrijalva/jwt-go"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/company"
+	"github.com/Risuii/models/companies"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/company/mocks"
+)
+
+func newToken(t *testing.T, role string, key []byte) string {
+	mockToken := &jwt.JWTclaim{
+		ID:        1,
+		CompanyID: 2,
+		Timezone:  "Asia/Makassar",
+		Email:     "test@test.com",
+		Role:      role,
+		StandardClaims: newJWT.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+		},
+	}
+
+	token, err := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestHandler_Get(t *testing.T) {
+	t.Run("Get Success", func(t *testing.T) {
+		companyUseCase := new(mocks.CompanyUseCase)
+		companyUseCase.On("Get", mock.Anything).Return(response.Success(response.StatusOK, companyStruct))
+
+		companyHandler := company.CompanyHandler{
+			Validate: validator.New(),
+			UseCase:  companyUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", nil)
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t, users.RoleEmployee, jwt.JWT_KEY)})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(companyHandler.Get)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		companyUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Get Without Token", func(t *testing.T) {
+		companyHandler := company.CompanyHandler{
+			Validate: validator.New(),
+			UseCase:  new(mocks.CompanyUseCase),
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", nil)
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(companyHandler.Get)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+}
+
+func TestHandler_Update(t *testing.T) {
+	t.Run("Update Success", func(t *testing.T) {
+		params := companies.CompanyReq{Name: "Acme", Timezone: "Asia/Jayapura"}
+		newReq, _ := json.Marshal(params)
+
+		companyUseCase := new(mocks.CompanyUseCase)
+		companyUseCase.On("Update", mock.Anything, users.RoleAdmin, params).Return(response.Success(response.StatusOK, companyStruct))
+
+		companyHandler := company.CompanyHandler{
+			Validate: validator.New(),
+			UseCase:  companyUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPatch, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t, users.RoleAdmin, jwt.JWT_KEY)})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(companyHandler.Update)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		companyUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Update Unknown Timezone", func(t *testing.T) {
+		newReq, _ := json.Marshal(companies.CompanyReq{Name: "Acme", Timezone: "Mars/Olympus"})
+
+		companyHandler := company.CompanyHandler{
+			Validate: validator.New(),
+			UseCase:  new(mocks.CompanyUseCase),
+		}
+
+		r := httptest.NewRequest(http.MethodPatch, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t, users.RoleAdmin, jwt.JWT_KEY)})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(companyHandler.Update)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestTenantMiddleware(t *testing.T) {
+	serve := func(r *http.Request) (int64, string) {
+		var id int64
+		var loc string
+
+		tenant.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id = tenant.ID(r.Context())
+			loc = tenant.Location(r.Context()).String()
+		})).ServeHTTP(httptest.NewRecorder(), r)
+
+		return id, loc
+	}
+
+	t.Run("Company From Token", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", nil)
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t, users.RoleEmployee, jwt.JWT_KEY)})
+
+		id, loc := serve(r)
+
+		assert.Equal(t, int64(2), id)
+		assert.Equal(t, "Asia/Makassar", loc)
+	})
+
+	t.Run("Company From Checkin Token", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", nil)
+		r.AddCookie(&http.Cookie{Name: "checkin-token", Value: newToken(t, "", jwt.JWT_KEY)})
+
+		id, _ := serve(r)
+
+		assert.Equal(t, int64(2), id)
+	})
+
+	t.Run("Forged Token Gets No Company", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", nil)
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t, users.RoleAdmin, []byte("forged"))})
+
+		id, loc := serve(r)
+
+		assert.Equal(t, int64(0), id)
+		assert.Equal(t, time.Local.String(), loc)
+	})
+
+	t.Run("No Token Gets No Company", func(t *testing.T) {
+		id, _ := serve(httptest.NewRequest(http.MethodGet, "/just/for/testing", nil))
+
+		assert.Equal(t, int64(0), id)
+	})
+}
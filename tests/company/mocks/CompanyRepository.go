@@ -0,0 +1,110 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	companies "github.com/Risuii/models/companies"
+
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CompanyRepository is an autogenerated mock type for the CompanyRepository type
+type CompanyRepository struct {
+	mock.Mock
+}
+
+// FindAll provides a mock function with given fields: ctx
+func (_m *CompanyRepository) FindAll(ctx context.Context) ([]companies.Company, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []companies.Company
+	if rf, ok := ret.Get(0).(func(context.Context) []companies.Company); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]companies.Company)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByCode provides a mock function with given fields: ctx, code
+func (_m *CompanyRepository) FindByCode(ctx context.Context, code string) (companies.Company, error) {
+	ret := _m.Called(ctx, code)
+
+	var r0 companies.Company
+	if rf, ok := ret.Get(0).(func(context.Context, string) companies.Company); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Get(0).(companies.Company)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *CompanyRepository) FindByID(ctx context.Context, id int64) (companies.Company, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 companies.Company
+	if rf, ok := ret.Get(0).(func(context.Context, int64) companies.Company); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(companies.Company)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, params
+func (_m *CompanyRepository) Update(ctx context.Context, id int64, params companies.Company) error {
+	ret := _m.Called(ctx, id, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, companies.Company) error); ok {
+		r0 = rf(ctx, id, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewCompanyRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCompanyRepository creates a new instance of CompanyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCompanyRepository(t mockConstructorTestingTNewCompanyRepository) *CompanyRepository {
+	mock := &CompanyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
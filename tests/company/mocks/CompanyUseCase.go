@@ -0,0 +1,64 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	companies "github.com/Risuii/models/companies"
+
+	context "context"
+
+	response "github.com/Risuii/helpers/response"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CompanyUseCase is an autogenerated mock type for the CompanyUseCase type
+type CompanyUseCase struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx
+func (_m *CompanyUseCase) Get(ctx context.Context) response.Response {
+	ret := _m.Called(ctx)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context) response.Response); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, role, params
+func (_m *CompanyUseCase) Update(ctx context.Context, role string, params companies.CompanyReq) response.Response {
+	ret := _m.Called(ctx, role, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, companies.CompanyReq) response.Response); ok {
+		r0 = rf(ctx, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewCompanyUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCompanyUseCase creates a new instance of CompanyUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCompanyUseCase(t mockConstructorTestingTNewCompanyUseCase) *CompanyUseCase {
+	mock := &CompanyUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
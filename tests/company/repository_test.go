@@ -0,0 +1,132 @@
+package company_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/internal/company"
+	"github.com/Risuii/models/companies"
+	"github.com/Risuii/tests/mock"
+)
+
+var currentTime = time.Date(2021, 12, 12, 0, 0, 0, 0, &time.Location{})
+var companyStruct = companies.Company{
+	ID:        2,
+	Code:      "acme",
+	Name:      "Acme",
+	Timezone:  "Asia/Makassar",
+	CreatedAt: currentTime,
+}
+var companyColumns = []string{"ID", "code", "name", "timezone", "created_at"}
+
+func TestFindByIDRepo(t *testing.T) {
+	query := regexp.QuoteMeta(fmt.Sprintf(`SELECT ID, code, name, timezone, created_at FROM %s WHERE ID = ?`, constant.TableCompany))
+
+	t.Run("FindByID Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := company.NewCompanyRepositoryImpl(db, constant.TableCompany)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows(companyColumns).AddRow(companyStruct.ID, companyStruct.Code, companyStruct.Name, companyStruct.Timezone, companyStruct.CreatedAt)
+
+		mock.ExpectPrepare(query).ExpectQuery().WithArgs(int64(2)).WillReturnRows(rows)
+
+		res, err := repo.FindByID(context.TODO(), 2)
+
+		assert.Equal(t, companyStruct, res)
+		assert.NoError(t, err)
+	})
+
+	t.Run("FindByID Not Found", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := company.NewCompanyRepositoryImpl(db, constant.TableCompany)
+
+		defer db.Close()
+
+		mock.ExpectPrepare(query).ExpectQuery().WithArgs(int64(2)).WillReturnRows(sqlmock.NewRows(companyColumns))
+
+		_, err := repo.FindByID(context.TODO(), 2)
+
+		assert.Equal(t, exception.ErrNotFound, err)
+	})
+}
+
+func TestFindByCodeRepo(t *testing.T) {
+	t.Run("FindByCode Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := company.NewCompanyRepositoryImpl(db, constant.TableCompany)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT ID, code, name, timezone, created_at FROM %s WHERE code = ?`, constant.TableCompany)
+		rows := sqlmock.NewRows(companyColumns).AddRow(companyStruct.ID, companyStruct.Code, companyStruct.Name, companyStruct.Timezone, companyStruct.CreatedAt)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs("acme").WillReturnRows(rows)
+
+		res, err := repo.FindByCode(context.TODO(), "acme")
+
+		assert.Equal(t, int64(2), res.ID)
+		assert.NoError(t, err)
+	})
+}
+
+func TestFindAllRepo(t *testing.T) {
+	query := regexp.QuoteMeta(fmt.Sprintf(`SELECT ID, code, name, timezone, created_at FROM %s ORDER BY ID`, constant.TableCompany))
+
+	t.Run("FindAll Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := company.NewCompanyRepositoryImpl(db, constant.TableCompany)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows(companyColumns).
+			AddRow(1, "default", "Default", "Asia/Jakarta", currentTime).
+			AddRow(companyStruct.ID, companyStruct.Code, companyStruct.Name, companyStruct.Timezone, companyStruct.CreatedAt)
+
+		mock.ExpectQuery(query).WillReturnRows(rows)
+
+		res, err := repo.FindAll(context.TODO())
+
+		assert.Len(t, res, 2)
+		assert.NoError(t, err)
+	})
+
+	t.Run("FindAll Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := company.NewCompanyRepositoryImpl(db, constant.TableCompany)
+
+		defer db.Close()
+
+		mock.ExpectQuery(query).WillReturnError(fmt.Errorf("error"))
+
+		_, err := repo.FindAll(context.TODO())
+
+		assert.Equal(t, exception.ErrInternalServer, err)
+	})
+}
+
+func TestUpdateRepo(t *testing.T) {
+	t.Run("Update Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := company.NewCompanyRepositoryImpl(db, constant.TableCompany)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`UPDATE %s SET name = ?, timezone = ? WHERE ID = ?`, constant.TableCompany)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectExec().WithArgs(companyStruct.Name, companyStruct.Timezone, companyStruct.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Update(context.TODO(), companyStruct.ID, companyStruct)
+
+		assert.NoError(t, err)
+	})
+}
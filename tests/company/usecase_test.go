@@ -0,0 +1,109 @@
+package company_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/company"
+	"github.com/Risuii/models/companies"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/company/mocks"
+)
+
+func TestGet(t *testing.T) {
+	t.Run("Get Own Company", func(t *testing.T) {
+		companyRepository := new(mocks.CompanyRepository)
+		companyRepository.On("FindByID", mock.Anything, int64(2)).Return(companyStruct, nil)
+
+		resp := company.NewCompanyUseCase(companyRepository).Get(tenant.WithID(context.TODO(), 2))
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, companyStruct, resp.(*response.ResponseImpl).Data)
+		companyRepository.AssertExpectations(t)
+	})
+
+	t.Run("Get Without Company", func(t *testing.T) {
+		companyRepository := new(mocks.CompanyRepository)
+		companyRepository.On("FindByID", mock.Anything, int64(0)).Return(companies.Company{}, exception.ErrNotFound)
+
+		resp := company.NewCompanyUseCase(companyRepository).Get(context.TODO())
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	params := companies.CompanyReq{Name: "Acme Corp", Timezone: "Asia/Jayapura"}
+
+	t.Run("Update Success", func(t *testing.T) {
+		companyRepository := new(mocks.CompanyRepository)
+		companyRepository.On("FindByID", mock.Anything, int64(2)).Return(companyStruct, nil)
+		companyRepository.On("Update", mock.Anything, int64(2), mock.MatchedBy(func(c companies.Company) bool {
+			return c.Name == "Acme Corp" && c.Timezone == "Asia/Jayapura" && c.Code == "acme"
+		})).Return(nil)
+
+		resp := company.NewCompanyUseCase(companyRepository).Update(tenant.WithID(context.TODO(), 2), users.RoleAdmin, params)
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, "Asia/Jayapura", resp.(*response.ResponseImpl).Data.(companies.Company).Location().String())
+		companyRepository.AssertExpectations(t)
+	})
+
+	t.Run("Update Forbidden For Manager", func(t *testing.T) {
+		companyRepository := new(mocks.CompanyRepository)
+
+		resp := company.NewCompanyUseCase(companyRepository).Update(tenant.WithID(context.TODO(), 2), users.RoleManager, params)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		companyRepository.AssertExpectations(t)
+	})
+
+	t.Run("Update Error Internal Server", func(t *testing.T) {
+		companyRepository := new(mocks.CompanyRepository)
+		companyRepository.On("FindByID", mock.Anything, int64(2)).Return(companyStruct, nil)
+		companyRepository.On("Update", mock.Anything, int64(2), mock.Anything).Return(exception.ErrInternalServer)
+
+		resp := company.NewCompanyUseCase(companyRepository).Update(tenant.WithID(context.TODO(), 2), users.RoleAdmin, params)
+
+		assert.Equal(t, exception.ErrInternalServer, resp.Err())
+	})
+}
+
+func TestEach(t *testing.T) {
+	t.Run("Each Company In Its Timezone", func(t *testing.T) {
+		companyRepository := new(mocks.CompanyRepository)
+		companyRepository.On("FindAll", mock.Anything).Return([]companies.Company{
+			{ID: 1, Timezone: "Asia/Jakarta"},
+			{ID: 2, Timezone: "Asia/Jayapura"},
+		}, nil)
+
+		now := time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC)
+		seen := map[int64]int{}
+
+		err := company.Each(context.TODO(), companyRepository, now, func(ctx context.Context, at time.Time) {
+			seen[tenant.ID(ctx)] = at.Hour()
+			assert.Equal(t, at.Location(), tenant.Location(ctx))
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[int64]int{1: 17, 2: 19}, seen)
+	})
+
+	t.Run("Each Error", func(t *testing.T) {
+		companyRepository := new(mocks.CompanyRepository)
+		companyRepository.On("FindAll", mock.Anything).Return([]companies.Company{}, exception.ErrInternalServer)
+
+		err := company.Each(context.TODO(), companyRepository, time.Now(), func(ctx context.Context, at time.Time) {
+			t.Fatal("fn must not be called")
+		})
+
+		assert.Equal(t, exception.ErrInternalServer, err)
+	})
+}
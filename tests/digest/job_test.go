@@ -0,0 +1,46 @@
+package digest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/digest"
+	"github.com/Risuii/models/companies"
+	companymocks "github.com/Risuii/tests/company/mocks"
+	"github.com/Risuii/tests/digest/mocks"
+)
+
+func TestDigestJob(t *testing.T) {
+	t.Run("Run Until Cancelled", func(t *testing.T) {
+		digestUseCase := new(mocks.DigestUseCase)
+		companyRepository := new(companymocks.CompanyRepository)
+
+		companyRepository.On("FindAll", mock.Anything).Return([]companies.Company{
+			{ID: 1, Timezone: "Asia/Jakarta"},
+			{ID: 2, Timezone: "Asia/Makassar"},
+		}, nil)
+		for _, c := range []struct {
+			id       int64
+			timezone string
+		}{{1, "Asia/Jakarta"}, {2, "Asia/Makassar"}} {
+			c := c
+			digestUseCase.On("Deliver", mock.MatchedBy(func(ctx context.Context) bool {
+				return tenant.ID(ctx) == c.id
+			}), mock.MatchedBy(func(now time.Time) bool {
+				return now.Location().String() == c.timezone
+			})).Return(1, nil)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		digest.NewDigestJob(digestUseCase, companyRepository, 10*time.Millisecond).Run(ctx)
+
+		digestUseCase.AssertExpectations(t)
+		companyRepository.AssertExpectations(t)
+	})
+}
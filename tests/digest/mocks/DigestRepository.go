@@ -0,0 +1,115 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	activitys "github.com/Risuii/models/activitys"
+
+	time "time"
+
+	digests "github.com/Risuii/models/digests"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DigestRepository is an autogenerated mock type for the DigestRepository type
+type DigestRepository struct {
+	mock.Mock
+}
+
+// FindActivities provides a mock function with given fields: ctx, from, to
+func (_m *DigestRepository) FindActivities(ctx context.Context, from string, to string) ([]activitys.Activity, error) {
+	ret := _m.Called(ctx, from, to)
+
+	var r0 []activitys.Activity
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []activitys.Activity); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]activitys.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindRecipients provides a mock function with given fields: ctx
+func (_m *DigestRepository) FindRecipients(ctx context.Context) ([]digests.Recipient, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []digests.Recipient
+	if rf, ok := ret.Get(0).(func(context.Context) []digests.Recipient); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]digests.Recipient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsSent provides a mock function with given fields: ctx, period, start, recipientID
+func (_m *DigestRepository) IsSent(ctx context.Context, period string, start string, recipientID int64) (bool, error) {
+	ret := _m.Called(ctx, period, start, recipientID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) bool); ok {
+		r0 = rf(ctx, period, start, recipientID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64) error); ok {
+		r1 = rf(ctx, period, start, recipientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkSent provides a mock function with given fields: ctx, period, start, recipientID, sentAt
+func (_m *DigestRepository) MarkSent(ctx context.Context, period string, start string, recipientID int64, sentAt time.Time) error {
+	ret := _m.Called(ctx, period, start, recipientID, sentAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, time.Time) error); ok {
+		r0 = rf(ctx, period, start, recipientID, sentAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewDigestRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewDigestRepository creates a new instance of DigestRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewDigestRepository(t mockConstructorTestingTNewDigestRepository) *DigestRepository {
+	mock := &DigestRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
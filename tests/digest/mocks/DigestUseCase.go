@@ -0,0 +1,76 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	time "time"
+
+	digests "github.com/Risuii/models/digests"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DigestUseCase is an autogenerated mock type for the DigestUseCase type
+type DigestUseCase struct {
+	mock.Mock
+}
+
+// Compile provides a mock function with given fields: ctx, period, now
+func (_m *DigestUseCase) Compile(ctx context.Context, period string, now time.Time) ([]digests.Digest, error) {
+	ret := _m.Called(ctx, period, now)
+
+	var r0 []digests.Digest
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []digests.Digest); ok {
+		r0 = rf(ctx, period, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]digests.Digest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, period, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Deliver provides a mock function with given fields: ctx, now
+func (_m *DigestUseCase) Deliver(ctx context.Context, now time.Time) (int, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewDigestUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewDigestUseCase creates a new instance of DigestUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewDigestUseCase(t mockConstructorTestingTNewDigestUseCase) *DigestUseCase {
+	mock := &DigestUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+package digest_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/internal/digest"
+	"github.com/Risuii/models/digests"
+	"github.com/Risuii/models/reports"
+)
+
+var digestStruct = digests.Digest{
+	Period:    digests.PeriodDaily,
+	From:      "2023-01-06",
+	To:        "2023-01-06",
+	Recipient: manager,
+	Members: []digests.Member{{
+		Attendance:      reports.Recap{UserID: 1, Name: "Employee", DaysPresent: 1, WorkedHours: 8},
+		Activities:      7,
+		ActivityMinutes: 240,
+		Pending:         2,
+		Highlights:      []string{"fixed login"},
+	}},
+}
+
+func TestRender(t *testing.T) {
+	t.Run("Render Members", func(t *testing.T) {
+		text := digest.Render(digestStruct)
+
+		assert.Contains(t, text, "Halo Manager")
+		assert.Contains(t, text, "Employee")
+		assert.Contains(t, text, "Aktivitas 7 (240 menit), 2 menunggu review")
+		assert.Contains(t, text, "- fixed login")
+		assert.Contains(t, text, "dan 6 aktivitas lainnya")
+	})
+
+	t.Run("Subject Weekly", func(t *testing.T) {
+		weekly := digestStruct
+		weekly.Period = digests.PeriodWeekly
+		weekly.From = "2022-12-31"
+
+		assert.Equal(t, "Ringkasan Mingguan 2022-12-31 - 2023-01-06", digest.Subject(weekly))
+	})
+}
+
+func TestFileNotifier(t *testing.T) {
+	t.Run("Notify Writes File", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "digests")
+
+		err := digest.NewFileNotifier(dir).Notify(context.TODO(), digestStruct)
+
+		assert.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(dir, "daily-2023-01-06-2.txt"))
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "Ringkasan Harian 2023-01-06")
+	})
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	t.Run("Notify Posts JSON", func(t *testing.T) {
+		var received digests.Digest
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			json.NewDecoder(r.Body).Decode(&received)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		err := digest.NewWebhookNotifier(server.URL).Notify(context.TODO(), digestStruct)
+
+		assert.NoError(t, err)
+		assert.Equal(t, digestStruct, received)
+	})
+
+	t.Run("Notify Error Status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		err := digest.NewWebhookNotifier(server.URL).Notify(context.TODO(), digestStruct)
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,139 @@
+package digest_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/digest"
+	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/digests"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/mock"
+)
+
+var currentTime = time.Date(2023, 1, 2, 17, 0, 0, 0, time.UTC)
+
+func TestFindRecipientsRepo(t *testing.T) {
+	t.Run("Find Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := digest.NewDigestRepositoryImpl(db)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "name", "email"}).
+			AddRow(2, "Manager", "manager@test.com").
+			AddRow(3, "Lead", nil)
+
+		query := fmt.Sprintf(`SELECT id, name, email FROM %s WHERE company_id = ? AND role = ? ORDER BY id`, constant.TableEmployee)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(int64(4), users.RoleManager).WillReturnRows(rows)
+
+		res, err := repo.FindRecipients(tenant.WithID(context.TODO(), 4))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []digests.Recipient{
+			{UserID: 2, Name: "Manager", Email: "manager@test.com"},
+			{UserID: 3, Name: "Lead"},
+		}, res)
+	})
+
+	t.Run("Find Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := digest.NewDigestRepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, name, email FROM %s`, constant.TableEmployee)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(fmt.Errorf("error"))
+
+		_, err := repo.FindRecipients(tenant.WithID(context.TODO(), 4))
+
+		assert.Equal(t, exception.ErrInternalServer, err)
+	})
+}
+
+func TestFindActivitiesRepo(t *testing.T) {
+	t.Run("Find Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := digest.NewDigestRepositoryImpl(db)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "userID", "deskripsi", "duration_minutes", "status", "created_at"}).
+			AddRow(1, 1, "fixed login", 30, activitys.StatusPending, currentTime)
+
+		query := fmt.Sprintf(`SELECT id, userID, deskripsi, duration_minutes, status, created_at FROM %s WHERE company_id = ? AND DATE(created_at) BETWEEN ? AND ? AND deleted_at IS NULL ORDER BY userID, created_at, id`, constant.TableActivity)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(int64(4), "2023-01-02", "2023-01-02").WillReturnRows(rows)
+
+		res, err := repo.FindActivities(tenant.WithID(context.TODO(), 4), "2023-01-02", "2023-01-02")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []activitys.Activity{{
+			ID:          1,
+			UserID:      1,
+			Description: "fixed login",
+			Duration:    30,
+			Status:      activitys.StatusPending,
+			CreatedAt:   currentTime,
+		}}, res)
+	})
+}
+
+func TestSentLogRepo(t *testing.T) {
+	t.Run("Is Sent", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := digest.NewDigestRepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE period = ? AND period_start = ? AND recipientID = ?`, constant.TableDigestLog)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(digests.PeriodDaily, "2023-01-02", int64(2)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		sent, err := repo.IsSent(context.TODO(), digests.PeriodDaily, "2023-01-02", 2)
+
+		assert.NoError(t, err)
+		assert.True(t, sent)
+	})
+
+	t.Run("Mark Sent", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := digest.NewDigestRepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`INSERT INTO %s (period, period_start, recipientID, sent_at) VALUES (?, ?, ?, ?)`, constant.TableDigestLog)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectExec().WithArgs(digests.PeriodWeekly, "2022-12-27", int64(2), currentTime).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := repo.MarkSent(context.TODO(), digests.PeriodWeekly, "2022-12-27", 2, currentTime)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Mark Sent Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := digest.NewDigestRepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableDigestLog)
+
+		mock.ExpectPrepare(query).ExpectExec().WillReturnError(fmt.Errorf("error"))
+
+		err := repo.MarkSent(context.TODO(), digests.PeriodDaily, "2023-01-02", 2, currentTime)
+
+		assert.Equal(t, exception.ErrInternalServer, err)
+	})
+}
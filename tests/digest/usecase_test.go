@@ -0,0 +1,221 @@
+package digest_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/internal/digest"
+	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/digests"
+	"github.com/Risuii/models/orgs"
+	"github.com/Risuii/models/reports"
+	"github.com/Risuii/tests/digest/mocks"
+	orgMocks "github.com/Risuii/tests/org/mocks"
+	reportMocks "github.com/Risuii/tests/report/mocks"
+)
+
+// friday is after the scheduled time on the weekly day.
+var friday = time.Date(2023, 1, 6, 17, 30, 0, 0, time.UTC)
+var schedule = digests.Schedule{At: "17:00", WeeklyDay: time.Friday}
+var manager = digests.Recipient{UserID: 2, Name: "Manager", Email: "manager@test.com"}
+
+var recap = []reports.Recap{
+	{UserID: 1, Name: "Employee", DaysPresent: 1},
+	{UserID: 2, Name: "Manager", DaysPresent: 1},
+	{UserID: 3, Name: "Idle"},
+	{UserID: 5, Name: "Other Team", DaysPresent: 1},
+}
+
+// teamChain puts employee 1 and 3 under every manager.
+func teamChain() *orgMocks.Chain {
+	chain := new(orgMocks.Chain)
+	chain.On("Reports", mock.Anything, mock.Anything).Return([]orgs.Member{{UserID: 1}, {UserID: 3}}, nil).Maybe()
+
+	return chain
+}
+
+var activity = []activitys.Activity{
+	{ID: 1, UserID: 1, Description: "fixed\nlogin", Duration: 30, Status: activitys.StatusPending},
+	{ID: 2, UserID: 1, Description: "review", Duration: 15, Status: activitys.StatusApproved},
+}
+
+func TestCompile(t *testing.T) {
+	t.Run("Compile Daily", func(t *testing.T) {
+		digestRepository := new(mocks.DigestRepository)
+		reportUseCase := new(reportMocks.ReportUseCase)
+
+		day := time.Date(2023, 1, 6, 0, 0, 0, 0, time.UTC)
+
+		digestRepository.On("FindRecipients", mock.Anything).Return([]digests.Recipient{manager}, nil)
+		reportUseCase.On("Calculate", mock.Anything, int64(0), day, day).Return(recap, nil)
+		digestRepository.On("FindActivities", mock.Anything, "2023-01-06", "2023-01-06").Return(activity, nil)
+
+		digestUseCase := digest.NewDigestUseCase(digestRepository, reportUseCase, teamChain(), new(mocks.Notifier), schedule)
+
+		res, err := digestUseCase.Compile(context.TODO(), digests.PeriodDaily, friday)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, "2023-01-06", res[0].From)
+		assert.Equal(t, manager, res[0].Recipient)
+		assert.Len(t, res[0].Members, 1)
+
+		member := res[0].Members[0]
+		assert.Equal(t, int64(1), member.Attendance.UserID)
+		assert.Equal(t, 2, member.Activities)
+		assert.Equal(t, 45, member.ActivityMinutes)
+		assert.Equal(t, 1, member.Pending)
+		assert.Equal(t, []string{"fixed login", "review"}, member.Highlights)
+		digestRepository.AssertExpectations(t)
+		reportUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Compile Weekly", func(t *testing.T) {
+		digestRepository := new(mocks.DigestRepository)
+		reportUseCase := new(reportMocks.ReportUseCase)
+
+		from := time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2023, 1, 6, 0, 0, 0, 0, time.UTC)
+
+		digestRepository.On("FindRecipients", mock.Anything).Return([]digests.Recipient{manager}, nil)
+		reportUseCase.On("Calculate", mock.Anything, int64(0), from, to).Return(recap, nil)
+		digestRepository.On("FindActivities", mock.Anything, "2022-12-31", "2023-01-06").Return(activity, nil)
+
+		digestUseCase := digest.NewDigestUseCase(digestRepository, reportUseCase, teamChain(), new(mocks.Notifier), schedule)
+
+		res, err := digestUseCase.Compile(context.TODO(), digests.PeriodWeekly, friday)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "2022-12-31", res[0].From)
+		assert.Equal(t, "2023-01-06", res[0].To)
+		digestRepository.AssertExpectations(t)
+		reportUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Compile Without Managers", func(t *testing.T) {
+		digestRepository := new(mocks.DigestRepository)
+		reportUseCase := new(reportMocks.ReportUseCase)
+
+		digestRepository.On("FindRecipients", mock.Anything).Return([]digests.Recipient{}, nil)
+
+		digestUseCase := digest.NewDigestUseCase(digestRepository, reportUseCase, teamChain(), new(mocks.Notifier), schedule)
+
+		res, err := digestUseCase.Compile(context.TODO(), digests.PeriodDaily, friday)
+
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+		reportUseCase.AssertNotCalled(t, "Calculate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Compile Error", func(t *testing.T) {
+		digestRepository := new(mocks.DigestRepository)
+		reportUseCase := new(reportMocks.ReportUseCase)
+
+		digestRepository.On("FindRecipients", mock.Anything).Return([]digests.Recipient{manager}, nil)
+		reportUseCase.On("Calculate", mock.Anything, int64(0), mock.Anything, mock.Anything).Return([]reports.Recap{}, exception.ErrInternalServer)
+
+		digestUseCase := digest.NewDigestUseCase(digestRepository, reportUseCase, teamChain(), new(mocks.Notifier), schedule)
+
+		_, err := digestUseCase.Compile(context.TODO(), digests.PeriodDaily, friday)
+
+		assert.Equal(t, exception.ErrInternalServer, err)
+	})
+}
+
+func TestDeliver(t *testing.T) {
+	t.Run("Deliver Before Schedule", func(t *testing.T) {
+		digestRepository := new(mocks.DigestRepository)
+		notifier := new(mocks.Notifier)
+
+		digestUseCase := digest.NewDigestUseCase(digestRepository, new(reportMocks.ReportUseCase), teamChain(), notifier, schedule)
+
+		sent, err := digestUseCase.Deliver(context.TODO(), friday.Add(-time.Hour))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, sent)
+		digestRepository.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("Deliver Daily And Weekly", func(t *testing.T) {
+		digestRepository := new(mocks.DigestRepository)
+		reportUseCase := new(reportMocks.ReportUseCase)
+		notifier := new(mocks.Notifier)
+
+		digestRepository.On("FindRecipients", mock.Anything).Return([]digests.Recipient{manager}, nil)
+		reportUseCase.On("Calculate", mock.Anything, int64(0), mock.Anything, mock.Anything).Return(recap, nil)
+		digestRepository.On("FindActivities", mock.Anything, mock.Anything, "2023-01-06").Return(activity, nil)
+		digestRepository.On("IsSent", mock.Anything, digests.PeriodDaily, "2023-01-06", int64(2)).Return(true, nil)
+		digestRepository.On("IsSent", mock.Anything, digests.PeriodWeekly, "2022-12-31", int64(2)).Return(false, nil)
+		notifier.On("Notify", mock.Anything, mock.MatchedBy(func(d digests.Digest) bool {
+			return d.Period == digests.PeriodWeekly
+		})).Return(nil)
+		digestRepository.On("MarkSent", mock.Anything, digests.PeriodWeekly, "2022-12-31", int64(2), friday).Return(nil)
+
+		digestUseCase := digest.NewDigestUseCase(digestRepository, reportUseCase, teamChain(), notifier, schedule)
+
+		sent, err := digestUseCase.Deliver(context.TODO(), friday)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, sent)
+		digestRepository.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("Deliver Skips Empty Digest", func(t *testing.T) {
+		digestRepository := new(mocks.DigestRepository)
+		reportUseCase := new(reportMocks.ReportUseCase)
+		notifier := new(mocks.Notifier)
+
+		saturday := friday.AddDate(0, 0, 1)
+
+		digestRepository.On("FindRecipients", mock.Anything).Return([]digests.Recipient{manager}, nil)
+		reportUseCase.On("Calculate", mock.Anything, int64(0), mock.Anything, mock.Anything).Return([]reports.Recap{{UserID: 1}}, nil)
+		digestRepository.On("FindActivities", mock.Anything, "2023-01-07", "2023-01-07").Return([]activitys.Activity{}, nil)
+
+		digestUseCase := digest.NewDigestUseCase(digestRepository, reportUseCase, teamChain(), notifier, schedule)
+
+		sent, err := digestUseCase.Deliver(context.TODO(), saturday)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, sent)
+		digestRepository.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("Deliver Notify Error", func(t *testing.T) {
+		digestRepository := new(mocks.DigestRepository)
+		reportUseCase := new(reportMocks.ReportUseCase)
+		notifier := new(mocks.Notifier)
+
+		thursday := friday.AddDate(0, 0, -1)
+		other := digests.Recipient{UserID: 4, Name: "Other"}
+
+		digestRepository.On("FindRecipients", mock.Anything).Return([]digests.Recipient{manager, other}, nil)
+		reportUseCase.On("Calculate", mock.Anything, int64(0), mock.Anything, mock.Anything).Return(recap, nil)
+		digestRepository.On("FindActivities", mock.Anything, "2023-01-05", "2023-01-05").Return(activity, nil)
+		digestRepository.On("IsSent", mock.Anything, digests.PeriodDaily, "2023-01-05", mock.Anything).Return(false, nil)
+		notifier.On("Notify", mock.Anything, mock.MatchedBy(func(d digests.Digest) bool {
+			return d.Recipient.UserID == 2
+		})).Return(fmt.Errorf("smtp down"))
+		notifier.On("Notify", mock.Anything, mock.MatchedBy(func(d digests.Digest) bool {
+			return d.Recipient.UserID == 4
+		})).Return(nil)
+		digestRepository.On("MarkSent", mock.Anything, digests.PeriodDaily, "2023-01-05", int64(4), thursday).Return(nil)
+
+		digestUseCase := digest.NewDigestUseCase(digestRepository, reportUseCase, teamChain(), notifier, schedule)
+
+		sent, err := digestUseCase.Deliver(context.TODO(), thursday)
+
+		assert.Equal(t, exception.ErrInternalServer, err)
+		assert.Equal(t, 1, sent)
+		digestRepository.AssertNotCalled(t, "MarkSent", mock.Anything, digests.PeriodDaily, "2023-01-05", int64(2), mock.Anything)
+		notifier.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,145 @@
+package employee_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	newJWT "github.com/dgrijalva/jwt-go"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/employee"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/employee/mocks"
+)
+
+func newToken(t *testing.T, role string) string {
+	mockToken := &jwt.JWTclaim{
+		ID:    1,
+		Email: "test@test.com",
+		Role:  role,
+		StandardClaims: newJWT.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+		},
+	}
+
+	token, err := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken).SignedString(jwt.JWT_KEY)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestHandler_List(t *testing.T) {
+	t.Run("List Success", func(t *testing.T) {
+		newReq, _ := json.Marshal(users.EmployeeList{Query: "budi", Status: users.StatusActive})
+
+		employeeUseCase := new(mocks.EmployeeUseCase)
+		employeeUseCase.On("List", mock.Anything, users.RoleAdmin, users.EmployeeList{Query: "budi", Status: users.StatusActive}).Return(response.Success(response.StatusOK, []users.Employee{}))
+
+		employeeHandler := employee.EmployeeHandler{
+			Validate: validator.New(),
+			UseCase:  employeeUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t, users.RoleAdmin)})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(employeeHandler.List)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		employeeUseCase.AssertExpectations(t)
+	})
+
+	t.Run("List Unknown Status", func(t *testing.T) {
+		newReq, _ := json.Marshal(users.EmployeeList{Status: "retired"})
+
+		employeeHandler := employee.EmployeeHandler{
+			Validate: validator.New(),
+			UseCase:  new(mocks.EmployeeUseCase),
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t, users.RoleAdmin)})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(employeeHandler.List)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_Create(t *testing.T) {
+	t.Run("Create Invalid Email", func(t *testing.T) {
+		newReq, _ := json.Marshal(users.EmployeeReq{Name: "Budi", Email: "budi", Password: "rahasia123"})
+
+		employeeHandler := employee.EmployeeHandler{
+			Validate: validator.New(),
+			UseCase:  new(mocks.EmployeeUseCase),
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t, users.RoleAdmin)})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(employeeHandler.Create)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Create Without Token", func(t *testing.T) {
+		newReq, _ := json.Marshal(users.EmployeeReq{Name: "Budi", Email: "budi@test.com", Password: "rahasia123"})
+
+		employeeHandler := employee.EmployeeHandler{
+			Validate: validator.New(),
+			UseCase:  new(mocks.EmployeeUseCase),
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(employeeHandler.Create)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+}
+
+func TestHandler_SetStatus(t *testing.T) {
+	t.Run("Set Status Success", func(t *testing.T) {
+		newReq, _ := json.Marshal(users.StatusReq{Status: users.StatusTerminated})
+
+		employeeUseCase := new(mocks.EmployeeUseCase)
+		employeeUseCase.On("SetStatus", mock.Anything, int64(5), int64(1), users.RoleAdmin, users.StatusReq{Status: users.StatusTerminated}).Return(response.Success(response.StatusOK, users.Employee{ID: 5}))
+
+		employeeHandler := employee.EmployeeHandler{
+			Validate: validator.New(),
+			UseCase:  employeeUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPatch, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t, users.RoleAdmin)})
+		r = mux.SetURLVars(r, map[string]string{"id": "5"})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(employeeHandler.SetStatus)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		employeeUseCase.AssertExpectations(t)
+	})
+}
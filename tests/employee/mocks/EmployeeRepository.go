@@ -0,0 +1,229 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	time "time"
+
+	onboardings "github.com/Risuii/models/onboardings"
+	shifts "github.com/Risuii/models/shifts"
+	users "github.com/Risuii/models/users"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EmployeeRepository is an autogenerated mock type for the EmployeeRepository type
+type EmployeeRepository struct {
+	mock.Mock
+}
+
+// Count provides a mock function with given fields: ctx, params
+func (_m *EmployeeRepository) Count(ctx context.Context, params users.EmployeeList) (int64, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, users.EmployeeList) int64); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, users.EmployeeList) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, params
+func (_m *EmployeeRepository) Create(ctx context.Context, params users.Employee) (int64, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, users.Employee) int64); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, users.Employee) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateInvite provides a mock function with given fields: ctx, userID, tokenHash, expiresAt
+func (_m *EmployeeRepository) CreateInvite(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error {
+	ret := _m.Called(ctx, userID, tokenHash, expiresAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, time.Time) error); ok {
+		r0 = rf(ctx, userID, tokenHash, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByCode provides a mock function with given fields: ctx, code
+func (_m *EmployeeRepository) FindByCode(ctx context.Context, code string) (users.Employee, error) {
+	ret := _m.Called(ctx, code)
+
+	var r0 users.Employee
+	if rf, ok := ret.Get(0).(func(context.Context, string) users.Employee); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Get(0).(users.Employee)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *EmployeeRepository) FindByID(ctx context.Context, id int64) (users.Employee, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 users.Employee
+	if rf, ok := ret.Get(0).(func(context.Context, int64) users.Employee); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(users.Employee)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindShifts provides a mock function with given fields: ctx
+func (_m *EmployeeRepository) FindShifts(ctx context.Context) ([]shifts.Shift, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []shifts.Shift
+	if rf, ok := ret.Get(0).(func(context.Context) []shifts.Shift); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]shifts.Shift)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, params
+func (_m *EmployeeRepository) List(ctx context.Context, params users.EmployeeList) ([]users.Employee, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []users.Employee
+	if rf, ok := ret.Get(0).(func(context.Context, users.EmployeeList) []users.Employee); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]users.Employee)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, users.EmployeeList) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Onboard provides a mock function with given fields: ctx, hires
+func (_m *EmployeeRepository) Onboard(ctx context.Context, hires []onboardings.Hire) ([]int64, error) {
+	ret := _m.Called(ctx, hires)
+
+	var r0 []int64
+	if rf, ok := ret.Get(0).(func(context.Context, []onboardings.Hire) []int64); ok {
+		r0 = rf(ctx, hires)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []onboardings.Hire) error); ok {
+		r1 = rf(ctx, hires)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetStatus provides a mock function with given fields: ctx, id, status
+func (_m *EmployeeRepository) SetStatus(ctx context.Context, id int64, status string) error {
+	ret := _m.Called(ctx, id, status)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, id, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, params
+func (_m *EmployeeRepository) Update(ctx context.Context, params users.Employee) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, users.Employee) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewEmployeeRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewEmployeeRepository creates a new instance of EmployeeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewEmployeeRepository(t mockConstructorTestingTNewEmployeeRepository) *EmployeeRepository {
+	mock := &EmployeeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
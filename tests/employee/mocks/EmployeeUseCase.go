@@ -0,0 +1,161 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	io "io"
+
+	response "github.com/Risuii/helpers/response"
+	users "github.com/Risuii/models/users"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EmployeeUseCase is an autogenerated mock type for the EmployeeUseCase type
+type EmployeeUseCase struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, role, params
+func (_m *EmployeeUseCase) Create(ctx context.Context, role string, params users.EmployeeReq) response.Response {
+	ret := _m.Called(ctx, role, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, users.EmployeeReq) response.Response); ok {
+		r0 = rf(ctx, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, id, role
+func (_m *EmployeeUseCase) Get(ctx context.Context, id int64, role string) response.Response {
+	ret := _m.Called(ctx, id, role)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) response.Response); ok {
+		r0 = rf(ctx, id, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Import provides a mock function with given fields: ctx, role, r, dryRun
+func (_m *EmployeeUseCase) Import(ctx context.Context, role string, r io.Reader, dryRun bool) response.Response {
+	ret := _m.Called(ctx, role, r, dryRun)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, bool) response.Response); ok {
+		r0 = rf(ctx, role, r, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Invite provides a mock function with given fields: ctx, role, params
+func (_m *EmployeeUseCase) Invite(ctx context.Context, role string, params users.InviteReq) response.Response {
+	ret := _m.Called(ctx, role, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, users.InviteReq) response.Response); ok {
+		r0 = rf(ctx, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: ctx, role, params
+func (_m *EmployeeUseCase) List(ctx context.Context, role string, params users.EmployeeList) response.Response {
+	ret := _m.Called(ctx, role, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, users.EmployeeList) response.Response); ok {
+		r0 = rf(ctx, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Reinvite provides a mock function with given fields: ctx, id, role
+func (_m *EmployeeUseCase) Reinvite(ctx context.Context, id int64, role string) response.Response {
+	ret := _m.Called(ctx, id, role)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) response.Response); ok {
+		r0 = rf(ctx, id, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// SetStatus provides a mock function with given fields: ctx, id, userID, role, params
+func (_m *EmployeeUseCase) SetStatus(ctx context.Context, id int64, userID int64, role string, params users.StatusReq) response.Response {
+	ret := _m.Called(ctx, id, userID, role, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string, users.StatusReq) response.Response); ok {
+		r0 = rf(ctx, id, userID, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, id, role, params
+func (_m *EmployeeUseCase) Update(ctx context.Context, id int64, role string, params users.EmployeeReq) response.Response {
+	ret := _m.Called(ctx, id, role, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, users.EmployeeReq) response.Response); ok {
+		r0 = rf(ctx, id, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewEmployeeUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewEmployeeUseCase creates a new instance of EmployeeUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewEmployeeUseCase(t mockConstructorTestingTNewEmployeeUseCase) *EmployeeUseCase {
+	mock := &EmployeeUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
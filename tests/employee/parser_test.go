@@ -0,0 +1,56 @@
+package employee_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/internal/employee"
+	"github.com/Risuii/models/onboardings"
+)
+
+func TestParseCSV(t *testing.T) {
+	t.Run("Parse Success", func(t *testing.T) {
+		csv := "\ufeffName,Email,Employee_Code,Department,Team,Shift,Notes\n" +
+			"Budi, budi@test.com ,EMP-1,Engineering,Backend,Pagi,first week\n" +
+			",,,,,,\n" +
+			"Sari,sari@test.com\n"
+
+		lines, result, failed, err := employee.ParseCSV(strings.NewReader(csv), validator.New())
+
+		assert.NoError(t, err)
+		assert.Empty(t, failed)
+		assert.Equal(t, 2, lines)
+		assert.Equal(t, []onboardings.Row{
+			{Line: 2, Name: "Budi", Email: "budi@test.com", Code: "EMP-1", Department: "Engineering", Team: "Backend", Shift: "Pagi"},
+			{Line: 4, Name: "Sari", Email: "sari@test.com"},
+		}, result)
+	})
+
+	t.Run("Parse Invalid Rows", func(t *testing.T) {
+		csv := "name,email,department,team\n" +
+			"Budi,budi,,\n" +
+			",sari@test.com,,\n" +
+			"Tono,tono@test.com,,Backend\n" +
+			"\"Rina,rina@test.com,,\n"
+
+		lines, result, failed, err := employee.ParseCSV(strings.NewReader(csv), validator.New())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, lines)
+		assert.Empty(t, result)
+		assert.Len(t, failed, 4)
+		assert.Equal(t, onboardings.ImportError{Line: 2, Reason: "email failed on email"}, failed[0])
+		assert.Equal(t, onboardings.ImportError{Line: 4, Reason: "department failed on required_with"}, failed[2])
+		assert.Equal(t, 5, failed[3].Line)
+	})
+
+	t.Run("Parse Missing Header", func(t *testing.T) {
+		_, _, _, err := employee.ParseCSV(strings.NewReader("name,code\nBudi,EMP-1\n"), validator.New())
+
+		assert.Equal(t, exception.ErrBadRequest, err)
+	})
+}
@@ -0,0 +1,234 @@
+package employee_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/employee"
+	"github.com/Risuii/models/onboardings"
+	"github.com/Risuii/models/paginations"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/mock"
+)
+
+var currentTime = time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+var employeeColumns = []string{"id", "name", "password", "email", "role", "code", "status", "company_id", "created_at", "update_at"}
+
+func TestListRepo(t *testing.T) {
+	t.Run("List Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := employee.NewEmployeeRepositoryImpl(db)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows(employeeColumns).
+			AddRow(5, "Budi", "hashed", "budi@test.com", users.RoleEmployee, "EMP-5", users.StatusActive, 2, currentTime, currentTime).
+			AddRow(4, "Budiman", "hashed", "budiman@test.com", users.RoleManager, nil, users.StatusSuspended, 2, currentTime, currentTime)
+
+		query := fmt.Sprintf(`SELECT id, name, password, email, role, code, status, company_id, created_at, update_at FROM %s WHERE company_id = ? AND (name LIKE ? OR email LIKE ? OR code LIKE ?) AND role = ? AND id < ? ORDER BY id DESC LIMIT ?`, constant.TableEmployee)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(int64(2), "%budi%", "%budi%", "%budi%", users.RoleEmployee, int64(10), 2).WillReturnRows(rows)
+
+		params := users.EmployeeList{
+			Query:      "budi",
+			Role:       users.RoleEmployee,
+			Pagination: paginations.Pagination{Limit: 2, Cursor: 10, Sort: paginations.SortDesc},
+		}
+
+		employee, err := repo.List(tenant.WithID(context.TODO(), 2), params)
+
+		assert.NoError(t, err)
+		assert.Len(t, employee, 2)
+		assert.Equal(t, "EMP-5", employee[0].Code)
+		assert.Empty(t, employee[0].Password)
+		assert.Equal(t, users.StatusSuspended, employee[1].Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("List Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := employee.NewEmployeeRepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, name, password, email, role, code, status, company_id, created_at, update_at FROM %s`, constant.TableEmployee)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(fmt.Errorf("error"))
+
+		_, err := repo.List(tenant.WithID(context.TODO(), 2), users.EmployeeList{Pagination: paginations.Pagination{Limit: 20}})
+
+		assert.Equal(t, exception.ErrInternalServer, err)
+	})
+}
+
+func TestCountRepo(t *testing.T) {
+	t.Run("Count Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := employee.NewEmployeeRepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE company_id = ? AND status = ?`, constant.TableEmployee)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(int64(2), users.StatusTerminated).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		total, err := repo.Count(tenant.WithID(context.TODO(), 2), users.EmployeeList{Status: users.StatusTerminated})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+	})
+}
+
+func TestFindByIDRepo(t *testing.T) {
+	t.Run("Find Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := employee.NewEmployeeRepositoryImpl(db)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows(employeeColumns).
+			AddRow(5, "Budi", "hashed", "budi@test.com", users.RoleEmployee, nil, users.StatusActive, 2, currentTime, currentTime)
+
+		query := fmt.Sprintf(`SELECT id, name, password, email, role, code, status, company_id, created_at, update_at FROM %s WHERE id = ? AND company_id = ?`, constant.TableEmployee)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(int64(5), int64(2)).WillReturnRows(rows)
+
+		employee, err := repo.FindByID(tenant.WithID(context.TODO(), 2), 5)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "hashed", employee.Password)
+		assert.Empty(t, employee.Code)
+	})
+
+	t.Run("Find Not Found", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := employee.NewEmployeeRepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, name, password, email, role, code, status, company_id, created_at, update_at FROM %s WHERE id = ? AND company_id = ?`, constant.TableEmployee)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(int64(5), int64(2)).WillReturnRows(sqlmock.NewRows(employeeColumns))
+
+		_, err := repo.FindByID(tenant.WithID(context.TODO(), 2), 5)
+
+		assert.Equal(t, exception.ErrNotFound, err)
+	})
+}
+
+func TestCreateRepo(t *testing.T) {
+	t.Run("Create Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := employee.NewEmployeeRepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`INSERT INTO %s (name, password, email, role, code, status, company_id, created_at, update_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, constant.TableEmployee)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectExec().
+			WithArgs("Budi", "hashed", "budi@test.com", users.RoleEmployee, nil, users.StatusActive, int64(2), currentTime, currentTime).
+			WillReturnResult(sqlmock.NewResult(7, 1))
+
+		ID, err := repo.Create(tenant.WithID(context.TODO(), 2), users.Employee{
+			Name:      "Budi",
+			Password:  "hashed",
+			Email:     "budi@test.com",
+			Role:      users.RoleEmployee,
+			Status:    users.StatusActive,
+			CreatedAt: currentTime,
+			UpdateAt:  currentTime,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), ID)
+	})
+}
+
+func TestSetStatusRepo(t *testing.T) {
+	t.Run("Set Status Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := employee.NewEmployeeRepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`UPDATE %s SET status = ? WHERE id = ? AND company_id = ?`, constant.TableEmployee)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectExec().WithArgs(users.StatusSuspended, int64(5), int64(2)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.SetStatus(tenant.WithID(context.TODO(), 2), 5, users.StatusSuspended)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Set Status Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := employee.NewEmployeeRepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`UPDATE %s SET status = ?`, constant.TableEmployee)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectExec().WillReturnError(fmt.Errorf("error"))
+
+		err := repo.SetStatus(tenant.WithID(context.TODO(), 2), 5, users.StatusSuspended)
+
+		assert.Equal(t, exception.ErrInternalServer, err)
+	})
+}
+
+func TestOnboardRepo(t *testing.T) {
+	employeeInsert := regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO %s (name, password, email, role, code, status, team_id, shift_id, company_id, created_at, update_at) VALUES (?, '', ?, ?, ?, ?, ?, ?, ?, ?, ?)`, constant.TableEmployee))
+	inviteInsert := regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO %s (userID, token_hash, expires_at, created_at) VALUES (?, ?, ?, ?)`, constant.TableInvite))
+
+	hires := []onboardings.Hire{
+		{Row: onboardings.Row{Name: "Budi", Email: "budi@test.com", Code: "EMP-1"}, TeamID: 4, TokenHash: "hash-budi", ExpiresAt: currentTime},
+		{Row: onboardings.Row{Name: "Sari", Email: "sari@test.com"}, ShiftID: 2, TokenHash: "hash-sari", ExpiresAt: currentTime},
+	}
+
+	t.Run("Onboard Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := employee.NewEmployeeRepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(employeeInsert).WithArgs("Budi", "budi@test.com", users.RoleEmployee, "EMP-1", users.StatusActive, int64(4), nil, int64(2), sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(11, 1))
+		mock.ExpectExec(inviteInsert).WithArgs(int64(11), "hash-budi", currentTime, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(employeeInsert).WithArgs("Sari", "sari@test.com", users.RoleEmployee, nil, users.StatusActive, nil, int64(2), int64(2), sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(12, 1))
+		mock.ExpectExec(inviteInsert).WithArgs(int64(12), "hash-sari", currentTime, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectCommit()
+
+		IDs, err := repo.Onboard(tenant.WithID(context.TODO(), 2), hires)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{11, 12}, IDs)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Onboard Error Rollback", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := employee.NewEmployeeRepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(employeeInsert).WillReturnResult(sqlmock.NewResult(11, 1))
+		mock.ExpectExec(inviteInsert).WillReturnError(fmt.Errorf("error"))
+		mock.ExpectRollback()
+
+		IDs, err := repo.Onboard(tenant.WithID(context.TODO(), 2), hires)
+
+		assert.Equal(t, exception.ErrInternalServer, err)
+		assert.Empty(t, IDs)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
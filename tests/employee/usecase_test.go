@@ -0,0 +1,444 @@
+package employee_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	bcryptmocks "github.com/Risuii/config/bcrypt/mocks"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/employee"
+	"github.com/Risuii/models/invites"
+	"github.com/Risuii/models/onboardings"
+	"github.com/Risuii/models/orgs"
+	"github.com/Risuii/models/paginations"
+	"github.com/Risuii/models/shifts"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/employee/mocks"
+	invitemocks "github.com/Risuii/tests/invite/mocks"
+	orgmocks "github.com/Risuii/tests/org/mocks"
+	usermocks "github.com/Risuii/tests/user/mocks"
+)
+
+func TestList(t *testing.T) {
+	t.Run("List Success", func(t *testing.T) {
+		employeeRepository := new(mocks.EmployeeRepository)
+
+		params := users.EmployeeList{
+			Query:      "budi",
+			Pagination: paginations.Pagination{Limit: 1, Sort: paginations.SortAsc},
+		}
+
+		employeeRepository.On("List", mock.Anything, params).Return([]users.Employee{{ID: 5}}, nil)
+		employeeRepository.On("Count", mock.Anything, params).Return(int64(3), nil)
+
+		employeeUseCase := employee.NewEmployeeUseCase(employeeRepository, new(usermocks.UserRepository), new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), new(invitemocks.Sender))
+
+		resp := employeeUseCase.List(context.TODO(), users.RoleAdmin, users.EmployeeList{Query: " budi ", Pagination: paginations.Pagination{Limit: 1}})
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, int64(5), resp.(*response.ResponseImpl).Page.NextCursor)
+
+		employeeRepository.AssertExpectations(t)
+	})
+
+	t.Run("List Not Admin", func(t *testing.T) {
+		employeeUseCase := employee.NewEmployeeUseCase(new(mocks.EmployeeRepository), new(usermocks.UserRepository), new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), new(invitemocks.Sender))
+
+		resp := employeeUseCase.List(context.TODO(), users.RoleManager, users.EmployeeList{})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+	})
+}
+
+func TestCreate(t *testing.T) {
+	params := users.EmployeeReq{
+		Name:     "Budi",
+		Email:    "budi@test.com",
+		Password: "rahasia123",
+		Code:     "EMP-5",
+	}
+
+	t.Run("Create Success", func(t *testing.T) {
+		employeeRepository := new(mocks.EmployeeRepository)
+		userRepository := new(usermocks.UserRepository)
+		bcrypt := new(bcryptmocks.Bcrypt)
+
+		userRepository.On("FindByEmail", mock.Anything, "budi@test.com").Return(users.Employee{}, exception.ErrNotFound)
+		employeeRepository.On("FindByCode", mock.Anything, "EMP-5").Return(users.Employee{}, exception.ErrNotFound)
+		bcrypt.On("HashPassword", "rahasia123").Return("hashed", nil)
+		employeeRepository.On("Create", mock.Anything, mock.MatchedBy(func(e users.Employee) bool {
+			return e.Password == "hashed" && e.Role == users.RoleEmployee && e.Status == users.StatusActive && e.Code == "EMP-5"
+		})).Return(int64(7), nil)
+
+		employeeUseCase := employee.NewEmployeeUseCase(employeeRepository, userRepository, new(orgmocks.OrgRepository), bcrypt, validator.New(), new(invitemocks.Sender))
+
+		resp := employeeUseCase.Create(context.TODO(), users.RoleAdmin, params)
+
+		assert.NoError(t, resp.Err())
+
+		created := resp.(*response.ResponseImpl).Data.(users.Employee)
+		assert.Equal(t, int64(7), created.ID)
+		assert.Empty(t, created.Password)
+
+		employeeRepository.AssertExpectations(t)
+		userRepository.AssertExpectations(t)
+		bcrypt.AssertExpectations(t)
+	})
+
+	t.Run("Create Email Taken", func(t *testing.T) {
+		userRepository := new(usermocks.UserRepository)
+
+		userRepository.On("FindByEmail", mock.Anything, "budi@test.com").Return(users.Employee{ID: 3}, nil)
+
+		employeeUseCase := employee.NewEmployeeUseCase(new(mocks.EmployeeRepository), userRepository, new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), new(invitemocks.Sender))
+
+		resp := employeeUseCase.Create(context.TODO(), users.RoleAdmin, params)
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+	})
+
+	t.Run("Create Code Taken", func(t *testing.T) {
+		employeeRepository := new(mocks.EmployeeRepository)
+		userRepository := new(usermocks.UserRepository)
+
+		userRepository.On("FindByEmail", mock.Anything, "budi@test.com").Return(users.Employee{}, exception.ErrNotFound)
+		employeeRepository.On("FindByCode", mock.Anything, "EMP-5").Return(users.Employee{ID: 3}, nil)
+
+		employeeUseCase := employee.NewEmployeeUseCase(employeeRepository, userRepository, new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), new(invitemocks.Sender))
+
+		resp := employeeUseCase.Create(context.TODO(), users.RoleAdmin, params)
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+	})
+
+	t.Run("Create Without Password", func(t *testing.T) {
+		employeeUseCase := employee.NewEmployeeUseCase(new(mocks.EmployeeRepository), new(usermocks.UserRepository), new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), new(invitemocks.Sender))
+
+		resp := employeeUseCase.Create(context.TODO(), users.RoleAdmin, users.EmployeeReq{Name: "Budi", Email: "budi@test.com"})
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("Update Keeps Password", func(t *testing.T) {
+		employeeRepository := new(mocks.EmployeeRepository)
+		userRepository := new(usermocks.UserRepository)
+
+		existing := users.Employee{ID: 5, Name: "Budi", Email: "budi@test.com", Password: "hashed", Role: users.RoleEmployee}
+
+		employeeRepository.On("FindByID", mock.Anything, int64(5)).Return(existing, nil)
+		userRepository.On("FindByEmail", mock.Anything, "budi@test.com").Return(existing, nil)
+		employeeRepository.On("Update", mock.Anything, mock.MatchedBy(func(e users.Employee) bool {
+			return e.Name == "Budi Santoso" && e.Password == "hashed" && e.Role == users.RoleManager
+		})).Return(nil)
+
+		employeeUseCase := employee.NewEmployeeUseCase(employeeRepository, userRepository, new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), new(invitemocks.Sender))
+
+		resp := employeeUseCase.Update(context.TODO(), 5, users.RoleAdmin, users.EmployeeReq{Name: "Budi Santoso", Email: "budi@test.com", Role: users.RoleManager})
+
+		assert.NoError(t, resp.Err())
+		assert.Empty(t, resp.(*response.ResponseImpl).Data.(users.Employee).Password)
+
+		employeeRepository.AssertExpectations(t)
+		userRepository.AssertExpectations(t)
+	})
+
+	t.Run("Update Not Found", func(t *testing.T) {
+		employeeRepository := new(mocks.EmployeeRepository)
+
+		employeeRepository.On("FindByID", mock.Anything, int64(5)).Return(users.Employee{}, exception.ErrNotFound)
+
+		employeeUseCase := employee.NewEmployeeUseCase(employeeRepository, new(usermocks.UserRepository), new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), new(invitemocks.Sender))
+
+		resp := employeeUseCase.Update(context.TODO(), 5, users.RoleAdmin, users.EmployeeReq{Name: "Budi", Email: "budi@test.com"})
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+	})
+}
+
+func TestSetStatus(t *testing.T) {
+	t.Run("Suspend Success", func(t *testing.T) {
+		employeeRepository := new(mocks.EmployeeRepository)
+
+		employeeRepository.On("FindByID", mock.Anything, int64(5)).Return(users.Employee{ID: 5, Status: users.StatusActive}, nil)
+		employeeRepository.On("SetStatus", mock.Anything, int64(5), users.StatusSuspended).Return(nil)
+
+		employeeUseCase := employee.NewEmployeeUseCase(employeeRepository, new(usermocks.UserRepository), new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), new(invitemocks.Sender))
+
+		resp := employeeUseCase.SetStatus(context.TODO(), 5, 1, users.RoleAdmin, users.StatusReq{Status: users.StatusSuspended})
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, users.StatusSuspended, resp.(*response.ResponseImpl).Data.(users.Employee).Status)
+
+		employeeRepository.AssertExpectations(t)
+	})
+
+	t.Run("Own Status", func(t *testing.T) {
+		employeeUseCase := employee.NewEmployeeUseCase(new(mocks.EmployeeRepository), new(usermocks.UserRepository), new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), new(invitemocks.Sender))
+
+		resp := employeeUseCase.SetStatus(context.TODO(), 1, 1, users.RoleAdmin, users.StatusReq{Status: users.StatusTerminated})
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+	})
+
+	t.Run("Not Admin", func(t *testing.T) {
+		employeeUseCase := employee.NewEmployeeUseCase(new(mocks.EmployeeRepository), new(usermocks.UserRepository), new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), new(invitemocks.Sender))
+
+		resp := employeeUseCase.SetStatus(context.TODO(), 5, 1, users.RoleEmployee, users.StatusReq{Status: users.StatusTerminated})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+	})
+}
+
+func TestImport(t *testing.T) {
+	csv := "name,email,code,department,team,shift\n" +
+		"Budi,budi@test.com,EMP-1,Engineering,Backend,Pagi\n" +
+		"Budi Lagi,BUDI@test.com,,,,\n" +
+		"Sari,sari@test.com,EMP-1,,,\n" +
+		"Tono,tono@test.com,,Engineering,Frontend,\n" +
+		"Rina,rina@test.com,,,,Malam\n" +
+		"Dewi,dewi@test.com,,,,\n" +
+		"Andi,andi@test.com,EMP-9,,,\n"
+
+	setup := func() (*mocks.EmployeeRepository, *usermocks.UserRepository, *orgmocks.OrgRepository) {
+		employeeRepository := new(mocks.EmployeeRepository)
+		userRepository := new(usermocks.UserRepository)
+		orgRepository := new(orgmocks.OrgRepository)
+
+		orgRepository.On("FindDepartments", mock.Anything).Return([]orgs.Department{{ID: 1, Name: "Engineering"}}, nil)
+		orgRepository.On("FindTeams", mock.Anything, int64(0)).Return([]orgs.Team{{ID: 4, DepartmentID: 1, Name: "Backend"}}, nil)
+		employeeRepository.On("FindShifts", mock.Anything).Return([]shifts.Shift{{ID: 2, Name: "pagi"}}, nil)
+
+		userRepository.On("FindByEmail", mock.Anything, "dewi@test.com").Return(users.Employee{ID: 3}, nil)
+		userRepository.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(users.Employee{}, exception.ErrNotFound)
+		employeeRepository.On("FindByCode", mock.Anything, "EMP-1").Return(users.Employee{}, exception.ErrNotFound)
+		employeeRepository.On("FindByCode", mock.Anything, "EMP-9").Return(users.Employee{ID: 8}, nil)
+
+		return employeeRepository, userRepository, orgRepository
+	}
+
+	t.Run("Import Dry Run", func(t *testing.T) {
+		employeeRepository, userRepository, orgRepository := setup()
+
+		employeeUseCase := employee.NewEmployeeUseCase(employeeRepository, userRepository, orgRepository, new(bcryptmocks.Bcrypt), validator.New(), new(invitemocks.Sender))
+
+		resp := employeeUseCase.Import(context.TODO(), users.RoleAdmin, strings.NewReader(csv), true)
+
+		assert.NoError(t, resp.Err())
+
+		report := resp.(*response.ResponseImpl).Data.(onboardings.Report)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, 7, report.Lines)
+		assert.Equal(t, 0, report.Created)
+		assert.Len(t, report.Hires, 1)
+		assert.Equal(t, int64(4), report.Hires[0].TeamID)
+		assert.Equal(t, int64(2), report.Hires[0].ShiftID)
+		assert.Empty(t, report.Hires[0].Link)
+		assert.Equal(t, []onboardings.ImportError{
+			{Line: 3, Reason: "email already in line 2"},
+			{Line: 4, Reason: "code already in line 2"},
+			{Line: 5, Reason: `unknown team "Frontend" in department "Engineering"`},
+			{Line: 6, Reason: `unknown shift "Malam"`},
+			{Line: 7, Reason: "email already registered"},
+			{Line: 8, Reason: "code already used"},
+		}, report.Errors)
+
+		employeeRepository.AssertNotCalled(t, "Onboard", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Import Commit", func(t *testing.T) {
+		employeeRepository, userRepository, orgRepository := setup()
+		sender := new(invitemocks.Sender)
+
+		token := invites.Token{
+			Value:     "abc",
+			Hash:      strings.Repeat("f", 64),
+			ExpiresAt: time.Now().Add(48 * time.Hour),
+			Link:      "https://absensi.test/invite?token=abc",
+		}
+
+		sender.On("Token").Return(token, nil)
+		sender.On("Deliver", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.MatchedBy(func(tk invites.Token) bool {
+			return tk.Link == token.Link
+		})).Return(nil)
+		employeeRepository.On("Onboard", mock.Anything, mock.MatchedBy(func(h []onboardings.Hire) bool {
+			return len(h) == 1 && h[0].TokenHash == token.Hash && h[0].ExpiresAt.Equal(token.ExpiresAt)
+		})).Return([]int64{11}, nil)
+
+		employeeUseCase := employee.NewEmployeeUseCase(employeeRepository, userRepository, orgRepository, new(bcryptmocks.Bcrypt), validator.New(), sender)
+
+		resp := employeeUseCase.Import(context.TODO(), users.RoleAdmin, strings.NewReader(csv), false)
+
+		assert.NoError(t, resp.Err())
+
+		report := resp.(*response.ResponseImpl).Data.(onboardings.Report)
+		assert.Equal(t, 1, report.Created)
+		assert.Equal(t, int64(11), report.Hires[0].UserID)
+		assert.Equal(t, token.Link, report.Hires[0].Link)
+		assert.True(t, report.Hires[0].Mailed)
+
+		employeeRepository.AssertExpectations(t)
+		sender.AssertExpectations(t)
+	})
+
+	t.Run("Import Commit Mail Failed", func(t *testing.T) {
+		employeeRepository, userRepository, orgRepository := setup()
+		sender := new(invitemocks.Sender)
+
+		sender.On("Token").Return(invites.Token{Hash: strings.Repeat("f", 64), Link: "https://absensi.test/invite?token=abc"}, nil)
+		sender.On("Deliver", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("smtp down"))
+		employeeRepository.On("Onboard", mock.Anything, mock.Anything).Return([]int64{11}, nil)
+
+		employeeUseCase := employee.NewEmployeeUseCase(employeeRepository, userRepository, orgRepository, new(bcryptmocks.Bcrypt), validator.New(), sender)
+
+		resp := employeeUseCase.Import(context.TODO(), users.RoleAdmin, strings.NewReader(csv), false)
+
+		assert.NoError(t, resp.Err())
+
+		report := resp.(*response.ResponseImpl).Data.(onboardings.Report)
+		assert.Equal(t, 1, report.Created)
+		assert.False(t, report.Hires[0].Mailed)
+		assert.Equal(t, "https://absensi.test/invite?token=abc", report.Hires[0].Link)
+	})
+
+	t.Run("Import Not Admin", func(t *testing.T) {
+		employeeUseCase := employee.NewEmployeeUseCase(new(mocks.EmployeeRepository), new(usermocks.UserRepository), new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), new(invitemocks.Sender))
+
+		resp := employeeUseCase.Import(context.TODO(), users.RoleManager, strings.NewReader(csv), true)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+	})
+}
+
+func TestInvite(t *testing.T) {
+	token := invites.Token{
+		Hash:      strings.Repeat("f", 64),
+		ExpiresAt: time.Now().Add(48 * time.Hour),
+		Link:      "https://absensi.test/invite?token=abc",
+	}
+
+	params := users.InviteReq{
+		Name:  "Budi",
+		Email: "budi@test.com",
+		Code:  "E-100",
+		Role:  users.RoleManager,
+	}
+
+	t.Run("Invite Success", func(t *testing.T) {
+		employeeRepository := new(mocks.EmployeeRepository)
+		userRepository := new(usermocks.UserRepository)
+		sender := new(invitemocks.Sender)
+
+		userRepository.On("FindByEmail", mock.Anything, "budi@test.com").Return(users.Employee{}, exception.ErrNotFound)
+		employeeRepository.On("FindByCode", mock.Anything, "E-100").Return(users.Employee{}, exception.ErrNotFound)
+		sender.On("Token").Return(token, nil)
+		employeeRepository.On("Onboard", mock.Anything, mock.MatchedBy(func(h []onboardings.Hire) bool {
+			return len(h) == 1 && h[0].Email == "budi@test.com" && h[0].Role == users.RoleManager && h[0].TokenHash == token.Hash
+		})).Return([]int64{7}, nil)
+		sender.On("Deliver", mock.Anything, "Budi", "budi@test.com", mock.AnythingOfType("invites.Token")).Return(nil)
+
+		employeeUseCase := employee.NewEmployeeUseCase(employeeRepository, userRepository, new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), sender)
+
+		resp := employeeUseCase.Invite(context.TODO(), users.RoleAdmin, params)
+
+		assert.NoError(t, resp.Err())
+
+		hire := resp.(*response.ResponseImpl).Data.(onboardings.Hire)
+		assert.Equal(t, int64(7), hire.UserID)
+		assert.Equal(t, token.Link, hire.Link)
+		assert.True(t, hire.Mailed)
+
+		employeeRepository.AssertExpectations(t)
+		userRepository.AssertExpectations(t)
+		sender.AssertExpectations(t)
+	})
+
+	t.Run("Invite Error Conflict", func(t *testing.T) {
+		employeeRepository := new(mocks.EmployeeRepository)
+		userRepository := new(usermocks.UserRepository)
+		sender := new(invitemocks.Sender)
+
+		userRepository.On("FindByEmail", mock.Anything, "budi@test.com").Return(users.Employee{ID: 3}, nil)
+
+		employeeUseCase := employee.NewEmployeeUseCase(employeeRepository, userRepository, new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), sender)
+
+		resp := employeeUseCase.Invite(context.TODO(), users.RoleAdmin, params)
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+
+		sender.AssertNotCalled(t, "Token")
+		employeeRepository.AssertNotCalled(t, "Onboard", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Invite Not Admin", func(t *testing.T) {
+		employeeUseCase := employee.NewEmployeeUseCase(new(mocks.EmployeeRepository), new(usermocks.UserRepository), new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), new(invitemocks.Sender))
+
+		resp := employeeUseCase.Invite(context.TODO(), users.RoleEmployee, params)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+	})
+}
+
+func TestReinvite(t *testing.T) {
+	token := invites.Token{
+		Hash:      strings.Repeat("f", 64),
+		ExpiresAt: time.Now().Add(48 * time.Hour),
+		Link:      "https://absensi.test/invite?token=abc",
+	}
+
+	t.Run("Reinvite Success", func(t *testing.T) {
+		employeeRepository := new(mocks.EmployeeRepository)
+		sender := new(invitemocks.Sender)
+
+		employeeRepository.On("FindByID", mock.Anything, int64(7)).Return(users.Employee{ID: 7, Name: "Budi", Email: "budi@test.com"}, nil)
+		sender.On("Token").Return(token, nil)
+		employeeRepository.On("CreateInvite", mock.Anything, int64(7), token.Hash, token.ExpiresAt).Return(nil)
+		sender.On("Deliver", mock.Anything, "Budi", "budi@test.com", mock.AnythingOfType("invites.Token")).Return(nil)
+
+		employeeUseCase := employee.NewEmployeeUseCase(employeeRepository, new(usermocks.UserRepository), new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), sender)
+
+		resp := employeeUseCase.Reinvite(context.TODO(), 7, users.RoleAdmin)
+
+		assert.NoError(t, resp.Err())
+		assert.True(t, resp.(*response.ResponseImpl).Data.(onboardings.Hire).Mailed)
+
+		employeeRepository.AssertExpectations(t)
+		sender.AssertExpectations(t)
+	})
+
+	t.Run("Reinvite Error Password Set", func(t *testing.T) {
+		employeeRepository := new(mocks.EmployeeRepository)
+		sender := new(invitemocks.Sender)
+
+		employeeRepository.On("FindByID", mock.Anything, int64(7)).Return(users.Employee{ID: 7, Password: "hashed"}, nil)
+
+		employeeUseCase := employee.NewEmployeeUseCase(employeeRepository, new(usermocks.UserRepository), new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), sender)
+
+		resp := employeeUseCase.Reinvite(context.TODO(), 7, users.RoleAdmin)
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+
+		sender.AssertNotCalled(t, "Token")
+	})
+
+	t.Run("Reinvite Error Not Found", func(t *testing.T) {
+		employeeRepository := new(mocks.EmployeeRepository)
+
+		employeeRepository.On("FindByID", mock.Anything, int64(7)).Return(users.Employee{}, exception.ErrNotFound)
+
+		employeeUseCase := employee.NewEmployeeUseCase(employeeRepository, new(usermocks.UserRepository), new(orgmocks.OrgRepository), new(bcryptmocks.Bcrypt), validator.New(), new(invitemocks.Sender))
+
+		resp := employeeUseCase.Reinvite(context.TODO(), 7, users.RoleAdmin)
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+	})
+}
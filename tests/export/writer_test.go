@@ -0,0 +1,30 @@
+package export_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/helpers/export"
+)
+
+func TestCSVWriter(t *testing.T) {
+	t.Run("Write Escapes Formulas", func(t *testing.T) {
+		var out bytes.Buffer
+
+		writer, err := export.NewWriter("csv", &out)
+		assert.NoError(t, err)
+
+		assert.NoError(t, writer.Write([]interface{}{"=HYPERLINK(\"http://evil.test\")", "+1", "-1", "@SUM(A1)", "\tcmd", "plain", -30, 1.5}))
+		assert.NoError(t, writer.Flush())
+
+		assert.Equal(t, "\"'=HYPERLINK(\"\"http://evil.test\"\")\",'+1,'-1,'@SUM(A1),'\tcmd,plain,-30,1.5\n", out.String())
+	})
+
+	t.Run("Unsupported Format", func(t *testing.T) {
+		_, err := export.NewWriter("pdf", &bytes.Buffer{})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,139 @@
+package fingerprint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	newJWT "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/fingerprint"
+	"github.com/Risuii/models/punches"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/fingerprint/mocks"
+)
+
+func newToken(t *testing.T, role string) string {
+	mockToken := &jwt.JWTclaim{
+		ID:    1,
+		Email: "test@test.com",
+		Role:  role,
+		StandardClaims: newJWT.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+		},
+	}
+
+	tokenAlgo := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken)
+
+	token, err := tokenAlgo.SignedString(jwt.JWT_KEY)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestHandler_Import(t *testing.T) {
+	t.Run("Import Multipart Commit", func(t *testing.T) {
+		fingerprintUseCase := new(mocks.FingerprintUseCase)
+		fingerprintUseCase.On("ImportFile", mock.Anything, users.RoleAdmin, mock.Anything, false).Return(response.Success(response.StatusCreated, punches.Report{}))
+
+		fingerprintHandler := fingerprint.FingerprintHandler{
+			UseCase: fingerprintUseCase,
+		}
+
+		var body bytes.Buffer
+		form := multipart.NewWriter(&body)
+		part, err := form.CreateFormFile("file", "attlog.dat")
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write([]byte("1\t2023-01-02 08:00:00\t0\n"))
+		form.Close()
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing?commit=true", &body)
+		r.Header.Set("Content-Type", form.FormDataContentType())
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleAdmin),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(fingerprintHandler.Import)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusCreated, rb.Status)
+
+		fingerprintUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Import Raw Body Dry Run", func(t *testing.T) {
+		fingerprintUseCase := new(mocks.FingerprintUseCase)
+		fingerprintUseCase.On("ImportFile", mock.Anything, users.RoleAdmin, mock.Anything, true).Return(response.Success(response.StatusOK, punches.Report{DryRun: true}))
+
+		fingerprintHandler := fingerprint.FingerprintHandler{
+			UseCase: fingerprintUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", strings.NewReader("1\t2023-01-02 08:00:00\t0\n"))
+		r.Header.Set("Content-Type", "text/plain")
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleAdmin),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(fingerprintHandler.Import)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusOK, rb.Status)
+
+		fingerprintUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Import Error Unauthorized", func(t *testing.T) {
+		fingerprintUseCase := new(mocks.FingerprintUseCase)
+
+		fingerprintHandler := fingerprint.FingerprintHandler{
+			UseCase: fingerprintUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", nil)
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(fingerprintHandler.Import)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusUnauthorized, rb.Status)
+
+		fingerprintUseCase.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	absensis "github.com/Risuii/models/absensis"
+
+	punches "github.com/Risuii/models/punches"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FingerprintRepository is an autogenerated mock type for the FingerprintRepository type
+type FingerprintRepository struct {
+	mock.Mock
+}
+
+// Commit provides a mock function with given fields: ctx, plan
+func (_m *FingerprintRepository) Commit(ctx context.Context, plan punches.Plan) error {
+	ret := _m.Called(ctx, plan)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, punches.Plan) error); ok {
+		r0 = rf(ctx, plan)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindDeviceUsers provides a mock function with given fields: ctx
+func (_m *FingerprintRepository) FindDeviceUsers(ctx context.Context) ([]punches.DeviceUser, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []punches.DeviceUser
+	if rf, ok := ret.Get(0).(func(context.Context) []punches.DeviceUser); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]punches.DeviceUser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindSessions provides a mock function with given fields: ctx, userID, from, to
+func (_m *FingerprintRepository) FindSessions(ctx context.Context, userID int64, from string, to string) ([]absensis.Absensi, error) {
+	ret := _m.Called(ctx, userID, from, to)
+
+	var r0 []absensis.Absensi
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) []absensis.Absensi); ok {
+		r0 = rf(ctx, userID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]absensis.Absensi)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string) error); ok {
+		r1 = rf(ctx, userID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewFingerprintRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewFingerprintRepository creates a new instance of FingerprintRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewFingerprintRepository(t mockConstructorTestingTNewFingerprintRepository) *FingerprintRepository {
+	mock := &FingerprintRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
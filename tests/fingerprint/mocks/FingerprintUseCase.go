@@ -0,0 +1,70 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	io "io"
+
+	response "github.com/Risuii/helpers/response"
+	punches "github.com/Risuii/models/punches"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FingerprintUseCase is an autogenerated mock type for the FingerprintUseCase type
+type FingerprintUseCase struct {
+	mock.Mock
+}
+
+// Import provides a mock function with given fields: ctx, r, dryRun
+func (_m *FingerprintUseCase) Import(ctx context.Context, r io.Reader, dryRun bool) (punches.Report, error) {
+	ret := _m.Called(ctx, r, dryRun)
+
+	var r0 punches.Report
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader, bool) punches.Report); ok {
+		r0 = rf(ctx, r, dryRun)
+	} else {
+		r0 = ret.Get(0).(punches.Report)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, io.Reader, bool) error); ok {
+		r1 = rf(ctx, r, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportFile provides a mock function with given fields: ctx, role, r, dryRun
+func (_m *FingerprintUseCase) ImportFile(ctx context.Context, role string, r io.Reader, dryRun bool) response.Response {
+	ret := _m.Called(ctx, role, r, dryRun)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, bool) response.Response); ok {
+		r0 = rf(ctx, role, r, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewFingerprintUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewFingerprintUseCase creates a new instance of FingerprintUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewFingerprintUseCase(t mockConstructorTestingTNewFingerprintUseCase) *FingerprintUseCase {
+	mock := &FingerprintUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,48 @@
+package fingerprint_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/internal/fingerprint"
+	"github.com/Risuii/models/punches"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("Parse Success", func(t *testing.T) {
+		log := "    1\t2023-01-02 08:01:00\t0\t1\t0\t0\n" +
+			"\n" +
+			"    1\t2023-01-02 17:05:00\t1\t1\t0\t0\n" +
+			"   12\t2023-01-02 08:30:00\t4\n"
+
+		lines, result, failed, err := fingerprint.Parse(strings.NewReader(log), time.UTC)
+
+		assert.NoError(t, err)
+		assert.Empty(t, failed)
+		assert.Equal(t, 3, lines)
+		assert.Equal(t, []punches.Punch{
+			{Line: 1, DeviceUserID: "1", Time: time.Date(2023, 1, 2, 8, 1, 0, 0, time.UTC), State: punches.StateIn},
+			{Line: 3, DeviceUserID: "1", Time: time.Date(2023, 1, 2, 17, 5, 0, 0, time.UTC), State: punches.StateOut},
+			{Line: 4, DeviceUserID: "12", Time: time.Date(2023, 1, 2, 8, 30, 0, 0, time.UTC), State: punches.StateIn},
+		}, result)
+	})
+
+	t.Run("Parse Invalid Lines", func(t *testing.T) {
+		log := "1\t2023-01-02\n" +
+			"1\t02/01/2023 08:00\t0\n" +
+			"1\t2023-01-02 08:00:00\t9\n" +
+			"\t2023-01-02 08:00:00\t0\n"
+
+		lines, result, failed, err := fingerprint.Parse(strings.NewReader(log), time.UTC)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, lines)
+		assert.Empty(t, result)
+		assert.Len(t, failed, 4)
+		assert.Equal(t, 1, failed[0].Line)
+		assert.Equal(t, 4, failed[3].Line)
+	})
+}
@@ -0,0 +1,124 @@
+package fingerprint_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/fingerprint"
+	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/punches"
+	"github.com/Risuii/tests/mock"
+)
+
+var currentTime = time.Date(2023, 1, 2, 8, 0, 0, 0, time.UTC)
+
+func TestFindDeviceUsersRepo(t *testing.T) {
+	query := regexp.QuoteMeta(fmt.Sprintf(`SELECT device_user_id, id, name FROM %s WHERE company_id = ? AND device_user_id IS NOT NULL`, constant.TableEmployee))
+
+	t.Run("FindDeviceUsers Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := fingerprint.NewFingerprintRepositoryImpl(db)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"device_user_id", "id", "name"}).AddRow("1", 1, "test")
+
+		mock.ExpectQuery(query).WithArgs(int64(2)).WillReturnRows(rows)
+
+		result, err := repo.FindDeviceUsers(tenant.WithID(context.TODO(), 2))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []punches.DeviceUser{{DeviceUserID: "1", UserID: 1, Name: "test"}}, result)
+	})
+
+	t.Run("FindDeviceUsers Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := fingerprint.NewFingerprintRepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectQuery(query).WillReturnError(fmt.Errorf("error"))
+
+		result, err := repo.FindDeviceUsers(tenant.WithID(context.TODO(), 2))
+
+		assert.Empty(t, result)
+		assert.Error(t, err)
+	})
+}
+
+func TestFindSessionsRepo(t *testing.T) {
+	query := regexp.QuoteMeta(fmt.Sprintf(`SELECT id, userID, name, checkin, checkout, date, status FROM %s WHERE userID = ? AND company_id = ? AND date BETWEEN ? AND ? AND checkin IS NOT NULL ORDER BY checkin`, constant.TableAbsensi))
+
+	t.Run("FindSessions Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := fingerprint.NewFingerprintRepositoryImpl(db)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "userID", "name", "checkin", "checkout", "date", "status"}).
+			AddRow(1, 1, "test", currentTime, nil, currentTime, absensis.StatusPresent)
+
+		mock.ExpectQuery(query).WithArgs(int64(1), int64(2), "2023-01-02", "2023-01-03").WillReturnRows(rows)
+
+		result, err := repo.FindSessions(tenant.WithID(context.TODO(), 2), 1, "2023-01-02", "2023-01-03")
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.True(t, result[0].Checkout.IsZero())
+	})
+}
+
+func TestCommitRepo(t *testing.T) {
+	insert := regexp.QuoteMeta(fmt.Sprintf(`INSERT INTO %s (userID, name, checkin, checkout, date, status, source, company_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`, constant.TableAbsensi))
+	update := regexp.QuoteMeta(fmt.Sprintf(`UPDATE %s SET checkout = ? WHERE id = ? AND company_id = ? AND checkout IS NULL`, constant.TableAbsensi))
+
+	plan := punches.Plan{
+		Sessions: []absensis.Absensi{
+			{UserID: 1, Name: "test", Checkin: currentTime, Date: currentTime, Status: absensis.StatusPresent},
+		},
+		Checkouts: []punches.Checkout{
+			{AbsenID: 7, Checkout: currentTime.Add(9 * time.Hour)},
+		},
+	}
+
+	t.Run("Commit Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := fingerprint.NewFingerprintRepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(insert).WithArgs(int64(1), "test", currentTime, nil, "2023-01-02", absensis.StatusPresent, punches.SourceDevice, int64(2)).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(update).WithArgs(currentTime.Add(9*time.Hour), int64(7), int64(2)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.Commit(tenant.WithID(context.TODO(), 2), plan)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Commit Error Rollback", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := fingerprint.NewFingerprintRepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(insert).WillReturnError(fmt.Errorf("error"))
+		mock.ExpectRollback()
+
+		err := repo.Commit(tenant.WithID(context.TODO(), 2), plan)
+
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
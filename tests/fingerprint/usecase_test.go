@@ -0,0 +1,200 @@
+package fingerprint_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/internal/fingerprint"
+	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/punches"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/fingerprint/mocks"
+)
+
+var deviceUsers = []punches.DeviceUser{
+	{DeviceUserID: "1", UserID: 1, Name: "test"},
+}
+
+var deviceLog = "1\t2023-01-02 08:00:00\t0\n" + // stored already
+	"1\t2023-01-02 12:00:00\t1\n" + // closes the stored session
+	"1\t2023-01-02 13:00:00\t0\n" +
+	"1\t2023-01-02 17:00:00\t1\n" +
+	"1\t2023-01-03 08:05:00\t0\n" +
+	"1\t2023-01-03 07:00:00\t1\n" + // check out before any check in
+	"9\t2023-01-03 08:00:00\t0\n" // unknown device user
+
+func at(day int, hour int, minute int) time.Time {
+	return time.Date(2023, 1, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestImport(t *testing.T) {
+	stored := []absensis.Absensi{
+		{ID: 7, UserID: 1, Name: "test", Checkin: at(2, 8, 0), Date: at(2, 0, 0), Status: absensis.StatusPresent},
+	}
+
+	expected := punches.Plan{
+		Sessions: []absensis.Absensi{
+			{UserID: 1, Name: "test", Checkin: at(2, 13, 0), Checkout: at(2, 17, 0), Date: at(2, 13, 0), Status: absensis.StatusPresent},
+			{UserID: 1, Name: "test", Checkin: at(3, 8, 5), Date: at(3, 8, 5), Status: absensis.StatusPresent},
+		},
+		Checkouts: []punches.Checkout{
+			{AbsenID: 7, Checkout: at(2, 12, 0)},
+		},
+	}
+
+	t.Run("Import Dry Run", func(t *testing.T) {
+		fingerprintRepository := new(mocks.FingerprintRepository)
+
+		fingerprintRepository.On("FindDeviceUsers", mock.Anything).Return(deviceUsers, nil)
+		fingerprintRepository.On("FindSessions", mock.Anything, int64(1), "2023-01-02", "2023-01-03").Return(stored, nil)
+
+		fingerprintUseCase := fingerprint.NewFingerprintUseCase(fingerprintRepository, time.UTC)
+
+		report, err := fingerprintUseCase.Import(context.TODO(), strings.NewReader(deviceLog), true)
+
+		assert.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, 7, report.Lines)
+		assert.Equal(t, 7, report.Punches)
+		assert.Equal(t, 1, report.Duplicates)
+		assert.Equal(t, 2, report.Created)
+		assert.Equal(t, 1, report.Closed)
+		assert.Len(t, report.Errors, 2)
+		assert.Equal(t, expected, report.Plan)
+		fingerprintRepository.AssertExpectations(t)
+	})
+
+	t.Run("Import Commit", func(t *testing.T) {
+		fingerprintRepository := new(mocks.FingerprintRepository)
+
+		fingerprintRepository.On("FindDeviceUsers", mock.Anything).Return(deviceUsers, nil)
+		fingerprintRepository.On("FindSessions", mock.Anything, int64(1), "2023-01-02", "2023-01-03").Return(stored, nil)
+		fingerprintRepository.On("Commit", mock.Anything, expected).Return(nil)
+
+		fingerprintUseCase := fingerprint.NewFingerprintUseCase(fingerprintRepository, time.UTC)
+
+		report, err := fingerprintUseCase.Import(context.TODO(), strings.NewReader(deviceLog), false)
+
+		assert.NoError(t, err)
+		assert.False(t, report.DryRun)
+		fingerprintRepository.AssertExpectations(t)
+	})
+
+	t.Run("Import Nothing New", func(t *testing.T) {
+		fingerprintRepository := new(mocks.FingerprintRepository)
+
+		closed := []absensis.Absensi{
+			{ID: 7, UserID: 1, Name: "test", Checkin: at(2, 8, 0), Checkout: at(2, 12, 0), Date: at(2, 0, 0)},
+		}
+
+		fingerprintRepository.On("FindDeviceUsers", mock.Anything).Return(deviceUsers, nil)
+		fingerprintRepository.On("FindSessions", mock.Anything, int64(1), "2023-01-02", "2023-01-02").Return(closed, nil)
+
+		fingerprintUseCase := fingerprint.NewFingerprintUseCase(fingerprintRepository, time.UTC)
+
+		report, err := fingerprintUseCase.Import(context.TODO(), strings.NewReader("1\t2023-01-02 08:00:00\t0\n1\t2023-01-02 12:00:00\t1\n"), false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, report.Duplicates)
+		assert.Zero(t, report.Created)
+		fingerprintRepository.AssertExpectations(t)
+	})
+
+	t.Run("Import Nothing New Device Timezone", func(t *testing.T) {
+		fingerprintRepository := new(mocks.FingerprintRepository)
+
+		jakarta, err := time.LoadLocation("Asia/Jakarta")
+		assert.NoError(t, err)
+
+		// 08:00 and 12:00 WIB as read back from the database
+		closed := []absensis.Absensi{
+			{ID: 7, UserID: 1, Name: "test", Checkin: at(2, 1, 0), Checkout: at(2, 5, 0), Date: at(2, 0, 0)},
+		}
+
+		fingerprintRepository.On("FindDeviceUsers", mock.Anything).Return(deviceUsers, nil)
+		fingerprintRepository.On("FindSessions", mock.Anything, int64(1), "2023-01-02", "2023-01-02").Return(closed, nil)
+
+		fingerprintUseCase := fingerprint.NewFingerprintUseCase(fingerprintRepository, jakarta)
+
+		report, err := fingerprintUseCase.Import(context.TODO(), strings.NewReader("1\t2023-01-02 08:00:00\t0\n1\t2023-01-02 12:00:00\t1\n"), false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, report.Duplicates)
+		assert.Zero(t, report.Created)
+		fingerprintRepository.AssertNotCalled(t, "Commit", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Import Closes Session Device Timezone", func(t *testing.T) {
+		fingerprintRepository := new(mocks.FingerprintRepository)
+
+		jakarta, err := time.LoadLocation("Asia/Jakarta")
+		assert.NoError(t, err)
+
+		// 00:30 WIB on the 2nd is still the 1st in UTC
+		open := []absensis.Absensi{
+			{ID: 7, UserID: 1, Name: "test", Checkin: time.Date(2023, 1, 1, 17, 30, 0, 0, time.UTC), Date: at(2, 0, 0)},
+		}
+
+		fingerprintRepository.On("FindDeviceUsers", mock.Anything).Return(deviceUsers, nil)
+		fingerprintRepository.On("FindSessions", mock.Anything, int64(1), "2023-01-02", "2023-01-02").Return(open, nil)
+		fingerprintRepository.On("Commit", mock.Anything, mock.MatchedBy(func(plan punches.Plan) bool {
+			return len(plan.Sessions) == 0 && len(plan.Checkouts) == 1 && plan.Checkouts[0].AbsenID == 7 &&
+				plan.Checkouts[0].Checkout.Equal(at(2, 1, 0))
+		})).Return(nil)
+
+		fingerprintUseCase := fingerprint.NewFingerprintUseCase(fingerprintRepository, jakarta)
+
+		report, err := fingerprintUseCase.Import(context.TODO(), strings.NewReader("1\t2023-01-02 08:00:00\t1\n"), false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.Closed)
+		assert.Empty(t, report.Errors)
+		fingerprintRepository.AssertExpectations(t)
+	})
+
+	t.Run("Import Error Internal Server", func(t *testing.T) {
+		fingerprintRepository := new(mocks.FingerprintRepository)
+
+		fingerprintRepository.On("FindDeviceUsers", mock.Anything).Return([]punches.DeviceUser{}, exception.ErrInternalServer)
+
+		fingerprintUseCase := fingerprint.NewFingerprintUseCase(fingerprintRepository, time.UTC)
+
+		_, err := fingerprintUseCase.Import(context.TODO(), strings.NewReader(deviceLog), true)
+
+		assert.Equal(t, exception.ErrInternalServer, err)
+		fingerprintRepository.AssertExpectations(t)
+	})
+}
+
+func TestImportFile(t *testing.T) {
+	t.Run("ImportFile Error Forbidden", func(t *testing.T) {
+		fingerprintRepository := new(mocks.FingerprintRepository)
+
+		fingerprintUseCase := fingerprint.NewFingerprintUseCase(fingerprintRepository, time.UTC)
+
+		resp := fingerprintUseCase.ImportFile(context.TODO(), users.RoleManager, strings.NewReader(deviceLog), true)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		fingerprintRepository.AssertExpectations(t)
+	})
+
+	t.Run("ImportFile Success", func(t *testing.T) {
+		fingerprintRepository := new(mocks.FingerprintRepository)
+
+		fingerprintRepository.On("FindDeviceUsers", mock.Anything).Return(deviceUsers, nil)
+		fingerprintRepository.On("FindSessions", mock.Anything, int64(1), mock.Anything, mock.Anything).Return([]absensis.Absensi{}, nil)
+
+		fingerprintUseCase := fingerprint.NewFingerprintUseCase(fingerprintRepository, time.UTC)
+
+		resp := fingerprintUseCase.ImportFile(context.TODO(), users.RoleAdmin, strings.NewReader(deviceLog), true)
+
+		assert.NoError(t, resp.Err())
+		fingerprintRepository.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,67 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	time "time"
+
+	invites "github.com/Risuii/models/invites"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InviteRepository is an autogenerated mock type for the InviteRepository type
+type InviteRepository struct {
+	mock.Mock
+}
+
+// Accept provides a mock function with given fields: ctx, params, password, usedAt
+func (_m *InviteRepository) Accept(ctx context.Context, params invites.Invite, password string, usedAt time.Time) error {
+	ret := _m.Called(ctx, params, password, usedAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, invites.Invite, string, time.Time) error); ok {
+		r0 = rf(ctx, params, password, usedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByToken provides a mock function with given fields: ctx, tokenHash
+func (_m *InviteRepository) FindByToken(ctx context.Context, tokenHash string) (invites.Invite, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	var r0 invites.Invite
+	if rf, ok := ret.Get(0).(func(context.Context, string) invites.Invite); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		r0 = ret.Get(0).(invites.Invite)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewInviteRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewInviteRepository creates a new instance of InviteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewInviteRepository(t mockConstructorTestingTNewInviteRepository) *InviteRepository {
+	mock := &InviteRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
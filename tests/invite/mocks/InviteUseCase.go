@@ -0,0 +1,63 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	response "github.com/Risuii/helpers/response"
+	invites "github.com/Risuii/models/invites"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InviteUseCase is an autogenerated mock type for the InviteUseCase type
+type InviteUseCase struct {
+	mock.Mock
+}
+
+// Accept provides a mock function with given fields: ctx, params
+func (_m *InviteUseCase) Accept(ctx context.Context, params invites.AcceptReq) response.Response {
+	ret := _m.Called(ctx, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, invites.AcceptReq) response.Response); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, token
+func (_m *InviteUseCase) Get(ctx context.Context, token string) response.Response {
+	ret := _m.Called(ctx, token)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.Response); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewInviteUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewInviteUseCase creates a new instance of InviteUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewInviteUseCase(t mockConstructorTestingTNewInviteUseCase) *InviteUseCase {
+	mock := &InviteUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,65 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	invites "github.com/Risuii/models/invites"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Sender is an autogenerated mock type for the Sender type
+type Sender struct {
+	mock.Mock
+}
+
+// Deliver provides a mock function with given fields: ctx, name, email, token
+func (_m *Sender) Deliver(ctx context.Context, name string, email string, token invites.Token) error {
+	ret := _m.Called(ctx, name, email, token)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, invites.Token) error); ok {
+		r0 = rf(ctx, name, email, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Token provides a mock function with given fields:
+func (_m *Sender) Token() (invites.Token, error) {
+	ret := _m.Called()
+
+	var r0 invites.Token
+	if rf, ok := ret.Get(0).(func() invites.Token); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(invites.Token)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewSender interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSender creates a new instance of Sender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSender(t mockConstructorTestingTNewSender) *Sender {
+	mock := &Sender{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,124 @@
+package invite_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/internal/invite"
+	"github.com/Risuii/models/invites"
+	"github.com/Risuii/tests/mock"
+)
+
+var currentTime = time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+
+func TestFindByTokenRepo(t *testing.T) {
+	t.Run("Find Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := invite.NewInviteRepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT i.id, i.userID, e.name, e.email, i.expires_at, i.used_at, i.created_at FROM %s i JOIN %s e ON e.id = i.userID WHERE i.token_hash = ?`, constant.TableInvite, constant.TableEmployee)
+		rows := sqlmock.NewRows([]string{"id", "userID", "name", "email", "expires_at", "used_at", "created_at"}).AddRow(1, 11, "Budi", "budi@test.com", currentTime, nil, currentTime)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs("hash").WillReturnRows(rows)
+
+		result, err := repo.FindByToken(context.TODO(), "hash")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(11), result.UserID)
+		assert.True(t, result.UsedAt.IsZero())
+	})
+}
+
+func TestAcceptRepo(t *testing.T) {
+	used := regexp.QuoteMeta(fmt.Sprintf(`UPDATE %s SET used_at = ? WHERE id = ? AND used_at IS NULL`, constant.TableInvite))
+	revoke := regexp.QuoteMeta(fmt.Sprintf(`UPDATE %s SET used_at = ? WHERE userID = ? AND used_at IS NULL`, constant.TableInvite))
+	password := regexp.QuoteMeta(fmt.Sprintf(`UPDATE %s SET password = ?, update_at = ? WHERE id = ? AND password = ''`, constant.TableEmployee))
+
+	t.Run("Accept Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := invite.NewInviteRepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(used).WithArgs(currentTime, int64(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(revoke).WithArgs(currentTime, int64(11)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(password).WithArgs("hashed", currentTime, int64(11)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.Accept(context.TODO(), invites.Invite{ID: 1, UserID: 11}, "hashed", currentTime)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Accept Already Used", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := invite.NewInviteRepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(used).WithArgs(currentTime, int64(1)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err := repo.Accept(context.TODO(), invites.Invite{ID: 1, UserID: 11}, "hashed", currentTime)
+
+		assert.Equal(t, exception.ErrNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Accept Second Invite After Acceptance", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := invite.NewInviteRepositoryImpl(db)
+
+		defer db.Close()
+
+		// accepting invite 1 revoked invite 2 of the same employee
+		mock.ExpectBegin()
+		mock.ExpectExec(used).WithArgs(currentTime, int64(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(revoke).WithArgs(currentTime, int64(11)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(password).WithArgs("hashed", currentTime, int64(11)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(used).WithArgs(currentTime, int64(2)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err := repo.Accept(context.TODO(), invites.Invite{ID: 1, UserID: 11}, "hashed", currentTime)
+		assert.NoError(t, err)
+
+		err = repo.Accept(context.TODO(), invites.Invite{ID: 2, UserID: 11}, "attacker", currentTime)
+		assert.Equal(t, exception.ErrNotFound, err)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Accept Password Already Set", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := invite.NewInviteRepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(used).WithArgs(currentTime, int64(2)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(revoke).WithArgs(currentTime, int64(11)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(password).WithArgs("attacker", currentTime, int64(11)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err := repo.Accept(context.TODO(), invites.Invite{ID: 2, UserID: 11}, "attacker", currentTime)
+
+		assert.Equal(t, exception.ErrNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
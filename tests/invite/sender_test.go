@@ -0,0 +1,50 @@
+package invite_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/config/mailer"
+	mailermocks "github.com/Risuii/config/mailer/mocks"
+	"github.com/Risuii/internal/invite"
+	"github.com/Risuii/models/invites"
+)
+
+func TestSender(t *testing.T) {
+	settings := invites.Settings{
+		URL: "https://absensi.test/invite",
+		TTL: 48 * time.Hour,
+	}
+
+	t.Run("Token Link", func(t *testing.T) {
+		token, err := invite.NewSender(settings, new(mailermocks.Mailer)).Token()
+
+		assert.NoError(t, err)
+		assert.Equal(t, invite.Hash(token.Value), token.Hash)
+		assert.Equal(t, "https://absensi.test/invite?token="+token.Value, token.Link)
+		assert.True(t, token.ExpiresAt.After(time.Now().Add(47*time.Hour)))
+	})
+
+	t.Run("Deliver Mails Link", func(t *testing.T) {
+		mail := new(mailermocks.Mailer)
+		sender := invite.NewSender(settings, mail)
+
+		token, err := sender.Token()
+		assert.NoError(t, err)
+
+		mail.On("Send", mock.Anything, mock.MatchedBy(func(m mailer.Message) bool {
+			return m.To == "budi@test.com" && strings.Contains(m.Body, token.Link) && !strings.Contains(m.Body, token.Hash)
+		})).Return(nil)
+
+		err = sender.Deliver(context.TODO(), "Budi", "budi@test.com", token)
+
+		assert.NoError(t, err)
+
+		mail.AssertExpectations(t)
+	})
+}
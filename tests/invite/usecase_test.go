@@ -0,0 +1,87 @@
+package invite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	bcryptmocks "github.com/Risuii/config/bcrypt/mocks"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/internal/invite"
+	"github.com/Risuii/models/invites"
+	"github.com/Risuii/tests/invite/mocks"
+)
+
+func TestToken(t *testing.T) {
+	t.Run("Token Hash", func(t *testing.T) {
+		token, hash, err := invite.NewToken()
+
+		assert.NoError(t, err)
+		assert.Len(t, token, 64)
+		assert.Equal(t, invite.Hash(token), hash)
+		assert.NotEqual(t, token, hash)
+	})
+}
+
+func TestGet(t *testing.T) {
+	t.Run("Get Success", func(t *testing.T) {
+		inviteRepository := new(mocks.InviteRepository)
+
+		inviteRepository.On("FindByToken", mock.Anything, invite.Hash("secret")).Return(invites.Invite{ID: 1, Email: "budi@test.com", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+
+		inviteUseCase := invite.NewInviteUseCase(inviteRepository, new(bcryptmocks.Bcrypt))
+
+		resp := inviteUseCase.Get(context.TODO(), "secret")
+
+		assert.NoError(t, resp.Err())
+		inviteRepository.AssertExpectations(t)
+	})
+
+	t.Run("Get Expired", func(t *testing.T) {
+		inviteRepository := new(mocks.InviteRepository)
+
+		inviteRepository.On("FindByToken", mock.Anything, invite.Hash("secret")).Return(invites.Invite{ID: 1, ExpiresAt: time.Now().Add(-time.Hour)}, nil)
+
+		inviteUseCase := invite.NewInviteUseCase(inviteRepository, new(bcryptmocks.Bcrypt))
+
+		resp := inviteUseCase.Get(context.TODO(), "secret")
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+	})
+}
+
+func TestAccept(t *testing.T) {
+	t.Run("Accept Success", func(t *testing.T) {
+		inviteRepository := new(mocks.InviteRepository)
+		bcrypt := new(bcryptmocks.Bcrypt)
+
+		pending := invites.Invite{ID: 1, UserID: 11, ExpiresAt: time.Now().Add(time.Hour)}
+
+		inviteRepository.On("FindByToken", mock.Anything, invite.Hash("secret")).Return(pending, nil)
+		bcrypt.On("HashPassword", "rahasia123").Return("hashed", nil)
+		inviteRepository.On("Accept", mock.Anything, pending, "hashed", mock.AnythingOfType("time.Time")).Return(nil)
+
+		inviteUseCase := invite.NewInviteUseCase(inviteRepository, bcrypt)
+
+		resp := inviteUseCase.Accept(context.TODO(), invites.AcceptReq{Token: "secret", Password: "rahasia123"})
+
+		assert.NoError(t, resp.Err())
+		inviteRepository.AssertExpectations(t)
+		bcrypt.AssertExpectations(t)
+	})
+
+	t.Run("Accept Used", func(t *testing.T) {
+		inviteRepository := new(mocks.InviteRepository)
+
+		inviteRepository.On("FindByToken", mock.Anything, invite.Hash("secret")).Return(invites.Invite{ID: 1, ExpiresAt: time.Now().Add(time.Hour), UsedAt: time.Now()}, nil)
+
+		inviteUseCase := invite.NewInviteUseCase(inviteRepository, new(bcryptmocks.Bcrypt))
+
+		resp := inviteUseCase.Accept(context.TODO(), invites.AcceptReq{Token: "secret", Password: "rahasia123"})
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+	})
+}
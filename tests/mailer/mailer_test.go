@@ -0,0 +1,37 @@
+package mailer_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/config/mailer"
+)
+
+func TestFileMailer(t *testing.T) {
+	t.Run("Send Writes File", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "mails")
+
+		err := mailer.NewFileMailer(dir).Send(context.TODO(), mailer.Message{
+			To:      "test@test.com",
+			Name:    "Test",
+			Subject: "Verifikasi Email Akun Absensi",
+			Body:    "https://absensi.test/verify?token=abc",
+		})
+
+		assert.NoError(t, err)
+
+		files, err := os.ReadDir(dir)
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+		assert.Contains(t, files[0].Name(), "test_at_test.com")
+
+		content, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "Verifikasi Email Akun Absensi")
+		assert.Contains(t, string(content), "https://absensi.test/verify?token=abc")
+	})
+}
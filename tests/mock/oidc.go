@@ -0,0 +1,201 @@
+package mock
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	newJWT "github.com/dgrijalva/jwt-go"
+)
+
+// OIDCProvider is a local OpenID Connect provider. It knows a single client
+// and a single user, whose claims are taken from Claims when a code is
+// issued.
+type OIDCProvider struct {
+	Server       *httptest.Server
+	ClientID     string
+	ClientSecret string
+	// Claims go into the ID token on top of iss, sub, aud, exp, iat and
+	// nonce, and may override them.
+	Claims map[string]interface{}
+	// Signer signs the ID tokens, replacing it without Rotate makes the
+	// signatures invalid.
+	Signer *rsa.PrivateKey
+	KeyID  string
+
+	mu    sync.Mutex
+	key   *rsa.PrivateKey
+	codes map[string]map[string]interface{}
+}
+
+func NewOIDCProvider() *OIDCProvider {
+	p := &OIDCProvider{
+		ClientID:     "absensi",
+		ClientSecret: "secret",
+		Claims: map[string]interface{}{
+			"sub":            "user-1",
+			"email":          "test@test.com",
+			"email_verified": true,
+			"name":           "Test",
+		},
+		codes: map[string]map[string]interface{}{},
+	}
+	p.Rotate()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("/authorize", p.authorize)
+	mux.HandleFunc("/token", p.token)
+	mux.HandleFunc("/jwks", p.jwks)
+
+	p.Server = httptest.NewServer(mux)
+
+	return p
+}
+
+func (p *OIDCProvider) URL() string {
+	return p.Server.URL
+}
+
+func (p *OIDCProvider) Close() {
+	p.Server.Close()
+}
+
+// Rotate replaces the signing key with a new one under a new key ID.
+func (p *OIDCProvider) Rotate() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when generating a signing key", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.key = key
+	p.Signer = key
+	p.KeyID = fmt.Sprintf("key-%d", time.Now().UnixNano())
+}
+
+// Code issues an authorization code as if the user had just logged in for a
+// request carrying nonce.
+func (p *OIDCProvider) Code(nonce string) string {
+	claims := map[string]interface{}{
+		"iss":   p.URL(),
+		"aud":   p.ClientID,
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"iat":   time.Now().Unix(),
+		"nonce": nonce,
+	}
+	for k, v := range p.Claims {
+		claims[k] = v
+	}
+
+	code := fmt.Sprintf("code-%d", time.Now().UnixNano())
+
+	p.mu.Lock()
+	p.codes[code] = claims
+	p.mu.Unlock()
+
+	return code
+}
+
+func (p *OIDCProvider) discovery(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(map[string]string{
+		"issuer":                 p.URL(),
+		"authorization_endpoint": p.URL() + "/authorize",
+		"token_endpoint":         p.URL() + "/token",
+		"jwks_uri":               p.URL() + "/jwks",
+	})
+}
+
+// authorize logs the user in right away and redirects back with a code.
+func (p *OIDCProvider) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	if query.Get("client_id") != p.ClientID || query.Get("response_type") != "code" {
+		http.Error(w, "invalid_request", http.StatusBadRequest)
+		return
+	}
+
+	redirect, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil {
+		http.Error(w, "invalid_request", http.StatusBadRequest)
+		return
+	}
+
+	back := redirect.Query()
+	back.Set("code", p.Code(query.Get("nonce")))
+	back.Set("state", query.Get("state"))
+	redirect.RawQuery = back.Encode()
+
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (p *OIDCProvider) token(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	clientID, secret, _ := r.BasicAuth()
+	clientID, _ = url.QueryUnescape(clientID)
+	secret, _ = url.QueryUnescape(secret)
+
+	if clientID != p.ClientID || secret != p.ClientSecret {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	p.mu.Lock()
+	claims, ok := p.codes[r.PostFormValue("code")]
+	delete(p.codes, r.PostFormValue("code"))
+	p.mu.Unlock()
+
+	if !ok || r.PostFormValue("grant_type") != "authorization_code" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string]string{
+		"access_token": "access",
+		"token_type":   "Bearer",
+		"id_token":     p.Sign(claims),
+	})
+}
+
+func (p *OIDCProvider) jwks(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": p.KeyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+		}},
+	})
+}
+
+// Sign returns claims as an ID token signed by Signer.
+func (p *OIDCProvider) Sign(claims map[string]interface{}) string {
+	token := newJWT.NewWithClaims(newJWT.SigningMethodRS256, newJWT.MapClaims(claims))
+	token.Header["kid"] = p.KeyID
+
+	signed, err := token.SignedString(p.Signer)
+	if err != nil {
+		log.Fatalf("an error '%s' was not expected when signing an id token", err)
+	}
+
+	return signed
+}
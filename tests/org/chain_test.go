@@ -0,0 +1,82 @@
+package org_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/internal/org"
+	"github.com/Risuii/models/orgs"
+	"github.com/Risuii/tests/org/mocks"
+)
+
+// lines sets up 1 -> 3 -> 2, employee 1 reports to 3 who reports to 2.
+func lines() *mocks.OrgRepository {
+	orgRepository := new(mocks.OrgRepository)
+	orgRepository.On("FindMember", mock.Anything, int64(1)).Return(orgs.Member{UserID: 1, ManagerID: 3}, nil).Maybe()
+	orgRepository.On("FindMember", mock.Anything, int64(3)).Return(orgs.Member{UserID: 3, ManagerID: 2}, nil).Maybe()
+	orgRepository.On("FindMember", mock.Anything, int64(2)).Return(orgs.Member{UserID: 2}, nil).Maybe()
+	orgRepository.On("FindMember", mock.Anything, int64(4)).Return(orgs.Member{UserID: 4, ManagerID: 2}, nil).Maybe()
+
+	return orgRepository
+}
+
+func TestInChain(t *testing.T) {
+	t.Run("Direct Manager", func(t *testing.T) {
+		ok, err := org.NewChain(lines()).InChain(context.TODO(), 3, 1)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Manager Of Manager", func(t *testing.T) {
+		ok, err := org.NewChain(lines()).InChain(context.TODO(), 2, 1)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Report Is Not Above", func(t *testing.T) {
+		ok, err := org.NewChain(lines()).InChain(context.TODO(), 1, 2)
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Peer Is Not Above", func(t *testing.T) {
+		ok, err := org.NewChain(lines()).InChain(context.TODO(), 4, 1)
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Self", func(t *testing.T) {
+		ok, err := org.NewChain(lines()).InChain(context.TODO(), 1, 1)
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Stops On Loop", func(t *testing.T) {
+		orgRepository := new(mocks.OrgRepository)
+		orgRepository.On("FindMember", mock.Anything, int64(1)).Return(orgs.Member{UserID: 1, ManagerID: 2}, nil)
+		orgRepository.On("FindMember", mock.Anything, int64(2)).Return(orgs.Member{UserID: 2, ManagerID: 1}, nil)
+
+		ok, err := org.NewChain(orgRepository).InChain(context.TODO(), 5, 1)
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		orgRepository := new(mocks.OrgRepository)
+		orgRepository.On("FindMember", mock.Anything, int64(1)).Return(orgs.Member{}, exception.ErrInternalServer)
+
+		_, err := org.NewChain(orgRepository).InChain(context.TODO(), 2, 1)
+
+		assert.Equal(t, exception.ErrInternalServer, err)
+	})
+}
@@ -0,0 +1,148 @@
+package org_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	newJWT "github.com/dgrijalva/jwt-go"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/org"
+	"github.com/Risuii/models/orgs"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/org/mocks"
+)
+
+func newToken(t *testing.T, role string) string {
+	mockToken := &jwt.JWTclaim{
+		ID:    1,
+		Email: "test@test.com",
+		Role:  role,
+		StandardClaims: newJWT.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+		},
+	}
+
+	token, err := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken).SignedString(jwt.JWT_KEY)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestHandler_CreateDepartment(t *testing.T) {
+	t.Run("Create Success", func(t *testing.T) {
+		newReq, _ := json.Marshal(orgs.DepartmentReq{Name: "Engineering"})
+
+		orgUseCase := new(mocks.OrgUseCase)
+		orgUseCase.On("CreateDepartment", mock.Anything, users.RoleAdmin, orgs.DepartmentReq{Name: "Engineering"}).Return(response.Success(response.StatusCreated, orgs.Department{ID: 1}))
+
+		orgHandler := org.OrgHandler{
+			Validate: validator.New(),
+			UseCase:  orgUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t, users.RoleAdmin)})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(orgHandler.CreateDepartment)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+		orgUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Create Without Name", func(t *testing.T) {
+		newReq, _ := json.Marshal(orgs.DepartmentReq{})
+
+		orgHandler := org.OrgHandler{
+			Validate: validator.New(),
+			UseCase:  new(mocks.OrgUseCase),
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t, users.RoleAdmin)})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(orgHandler.CreateDepartment)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_Place(t *testing.T) {
+	t.Run("Place Success", func(t *testing.T) {
+		newReq, _ := json.Marshal(orgs.PlacementReq{TeamID: 1, ManagerID: 2})
+
+		orgUseCase := new(mocks.OrgUseCase)
+		orgUseCase.On("Place", mock.Anything, int64(5), users.RoleAdmin, orgs.PlacementReq{TeamID: 1, ManagerID: 2}).Return(response.Success(response.StatusOK, orgs.Member{UserID: 5}))
+
+		orgHandler := org.OrgHandler{
+			Validate: validator.New(),
+			UseCase:  orgUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPatch, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t, users.RoleAdmin)})
+		r = mux.SetURLVars(r, map[string]string{"id": "5"})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(orgHandler.Place)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		orgUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Place Without Token", func(t *testing.T) {
+		newReq, _ := json.Marshal(orgs.PlacementReq{ManagerID: 2})
+
+		orgHandler := org.OrgHandler{
+			Validate: validator.New(),
+			UseCase:  new(mocks.OrgUseCase),
+		}
+
+		r := httptest.NewRequest(http.MethodPatch, "/just/for/testing", bytes.NewReader(newReq))
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(orgHandler.Place)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+}
+
+func TestHandler_ListTeams(t *testing.T) {
+	t.Run("List By Department", func(t *testing.T) {
+		orgUseCase := new(mocks.OrgUseCase)
+		orgUseCase.On("ListTeams", mock.Anything, int64(3)).Return(response.Success(response.StatusOK, []orgs.Team{}))
+
+		orgHandler := org.OrgHandler{
+			Validate: validator.New(),
+			UseCase:  orgUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing?departmentID=3", nil)
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t, users.RoleEmployee)})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(orgHandler.ListTeams)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		orgUseCase.AssertExpectations(t)
+	})
+}
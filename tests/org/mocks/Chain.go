@@ -0,0 +1,75 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	orgs "github.com/Risuii/models/orgs"
+)
+
+// Chain is an autogenerated mock type for the Chain type
+type Chain struct {
+	mock.Mock
+}
+
+// InChain provides a mock function with given fields: ctx, managerID, userID
+func (_m *Chain) InChain(ctx context.Context, managerID int64, userID int64) (bool, error) {
+	ret := _m.Called(ctx, managerID, userID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) bool); ok {
+		r0 = rf(ctx, managerID, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, managerID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reports provides a mock function with given fields: ctx, managerID
+func (_m *Chain) Reports(ctx context.Context, managerID int64) ([]orgs.Member, error) {
+	ret := _m.Called(ctx, managerID)
+
+	var r0 []orgs.Member
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []orgs.Member); ok {
+		r0 = rf(ctx, managerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]orgs.Member)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, managerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewChain interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewChain creates a new instance of Chain. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewChain(t mockConstructorTestingTNewChain) *Chain {
+	mock := &Chain{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
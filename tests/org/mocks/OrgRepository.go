@@ -0,0 +1,221 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	orgs "github.com/Risuii/models/orgs"
+)
+
+// OrgRepository is an autogenerated mock type for the OrgRepository type
+type OrgRepository struct {
+	mock.Mock
+}
+
+// CreateDepartment provides a mock function with given fields: ctx, params
+func (_m *OrgRepository) CreateDepartment(ctx context.Context, params orgs.Department) (int64, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, orgs.Department) int64); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, orgs.Department) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTeam provides a mock function with given fields: ctx, params
+func (_m *OrgRepository) CreateTeam(ctx context.Context, params orgs.Team) (int64, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, orgs.Team) int64); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, orgs.Team) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindDepartments provides a mock function with given fields: ctx
+func (_m *OrgRepository) FindDepartments(ctx context.Context) ([]orgs.Department, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []orgs.Department
+	if rf, ok := ret.Get(0).(func(context.Context) []orgs.Department); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]orgs.Department)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindMember provides a mock function with given fields: ctx, userID
+func (_m *OrgRepository) FindMember(ctx context.Context, userID int64) (orgs.Member, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 orgs.Member
+	if rf, ok := ret.Get(0).(func(context.Context, int64) orgs.Member); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(orgs.Member)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindReports provides a mock function with given fields: ctx, managerID
+func (_m *OrgRepository) FindReports(ctx context.Context, managerID int64) ([]orgs.Member, error) {
+	ret := _m.Called(ctx, managerID)
+
+	var r0 []orgs.Member
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []orgs.Member); ok {
+		r0 = rf(ctx, managerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]orgs.Member)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, managerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTeamByID provides a mock function with given fields: ctx, id
+func (_m *OrgRepository) FindTeamByID(ctx context.Context, id int64) (orgs.Team, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 orgs.Team
+	if rf, ok := ret.Get(0).(func(context.Context, int64) orgs.Team); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(orgs.Team)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTeamMembers provides a mock function with given fields: ctx, teamID
+func (_m *OrgRepository) FindTeamMembers(ctx context.Context, teamID int64) ([]orgs.Member, error) {
+	ret := _m.Called(ctx, teamID)
+
+	var r0 []orgs.Member
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []orgs.Member); ok {
+		r0 = rf(ctx, teamID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]orgs.Member)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTeams provides a mock function with given fields: ctx, departmentID
+func (_m *OrgRepository) FindTeams(ctx context.Context, departmentID int64) ([]orgs.Team, error) {
+	ret := _m.Called(ctx, departmentID)
+
+	var r0 []orgs.Team
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []orgs.Team); ok {
+		r0 = rf(ctx, departmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]orgs.Team)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, departmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Place provides a mock function with given fields: ctx, userID, teamID, managerID
+func (_m *OrgRepository) Place(ctx context.Context, userID int64, teamID int64, managerID int64) error {
+	ret := _m.Called(ctx, userID, teamID, managerID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) error); ok {
+		r0 = rf(ctx, userID, teamID, managerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewOrgRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewOrgRepository creates a new instance of OrgRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewOrgRepository(t mockConstructorTestingTNewOrgRepository) *OrgRepository {
+	mock := &OrgRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
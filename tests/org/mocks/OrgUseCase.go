@@ -0,0 +1,144 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "github.com/Risuii/helpers/response"
+	orgs "github.com/Risuii/models/orgs"
+)
+
+// OrgUseCase is an autogenerated mock type for the OrgUseCase type
+type OrgUseCase struct {
+	mock.Mock
+}
+
+// CreateDepartment provides a mock function with given fields: ctx, role, params
+func (_m *OrgUseCase) CreateDepartment(ctx context.Context, role string, params orgs.DepartmentReq) response.Response {
+	ret := _m.Called(ctx, role, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, orgs.DepartmentReq) response.Response); ok {
+		r0 = rf(ctx, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// CreateTeam provides a mock function with given fields: ctx, role, params
+func (_m *OrgUseCase) CreateTeam(ctx context.Context, role string, params orgs.TeamReq) response.Response {
+	ret := _m.Called(ctx, role, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, orgs.TeamReq) response.Response); ok {
+		r0 = rf(ctx, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// ListDepartments provides a mock function with given fields: ctx
+func (_m *OrgUseCase) ListDepartments(ctx context.Context) response.Response {
+	ret := _m.Called(ctx)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context) response.Response); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// ListTeams provides a mock function with given fields: ctx, departmentID
+func (_m *OrgUseCase) ListTeams(ctx context.Context, departmentID int64) response.Response {
+	ret := _m.Called(ctx, departmentID)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64) response.Response); ok {
+		r0 = rf(ctx, departmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Place provides a mock function with given fields: ctx, id, role, params
+func (_m *OrgUseCase) Place(ctx context.Context, id int64, role string, params orgs.PlacementReq) response.Response {
+	ret := _m.Called(ctx, id, role, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, orgs.PlacementReq) response.Response); ok {
+		r0 = rf(ctx, id, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Reports provides a mock function with given fields: ctx, userID
+func (_m *OrgUseCase) Reports(ctx context.Context, userID int64) response.Response {
+	ret := _m.Called(ctx, userID)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64) response.Response); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// TeamMembers provides a mock function with given fields: ctx, id, userID, role
+func (_m *OrgUseCase) TeamMembers(ctx context.Context, id int64, userID int64, role string) response.Response {
+	ret := _m.Called(ctx, id, userID, role)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string) response.Response); ok {
+		r0 = rf(ctx, id, userID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewOrgUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewOrgUseCase creates a new instance of OrgUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewOrgUseCase(t mockConstructorTestingTNewOrgUseCase) *OrgUseCase {
+	mock := &OrgUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
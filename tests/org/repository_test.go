@@ -0,0 +1,167 @@
+package org_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/org"
+	"github.com/Risuii/models/orgs"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/mock"
+)
+
+var currentTime = time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+var memberColumns = []string{"id", "name", "email", "role", "team_id", "manager_id"}
+
+func TestCreateDepartmentRepo(t *testing.T) {
+	t.Run("Create Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := org.NewOrgRepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`INSERT INTO %s (name, company_id, created_at) VALUES (?, ?, ?)`, constant.TableDepartment)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectExec().WithArgs("Engineering", int64(6), currentTime).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		ID, err := repo.CreateDepartment(tenant.WithID(context.TODO(), 6), orgs.Department{Name: "Engineering", CreatedAt: currentTime})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), ID)
+	})
+
+	t.Run("Create Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := org.NewOrgRepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableDepartment)
+
+		mock.ExpectPrepare(query).ExpectExec().WillReturnError(fmt.Errorf("error"))
+
+		_, err := repo.CreateDepartment(tenant.WithID(context.TODO(), 6), orgs.Department{Name: "Engineering"})
+
+		assert.Equal(t, exception.ErrInternalServer, err)
+	})
+}
+
+func TestFindTeamsRepo(t *testing.T) {
+	t.Run("Find Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := org.NewOrgRepositoryImpl(db)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "departmentID", "name", "created_at"}).
+			AddRow(1, 1, "Backend", currentTime)
+
+		query := fmt.Sprintf(`SELECT id, departmentID, name, created_at FROM %s WHERE company_id = ? AND (? = 0 OR departmentID = ?) ORDER BY departmentID, name`, constant.TableTeam)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(int64(6), int64(1), int64(1)).WillReturnRows(rows)
+
+		res, err := repo.FindTeams(tenant.WithID(context.TODO(), 6), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []orgs.Team{{ID: 1, DepartmentID: 1, Name: "Backend", CreatedAt: currentTime}}, res)
+	})
+}
+
+func TestFindTeamByIDRepo(t *testing.T) {
+	t.Run("Find Not Found", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := org.NewOrgRepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, departmentID, name, created_at FROM %s WHERE id = ? AND company_id = ?`, constant.TableTeam)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(int64(9), int64(6)).WillReturnRows(sqlmock.NewRows([]string{"id", "departmentID", "name", "created_at"}))
+
+		_, err := repo.FindTeamByID(tenant.WithID(context.TODO(), 6), 9)
+
+		assert.Equal(t, exception.ErrNotFound, err)
+	})
+}
+
+func TestFindMemberRepo(t *testing.T) {
+	t.Run("Find Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := org.NewOrgRepositoryImpl(db)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows(memberColumns).AddRow(1, "Employee", "employee@test.com", users.RoleEmployee, nil, 2)
+
+		query := fmt.Sprintf(`SELECT id, name, email, role, team_id, manager_id FROM %s WHERE id = ? AND company_id = ?`, constant.TableEmployee)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(int64(1), int64(6)).WillReturnRows(rows)
+
+		res, err := repo.FindMember(tenant.WithID(context.TODO(), 6), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, orgs.Member{UserID: 1, Name: "Employee", Email: "employee@test.com", Role: users.RoleEmployee, ManagerID: 2}, res)
+	})
+
+	t.Run("Find Not Found", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := org.NewOrgRepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, name, email, role, team_id, manager_id FROM %s WHERE id = ? AND company_id = ?`, constant.TableEmployee)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(int64(1), int64(6)).WillReturnRows(sqlmock.NewRows(memberColumns))
+
+		_, err := repo.FindMember(tenant.WithID(context.TODO(), 6), 1)
+
+		assert.Equal(t, exception.ErrNotFound, err)
+	})
+}
+
+func TestFindReportsRepo(t *testing.T) {
+	t.Run("Find Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := org.NewOrgRepositoryImpl(db)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows(memberColumns).
+			AddRow(1, "Employee", "employee@test.com", users.RoleEmployee, 1, 3).
+			AddRow(3, "Lead", "lead@test.com", users.RoleManager, 1, 2)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`WITH RECURSIVE chain (id) AS (`)).WithArgs(int64(2), int64(6)).WillReturnRows(rows)
+
+		res, err := repo.FindReports(tenant.WithID(context.TODO(), 6), 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.Equal(t, int64(3), res[0].ManagerID)
+	})
+}
+
+func TestPlaceRepo(t *testing.T) {
+	t.Run("Place Clears Team", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := org.NewOrgRepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`UPDATE %s SET team_id = ?, manager_id = ? WHERE id = ? AND company_id = ?`, constant.TableEmployee)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectExec().WithArgs(nil, int64(2), int64(1), int64(6)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Place(tenant.WithID(context.TODO(), 6), 1, 0, 2)
+
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,243 @@
+package org_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/org"
+	"github.com/Risuii/models/orgs"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/org/mocks"
+)
+
+var engineering = orgs.Department{ID: 1, Name: "Engineering"}
+
+func TestCreateDepartment(t *testing.T) {
+	t.Run("Create Success", func(t *testing.T) {
+		orgRepository := new(mocks.OrgRepository)
+
+		orgRepository.On("FindDepartments", mock.Anything).Return([]orgs.Department{engineering}, nil)
+		orgRepository.On("CreateDepartment", mock.Anything, mock.MatchedBy(func(d orgs.Department) bool {
+			return d.Name == "Finance"
+		})).Return(int64(2), nil)
+
+		orgUseCase := org.NewOrgUseCase(orgRepository, new(mocks.Chain))
+
+		resp := orgUseCase.CreateDepartment(context.TODO(), users.RoleAdmin, orgs.DepartmentReq{Name: " Finance "})
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, int64(2), resp.(*response.ResponseImpl).Data.(orgs.Department).ID)
+		orgRepository.AssertExpectations(t)
+	})
+
+	t.Run("Create Duplicate Name", func(t *testing.T) {
+		orgRepository := new(mocks.OrgRepository)
+
+		orgRepository.On("FindDepartments", mock.Anything).Return([]orgs.Department{engineering}, nil)
+
+		orgUseCase := org.NewOrgUseCase(orgRepository, new(mocks.Chain))
+
+		resp := orgUseCase.CreateDepartment(context.TODO(), users.RoleAdmin, orgs.DepartmentReq{Name: "engineering"})
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+		orgRepository.AssertExpectations(t)
+	})
+
+	t.Run("Create Forbidden", func(t *testing.T) {
+		orgRepository := new(mocks.OrgRepository)
+
+		orgUseCase := org.NewOrgUseCase(orgRepository, new(mocks.Chain))
+
+		resp := orgUseCase.CreateDepartment(context.TODO(), users.RoleManager, orgs.DepartmentReq{Name: "Finance"})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		orgRepository.AssertExpectations(t)
+	})
+}
+
+func TestCreateTeam(t *testing.T) {
+	t.Run("Create Success", func(t *testing.T) {
+		orgRepository := new(mocks.OrgRepository)
+
+		orgRepository.On("FindDepartments", mock.Anything).Return([]orgs.Department{engineering}, nil)
+		orgRepository.On("FindTeams", mock.Anything, int64(1)).Return([]orgs.Team{{ID: 1, DepartmentID: 1, Name: "Backend"}}, nil)
+		orgRepository.On("CreateTeam", mock.Anything, mock.MatchedBy(func(tm orgs.Team) bool {
+			return tm.DepartmentID == 1 && tm.Name == "Frontend"
+		})).Return(int64(2), nil)
+
+		orgUseCase := org.NewOrgUseCase(orgRepository, new(mocks.Chain))
+
+		resp := orgUseCase.CreateTeam(context.TODO(), users.RoleAdmin, orgs.TeamReq{DepartmentID: 1, Name: "Frontend"})
+
+		assert.NoError(t, resp.Err())
+		orgRepository.AssertExpectations(t)
+	})
+
+	t.Run("Create Unknown Department", func(t *testing.T) {
+		orgRepository := new(mocks.OrgRepository)
+
+		orgRepository.On("FindDepartments", mock.Anything).Return([]orgs.Department{engineering}, nil)
+
+		orgUseCase := org.NewOrgUseCase(orgRepository, new(mocks.Chain))
+
+		resp := orgUseCase.CreateTeam(context.TODO(), users.RoleAdmin, orgs.TeamReq{DepartmentID: 9, Name: "Frontend"})
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+		orgRepository.AssertExpectations(t)
+	})
+}
+
+func TestPlace(t *testing.T) {
+	t.Run("Place Success", func(t *testing.T) {
+		orgRepository := new(mocks.OrgRepository)
+		chain := new(mocks.Chain)
+
+		orgRepository.On("FindMember", mock.Anything, int64(1)).Return(orgs.Member{UserID: 1}, nil)
+		orgRepository.On("FindTeamByID", mock.Anything, int64(1)).Return(orgs.Team{ID: 1}, nil)
+		orgRepository.On("FindMember", mock.Anything, int64(2)).Return(orgs.Member{UserID: 2}, nil)
+		chain.On("InChain", mock.Anything, int64(1), int64(2)).Return(false, nil)
+		orgRepository.On("Place", mock.Anything, int64(1), int64(1), int64(2)).Return(nil)
+
+		orgUseCase := org.NewOrgUseCase(orgRepository, chain)
+
+		resp := orgUseCase.Place(context.TODO(), 1, users.RoleAdmin, orgs.PlacementReq{TeamID: 1, ManagerID: 2})
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, int64(2), resp.(*response.ResponseImpl).Data.(orgs.Member).ManagerID)
+		orgRepository.AssertExpectations(t)
+		chain.AssertExpectations(t)
+	})
+
+	t.Run("Place Under Own Report", func(t *testing.T) {
+		orgRepository := new(mocks.OrgRepository)
+		chain := new(mocks.Chain)
+
+		orgRepository.On("FindMember", mock.Anything, int64(2)).Return(orgs.Member{UserID: 2}, nil)
+		orgRepository.On("FindMember", mock.Anything, int64(1)).Return(orgs.Member{UserID: 1, ManagerID: 2}, nil)
+		chain.On("InChain", mock.Anything, int64(2), int64(1)).Return(true, nil)
+
+		orgUseCase := org.NewOrgUseCase(orgRepository, chain)
+
+		resp := orgUseCase.Place(context.TODO(), 2, users.RoleAdmin, orgs.PlacementReq{ManagerID: 1})
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+		orgRepository.AssertNotCalled(t, "Place", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Place Under Self", func(t *testing.T) {
+		orgRepository := new(mocks.OrgRepository)
+
+		orgRepository.On("FindMember", mock.Anything, int64(1)).Return(orgs.Member{UserID: 1}, nil)
+
+		orgUseCase := org.NewOrgUseCase(orgRepository, new(mocks.Chain))
+
+		resp := orgUseCase.Place(context.TODO(), 1, users.RoleAdmin, orgs.PlacementReq{ManagerID: 1})
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+	})
+
+	t.Run("Place Unknown Team", func(t *testing.T) {
+		orgRepository := new(mocks.OrgRepository)
+
+		orgRepository.On("FindMember", mock.Anything, int64(1)).Return(orgs.Member{UserID: 1}, nil)
+		orgRepository.On("FindTeamByID", mock.Anything, int64(9)).Return(orgs.Team{}, exception.ErrNotFound)
+
+		orgUseCase := org.NewOrgUseCase(orgRepository, new(mocks.Chain))
+
+		resp := orgUseCase.Place(context.TODO(), 1, users.RoleAdmin, orgs.PlacementReq{TeamID: 9})
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+	})
+
+	t.Run("Place Forbidden", func(t *testing.T) {
+		orgUseCase := org.NewOrgUseCase(new(mocks.OrgRepository), new(mocks.Chain))
+
+		resp := orgUseCase.Place(context.TODO(), 1, users.RoleManager, orgs.PlacementReq{ManagerID: 2})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+	})
+}
+
+func TestTeamMembers(t *testing.T) {
+	members := []orgs.Member{{UserID: 1, TeamID: 1}, {UserID: 4, TeamID: 1}}
+
+	t.Run("Members As Admin", func(t *testing.T) {
+		orgRepository := new(mocks.OrgRepository)
+
+		orgRepository.On("FindTeamByID", mock.Anything, int64(1)).Return(orgs.Team{ID: 1}, nil)
+		orgRepository.On("FindTeamMembers", mock.Anything, int64(1)).Return(members, nil)
+
+		orgUseCase := org.NewOrgUseCase(orgRepository, new(mocks.Chain))
+
+		resp := orgUseCase.TeamMembers(context.TODO(), 1, 9, users.RoleAdmin)
+
+		assert.NoError(t, resp.Err())
+	})
+
+	t.Run("Members As Member", func(t *testing.T) {
+		orgRepository := new(mocks.OrgRepository)
+		chain := new(mocks.Chain)
+
+		orgRepository.On("FindTeamByID", mock.Anything, int64(1)).Return(orgs.Team{ID: 1}, nil)
+		orgRepository.On("FindTeamMembers", mock.Anything, int64(1)).Return(members, nil)
+
+		orgUseCase := org.NewOrgUseCase(orgRepository, chain)
+
+		resp := orgUseCase.TeamMembers(context.TODO(), 1, 1, users.RoleEmployee)
+
+		assert.NoError(t, resp.Err())
+		chain.AssertExpectations(t)
+	})
+
+	t.Run("Members As Manager In Chain", func(t *testing.T) {
+		orgRepository := new(mocks.OrgRepository)
+		chain := new(mocks.Chain)
+
+		orgRepository.On("FindTeamByID", mock.Anything, int64(1)).Return(orgs.Team{ID: 1}, nil)
+		orgRepository.On("FindTeamMembers", mock.Anything, int64(1)).Return(members, nil)
+		chain.On("InChain", mock.Anything, int64(2), int64(1)).Return(false, nil)
+		chain.On("InChain", mock.Anything, int64(2), int64(4)).Return(true, nil)
+
+		orgUseCase := org.NewOrgUseCase(orgRepository, chain)
+
+		resp := orgUseCase.TeamMembers(context.TODO(), 1, 2, users.RoleManager)
+
+		assert.NoError(t, resp.Err())
+		chain.AssertExpectations(t)
+	})
+
+	t.Run("Members Outside Chain", func(t *testing.T) {
+		orgRepository := new(mocks.OrgRepository)
+		chain := new(mocks.Chain)
+
+		orgRepository.On("FindTeamByID", mock.Anything, int64(1)).Return(orgs.Team{ID: 1}, nil)
+		orgRepository.On("FindTeamMembers", mock.Anything, int64(1)).Return(members, nil)
+		chain.On("InChain", mock.Anything, int64(5), mock.Anything).Return(false, nil)
+
+		orgUseCase := org.NewOrgUseCase(orgRepository, chain)
+
+		resp := orgUseCase.TeamMembers(context.TODO(), 1, 5, users.RoleManager)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+	})
+}
+
+func TestReports(t *testing.T) {
+	t.Run("Reports Success", func(t *testing.T) {
+		chain := new(mocks.Chain)
+
+		chain.On("Reports", mock.Anything, int64(2)).Return([]orgs.Member{{UserID: 1}}, nil)
+
+		orgUseCase := org.NewOrgUseCase(new(mocks.OrgRepository), chain)
+
+		resp := orgUseCase.Reports(context.TODO(), 2)
+
+		assert.NoError(t, resp.Err())
+		assert.Len(t, resp.(*response.ResponseImpl).Data.([]orgs.Member), 1)
+	})
+}
@@ -0,0 +1,298 @@
+package overtime_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	newJWT "github.com/dgrijalva/jwt-go"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/overtime"
+	"github.com/Risuii/models/overtimes"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/overtime/mocks"
+)
+
+func newToken(t *testing.T, role string) string {
+	mockToken := &jwt.JWTclaim{
+		ID:    1,
+		Email: "test@test.com",
+		Role:  role,
+		StandardClaims: newJWT.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+		},
+	}
+
+	tokenAlgo := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken)
+
+	token, err := tokenAlgo.SignedString(jwt.JWT_KEY)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestHandler_Request(t *testing.T) {
+	t.Run("Request Success", func(t *testing.T) {
+		mockData := overtimes.OvertimeReq{
+			Date:         "2021-12-12",
+			PlannedHours: 2,
+			Reason:       "test",
+		}
+
+		resp := response.Success(response.StatusCreated, overtimes.Overtime{})
+
+		validate := validator.New()
+		overtimeUseCase := new(mocks.OvertimeUseCase)
+		overtimeUseCase.On("Request", mock.Anything, int64(1), mockData).Return(resp)
+
+		overtimeHandler := overtime.OvertimeHandler{
+			Validate: validate,
+			UseCase:  overtimeUseCase,
+		}
+
+		newReq, err := json.Marshal(mockData)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleEmployee),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(overtimeHandler.Request)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusCreated, rb.Status)
+		assert.NotNil(t, rb.Data)
+
+		overtimeUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Request Error Unauthorized", func(t *testing.T) {
+		overtimeUseCase := new(mocks.OvertimeUseCase)
+
+		overtimeHandler := overtime.OvertimeHandler{
+			Validate: validator.New(),
+			UseCase:  overtimeUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", nil)
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(overtimeHandler.Request)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusUnauthorized, rb.Status)
+		assert.Nil(t, rb.Data)
+
+		overtimeUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Request Error Bad Request", func(t *testing.T) {
+		mockData := overtimes.OvertimeReq{
+			Date:         "12-12-2021",
+			PlannedHours: 0,
+		}
+
+		overtimeUseCase := new(mocks.OvertimeUseCase)
+
+		overtimeHandler := overtime.OvertimeHandler{
+			Validate: validator.New(),
+			UseCase:  overtimeUseCase,
+		}
+
+		newReq, err := json.Marshal(mockData)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleEmployee),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(overtimeHandler.Request)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusBadRequest, rb.Status)
+
+		overtimeUseCase.AssertExpectations(t)
+	})
+}
+
+func TestHandler_Review(t *testing.T) {
+	t.Run("Review Success", func(t *testing.T) {
+		mockData := overtimes.ReviewReq{
+			Status: overtimes.StatusApproved,
+		}
+
+		resp := response.Success(response.StatusOK, overtimes.Overtime{})
+
+		overtimeUseCase := new(mocks.OvertimeUseCase)
+		overtimeUseCase.On("Review", mock.Anything, int64(3), int64(1), users.RoleManager, mockData).Return(resp)
+
+		overtimeHandler := overtime.OvertimeHandler{
+			Validate: validator.New(),
+			UseCase:  overtimeUseCase,
+		}
+
+		newReq, err := json.Marshal(mockData)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		r := httptest.NewRequest(http.MethodPatch, "/just/for/testing", bytes.NewReader(newReq))
+		r = mux.SetURLVars(r, map[string]string{"id": "3"})
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleManager),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(overtimeHandler.Review)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusOK, rb.Status)
+
+		overtimeUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Review Error Bad Request", func(t *testing.T) {
+		mockData := overtimes.ReviewReq{
+			Status: "maybe",
+		}
+
+		overtimeUseCase := new(mocks.OvertimeUseCase)
+
+		overtimeHandler := overtime.OvertimeHandler{
+			Validate: validator.New(),
+			UseCase:  overtimeUseCase,
+		}
+
+		newReq, err := json.Marshal(mockData)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		r := httptest.NewRequest(http.MethodPatch, "/just/for/testing", bytes.NewReader(newReq))
+		r = mux.SetURLVars(r, map[string]string{"id": "3"})
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleManager),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(overtimeHandler.Review)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusBadRequest, rb.Status)
+
+		overtimeUseCase.AssertExpectations(t)
+	})
+}
+
+func TestHandler_ReviewForgedToken(t *testing.T) {
+	forged := map[string]func() (string, error){
+		"Other Key": func() (string, error) {
+			claims := &jwt.JWTclaim{ID: 1, Role: users.RoleManager}
+			return newJWT.NewWithClaims(newJWT.SigningMethodHS256, claims).SignedString([]byte("guessed"))
+		},
+		"Expired": func() (string, error) {
+			claims := &jwt.JWTclaim{
+				ID:   1,
+				Role: users.RoleManager,
+				StandardClaims: newJWT.StandardClaims{
+					ExpiresAt: time.Now().Add(-time.Minute).Unix(),
+				},
+			}
+			return newJWT.NewWithClaims(newJWT.SigningMethodHS256, claims).SignedString(jwt.JWT_KEY)
+		},
+		"Unsigned": func() (string, error) {
+			claims := &jwt.JWTclaim{ID: 1, Role: users.RoleManager}
+			return newJWT.NewWithClaims(newJWT.SigningMethodNone, claims).SignedString(newJWT.UnsafeAllowNoneSignatureType)
+		},
+	}
+
+	for name, sign := range forged {
+		sign := sign
+		t.Run("Review Error "+name, func(t *testing.T) {
+			token, err := sign()
+			assert.NoError(t, err)
+
+			overtimeUseCase := new(mocks.OvertimeUseCase)
+
+			overtimeHandler := overtime.OvertimeHandler{
+				Validate: validator.New(),
+				UseCase:  overtimeUseCase,
+			}
+
+			newReq, _ := json.Marshal(overtimes.ReviewReq{Status: overtimes.StatusApproved})
+
+			r := httptest.NewRequest(http.MethodPatch, "/just/for/testing", bytes.NewReader(newReq))
+			r = mux.SetURLVars(r, map[string]string{"id": "3"})
+			r.AddCookie(&http.Cookie{
+				Name:  "token",
+				Value: token,
+			})
+			recorder := httptest.NewRecorder()
+
+			handler := http.HandlerFunc(overtimeHandler.Review)
+			handler.ServeHTTP(recorder, r)
+
+			assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+			overtimeUseCase.AssertNotCalled(t, "Review", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	overtimes "github.com/Risuii/models/overtimes"
+)
+
+// OvertimeRepository is an autogenerated mock type for the OvertimeRepository type
+type OvertimeRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, params
+func (_m *OvertimeRepository) Create(ctx context.Context, params overtimes.Overtime) (int64, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, overtimes.Overtime) int64); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, overtimes.Overtime) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *OvertimeRepository) FindByID(ctx context.Context, id int64) (overtimes.Overtime, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 overtimes.Overtime
+	if rf, ok := ret.Get(0).(func(context.Context, int64) overtimes.Overtime); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(overtimes.Overtime)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByStatus provides a mock function with given fields: ctx, status
+func (_m *OvertimeRepository) FindByStatus(ctx context.Context, status string) ([]overtimes.Overtime, error) {
+	ret := _m.Called(ctx, status)
+
+	var r0 []overtimes.Overtime
+	if rf, ok := ret.Get(0).(func(context.Context, string) []overtimes.Overtime); ok {
+		r0 = rf(ctx, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]overtimes.Overtime)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByUserID provides a mock function with given fields: ctx, userID
+func (_m *OvertimeRepository) FindByUserID(ctx context.Context, userID int64) ([]overtimes.Overtime, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []overtimes.Overtime
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []overtimes.Overtime); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]overtimes.Overtime)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, id, params
+func (_m *OvertimeRepository) UpdateStatus(ctx context.Context, id int64, params overtimes.Overtime) error {
+	ret := _m.Called(ctx, id, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, overtimes.Overtime) error); ok {
+		r0 = rf(ctx, id, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewOvertimeRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewOvertimeRepository creates a new instance of OvertimeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewOvertimeRepository(t mockConstructorTestingTNewOvertimeRepository) *OvertimeRepository {
+	mock := &OvertimeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
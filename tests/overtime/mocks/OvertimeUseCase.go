@@ -0,0 +1,96 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "github.com/Risuii/helpers/response"
+	overtimes "github.com/Risuii/models/overtimes"
+)
+
+// OvertimeUseCase is an autogenerated mock type for the OvertimeUseCase type
+type OvertimeUseCase struct {
+	mock.Mock
+}
+
+// Pending provides a mock function with given fields: ctx, reviewerID, role
+func (_m *OvertimeUseCase) Pending(ctx context.Context, reviewerID int64, role string) response.Response {
+	ret := _m.Called(ctx, reviewerID, role)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) response.Response); ok {
+		r0 = rf(ctx, reviewerID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Request provides a mock function with given fields: ctx, userID, params
+func (_m *OvertimeUseCase) Request(ctx context.Context, userID int64, params overtimes.OvertimeReq) response.Response {
+	ret := _m.Called(ctx, userID, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, overtimes.OvertimeReq) response.Response); ok {
+		r0 = rf(ctx, userID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Review provides a mock function with given fields: ctx, id, reviewerID, role, params
+func (_m *OvertimeUseCase) Review(ctx context.Context, id int64, reviewerID int64, role string, params overtimes.ReviewReq) response.Response {
+	ret := _m.Called(ctx, id, reviewerID, role, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string, overtimes.ReviewReq) response.Response); ok {
+		r0 = rf(ctx, id, reviewerID, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Riwayat provides a mock function with given fields: ctx, userID
+func (_m *OvertimeUseCase) Riwayat(ctx context.Context, userID int64) response.Response {
+	ret := _m.Called(ctx, userID)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64) response.Response); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewOvertimeUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewOvertimeUseCase creates a new instance of OvertimeUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewOvertimeUseCase(t mockConstructorTestingTNewOvertimeUseCase) *OvertimeUseCase {
+	mock := &OvertimeUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,181 @@
+package overtime_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/overtime"
+	"github.com/Risuii/models/overtimes"
+	"github.com/Risuii/tests/mock"
+)
+
+var currentTime = time.Date(2021, 12, 12, 0, 0, 0, 0, &time.Location{})
+var overtimeStruct = overtimes.Overtime{
+	ID:           1,
+	UserID:       1,
+	Date:         currentTime,
+	PlannedHours: 2,
+	Reason:       "test",
+	Status:       overtimes.StatusPending,
+	CreatedAt:    currentTime,
+}
+var overtimeColumns = []string{"id", "userID", "date", "planned_hours", "reason", "status", "reviewed_by", "reviewed_at", "created_at"}
+
+func TestCreateRepo(t *testing.T) {
+	t.Run("Create Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := overtime.NewOvertimeRepositoryImpl(db, constant.TableOvertime)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableOvertime)
+		ctx := tenant.WithID(context.TODO(), 3)
+
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(overtimeStruct.UserID, "2021-12-12", overtimeStruct.PlannedHours, overtimeStruct.Reason, overtimeStruct.Status, overtimeStruct.CreatedAt).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		ID, err := repo.Create(ctx, overtimeStruct)
+
+		assert.Equal(t, int64(1), ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := overtime.NewOvertimeRepositoryImpl(db, constant.TableOvertime)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableOvertime)
+		ctx := tenant.WithID(context.TODO(), 3)
+
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(overtimeStruct.UserID, "2021-12-12", overtimeStruct.PlannedHours, overtimeStruct.Reason, overtimeStruct.Status, overtimeStruct.CreatedAt).WillReturnError(fmt.Errorf("error"))
+
+		ID, err := repo.Create(ctx, overtimeStruct)
+
+		assert.Equal(t, int64(0), ID)
+		assert.Error(t, err)
+	})
+}
+
+func TestFindByIDRepo(t *testing.T) {
+	t.Run("FindByID Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := overtime.NewOvertimeRepositoryImpl(db, constant.TableOvertime)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, userID, date, planned_hours, reason, status, reviewed_by, reviewed_at, created_at FROM %s WHERE id = ? AND userID IN (SELECT id FROM %s WHERE company_id = ?)`, constant.TableOvertime, constant.TableEmployee)
+		rows := sqlmock.NewRows(overtimeColumns).AddRow(overtimeStruct.ID, overtimeStruct.UserID, overtimeStruct.Date, overtimeStruct.PlannedHours, overtimeStruct.Reason, overtimeStruct.Status, nil, nil, overtimeStruct.CreatedAt)
+
+		ctx := tenant.WithID(context.TODO(), 3)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(overtimeStruct.ID, int64(3)).WillReturnRows(rows)
+
+		result, err := repo.FindByID(ctx, overtimeStruct.ID)
+
+		assert.Equal(t, overtimeStruct, result)
+		assert.NoError(t, err)
+	})
+
+	t.Run("FindByID Error Not Found", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := overtime.NewOvertimeRepositoryImpl(db, constant.TableOvertime)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, userID, date, planned_hours, reason, status, reviewed_by, reviewed_at, created_at FROM %s WHERE id = ? AND userID IN (SELECT id FROM %s WHERE company_id = ?)`, constant.TableOvertime, constant.TableEmployee)
+		rows := sqlmock.NewRows(overtimeColumns)
+
+		ctx := tenant.WithID(context.TODO(), 3)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(overtimeStruct.ID, int64(3)).WillReturnRows(rows)
+
+		result, err := repo.FindByID(ctx, overtimeStruct.ID)
+
+		assert.Empty(t, result)
+		assert.Equal(t, exception.ErrNotFound, err)
+	})
+}
+
+func TestFindByUserIDRepo(t *testing.T) {
+	t.Run("FindByUserID Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := overtime.NewOvertimeRepositoryImpl(db, constant.TableOvertime)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, userID, date, planned_hours, reason, status, reviewed_by, reviewed_at, created_at FROM %s WHERE userID = ?`, constant.TableOvertime)
+		rows := sqlmock.NewRows(overtimeColumns).AddRow(overtimeStruct.ID, overtimeStruct.UserID, overtimeStruct.Date, overtimeStruct.PlannedHours, overtimeStruct.Reason, overtimeStruct.Status, 2, currentTime, overtimeStruct.CreatedAt)
+
+		ctx := tenant.WithID(context.TODO(), 3)
+
+		mock.ExpectQuery(query).WithArgs(overtimeStruct.UserID, int64(3)).WillReturnRows(rows)
+
+		result, err := repo.FindByUserID(ctx, overtimeStruct.UserID)
+
+		assert.Len(t, result, 1)
+		assert.Equal(t, int64(2), result[0].ReviewedBy)
+		assert.NoError(t, err)
+	})
+
+	t.Run("FindByUserID Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := overtime.NewOvertimeRepositoryImpl(db, constant.TableOvertime)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, userID, date, planned_hours, reason, status, reviewed_by, reviewed_at, created_at FROM %s WHERE userID = ?`, constant.TableOvertime)
+
+		ctx := tenant.WithID(context.TODO(), 3)
+
+		mock.ExpectQuery(query).WithArgs(overtimeStruct.UserID, int64(3)).WillReturnError(fmt.Errorf("error"))
+
+		result, err := repo.FindByUserID(ctx, overtimeStruct.UserID)
+
+		assert.Empty(t, result)
+		assert.Error(t, err)
+	})
+}
+
+func TestUpdateStatusRepo(t *testing.T) {
+	t.Run("UpdateStatus Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := overtime.NewOvertimeRepositoryImpl(db, constant.TableOvertime)
+
+		defer db.Close()
+
+		query := regexp.QuoteMeta(fmt.Sprintf(`UPDATE %s SET status = ?, reviewed_by = ?, reviewed_at = ? WHERE id = ? AND status = ?`, constant.TableOvertime))
+		ctx := tenant.WithID(context.TODO(), 3)
+
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(overtimes.StatusApproved, int64(2), currentTime, overtimeStruct.ID, overtimes.StatusPending, int64(3)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.UpdateStatus(ctx, overtimeStruct.ID, overtimes.Overtime{Status: overtimes.StatusApproved, ReviewedBy: 2, ReviewedAt: currentTime})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("UpdateStatus Already Reviewed", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := overtime.NewOvertimeRepositoryImpl(db, constant.TableOvertime)
+
+		defer db.Close()
+
+		query := regexp.QuoteMeta(fmt.Sprintf(`UPDATE %s SET status = ?, reviewed_by = ?, reviewed_at = ? WHERE id = ? AND status = ?`, constant.TableOvertime))
+		ctx := tenant.WithID(context.TODO(), 3)
+
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(overtimes.StatusApproved, int64(2), currentTime, overtimeStruct.ID, overtimes.StatusPending, int64(3)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.UpdateStatus(ctx, overtimeStruct.ID, overtimes.Overtime{Status: overtimes.StatusApproved, ReviewedBy: 2, ReviewedAt: currentTime})
+
+		assert.Equal(t, exception.ErrConflicted, err)
+	})
+}
@@ -0,0 +1,242 @@
+package overtime_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/overtime"
+	"github.com/Risuii/models/orgs"
+	"github.com/Risuii/models/overtimes"
+	"github.com/Risuii/models/users"
+	orgMocks "github.com/Risuii/tests/org/mocks"
+	"github.com/Risuii/tests/overtime/mocks"
+)
+
+func TestRequest(t *testing.T) {
+	t.Run("Request Success", func(t *testing.T) {
+		overtimeRepository := new(mocks.OvertimeRepository)
+
+		overtimeRepository.On("Create", mock.Anything, mock.AnythingOfType("overtimes.Overtime")).Return(int64(1), nil)
+
+		overtimeUseCase := overtime.NewOvertimeUseCase(overtimeRepository, new(orgMocks.Chain))
+
+		params := overtimes.OvertimeReq{
+			Date:         "2021-12-12",
+			PlannedHours: 2,
+			Reason:       "test",
+		}
+
+		resp := overtimeUseCase.Request(context.TODO(), 1, params)
+
+		assert.NoError(t, resp.Err())
+		overtimeRepository.AssertExpectations(t)
+	})
+
+	t.Run("Request Error Internal Server", func(t *testing.T) {
+		overtimeRepository := new(mocks.OvertimeRepository)
+
+		overtimeRepository.On("Create", mock.Anything, mock.AnythingOfType("overtimes.Overtime")).Return(int64(0), exception.ErrInternalServer)
+
+		overtimeUseCase := overtime.NewOvertimeUseCase(overtimeRepository, new(orgMocks.Chain))
+
+		params := overtimes.OvertimeReq{
+			Date:         "2021-12-12",
+			PlannedHours: 2,
+			Reason:       "test",
+		}
+
+		resp := overtimeUseCase.Request(context.TODO(), 1, params)
+
+		assert.Error(t, resp.Err())
+		overtimeRepository.AssertExpectations(t)
+	})
+}
+
+func TestReview(t *testing.T) {
+	t.Run("Review Success", func(t *testing.T) {
+		overtimeRepository := new(mocks.OvertimeRepository)
+
+		chain := new(orgMocks.Chain)
+		chain.On("InChain", mock.Anything, int64(2), overtimeStruct.UserID).Return(true, nil)
+
+		overtimeRepository.On("FindByID", mock.Anything, int64(1)).Return(overtimeStruct, nil)
+		overtimeRepository.On("UpdateStatus", mock.Anything, int64(1), mock.AnythingOfType("overtimes.Overtime")).Return(nil)
+
+		overtimeUseCase := overtime.NewOvertimeUseCase(overtimeRepository, chain)
+
+		resp := overtimeUseCase.Review(context.TODO(), 1, 2, users.RoleManager, overtimes.ReviewReq{Status: overtimes.StatusApproved})
+
+		assert.NoError(t, resp.Err())
+		overtimeRepository.AssertExpectations(t)
+	})
+
+	t.Run("Review Error Forbidden Role", func(t *testing.T) {
+		overtimeRepository := new(mocks.OvertimeRepository)
+
+		overtimeUseCase := overtime.NewOvertimeUseCase(overtimeRepository, new(orgMocks.Chain))
+
+		resp := overtimeUseCase.Review(context.TODO(), 1, 2, users.RoleEmployee, overtimes.ReviewReq{Status: overtimes.StatusApproved})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		overtimeRepository.AssertExpectations(t)
+	})
+
+	t.Run("Review Error Forbidden Own Request", func(t *testing.T) {
+		overtimeRepository := new(mocks.OvertimeRepository)
+
+		overtimeRepository.On("FindByID", mock.Anything, int64(1)).Return(overtimeStruct, nil)
+
+		overtimeUseCase := overtime.NewOvertimeUseCase(overtimeRepository, new(orgMocks.Chain))
+
+		resp := overtimeUseCase.Review(context.TODO(), 1, overtimeStruct.UserID, users.RoleManager, overtimes.ReviewReq{Status: overtimes.StatusApproved})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		overtimeRepository.AssertExpectations(t)
+	})
+
+	t.Run("Review Error Forbidden Outside Chain", func(t *testing.T) {
+		overtimeRepository := new(mocks.OvertimeRepository)
+
+		chain := new(orgMocks.Chain)
+		chain.On("InChain", mock.Anything, int64(2), overtimeStruct.UserID).Return(false, nil)
+
+		overtimeRepository.On("FindByID", mock.Anything, int64(1)).Return(overtimeStruct, nil)
+
+		overtimeUseCase := overtime.NewOvertimeUseCase(overtimeRepository, chain)
+
+		resp := overtimeUseCase.Review(context.TODO(), 1, 2, users.RoleManager, overtimes.ReviewReq{Status: overtimes.StatusApproved})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		overtimeRepository.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Review Error Reviewed Meanwhile", func(t *testing.T) {
+		overtimeRepository := new(mocks.OvertimeRepository)
+
+		overtimeRepository.On("FindByID", mock.Anything, int64(1)).Return(overtimeStruct, nil)
+		overtimeRepository.On("UpdateStatus", mock.Anything, int64(1), mock.AnythingOfType("overtimes.Overtime")).Return(exception.ErrConflicted)
+
+		overtimeUseCase := overtime.NewOvertimeUseCase(overtimeRepository, new(orgMocks.Chain))
+
+		resp := overtimeUseCase.Review(context.TODO(), 1, 2, users.RoleAdmin, overtimes.ReviewReq{Status: overtimes.StatusApproved})
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+	})
+
+	t.Run("Review Error Conflict", func(t *testing.T) {
+		overtimeRepository := new(mocks.OvertimeRepository)
+
+		reviewed := overtimeStruct
+		reviewed.Status = overtimes.StatusRejected
+
+		overtimeRepository.On("FindByID", mock.Anything, int64(1)).Return(reviewed, nil)
+
+		overtimeUseCase := overtime.NewOvertimeUseCase(overtimeRepository, new(orgMocks.Chain))
+
+		resp := overtimeUseCase.Review(context.TODO(), 1, 2, users.RoleAdmin, overtimes.ReviewReq{Status: overtimes.StatusApproved})
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+		overtimeRepository.AssertExpectations(t)
+	})
+
+	t.Run("Review Error Not Found", func(t *testing.T) {
+		overtimeRepository := new(mocks.OvertimeRepository)
+
+		overtimeRepository.On("FindByID", mock.Anything, int64(1)).Return(overtimes.Overtime{}, exception.ErrNotFound)
+
+		overtimeUseCase := overtime.NewOvertimeUseCase(overtimeRepository, new(orgMocks.Chain))
+
+		resp := overtimeUseCase.Review(context.TODO(), 1, 2, users.RoleManager, overtimes.ReviewReq{Status: overtimes.StatusApproved})
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+		overtimeRepository.AssertExpectations(t)
+	})
+}
+
+func TestRiwayatOvertime(t *testing.T) {
+	t.Run("Riwayat Success", func(t *testing.T) {
+		overtimeRepository := new(mocks.OvertimeRepository)
+
+		overtimeRepository.On("FindByUserID", mock.Anything, int64(1)).Return([]overtimes.Overtime{overtimeStruct}, nil)
+
+		overtimeUseCase := overtime.NewOvertimeUseCase(overtimeRepository, new(orgMocks.Chain))
+
+		resp := overtimeUseCase.Riwayat(context.TODO(), 1)
+
+		assert.NoError(t, resp.Err())
+		overtimeRepository.AssertExpectations(t)
+	})
+
+	t.Run("Riwayat Error Internal Server", func(t *testing.T) {
+		overtimeRepository := new(mocks.OvertimeRepository)
+
+		overtimeRepository.On("FindByUserID", mock.Anything, int64(1)).Return([]overtimes.Overtime{}, exception.ErrInternalServer)
+
+		overtimeUseCase := overtime.NewOvertimeUseCase(overtimeRepository, new(orgMocks.Chain))
+
+		resp := overtimeUseCase.Riwayat(context.TODO(), 1)
+
+		assert.Error(t, resp.Err())
+		overtimeRepository.AssertExpectations(t)
+	})
+}
+
+func TestPending(t *testing.T) {
+	t.Run("Pending Success", func(t *testing.T) {
+		overtimeRepository := new(mocks.OvertimeRepository)
+
+		other := overtimeStruct
+		other.ID = 2
+		other.UserID = 8
+
+		chain := new(orgMocks.Chain)
+		chain.On("Reports", mock.Anything, int64(2)).Return([]orgs.Member{{UserID: overtimeStruct.UserID}}, nil)
+
+		overtimeRepository.On("FindByStatus", mock.Anything, overtimes.StatusPending).Return([]overtimes.Overtime{overtimeStruct, other}, nil)
+
+		overtimeUseCase := overtime.NewOvertimeUseCase(overtimeRepository, chain)
+
+		resp := overtimeUseCase.Pending(context.TODO(), 2, users.RoleManager)
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, []overtimes.Overtime{overtimeStruct}, resp.(*response.ResponseImpl).Data)
+		overtimeRepository.AssertExpectations(t)
+		chain.AssertExpectations(t)
+	})
+
+	t.Run("Pending Admin Sees Everyone", func(t *testing.T) {
+		overtimeRepository := new(mocks.OvertimeRepository)
+
+		other := overtimeStruct
+		other.ID = 2
+		other.UserID = 8
+
+		chain := new(orgMocks.Chain)
+
+		overtimeRepository.On("FindByStatus", mock.Anything, overtimes.StatusPending).Return([]overtimes.Overtime{overtimeStruct, other}, nil)
+
+		overtimeUseCase := overtime.NewOvertimeUseCase(overtimeRepository, chain)
+
+		resp := overtimeUseCase.Pending(context.TODO(), 2, users.RoleAdmin)
+
+		assert.NoError(t, resp.Err())
+		assert.Len(t, resp.(*response.ResponseImpl).Data, 2)
+		chain.AssertNotCalled(t, "Reports", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Pending Error Forbidden", func(t *testing.T) {
+		overtimeRepository := new(mocks.OvertimeRepository)
+
+		overtimeUseCase := overtime.NewOvertimeUseCase(overtimeRepository, new(orgMocks.Chain))
+
+		resp := overtimeUseCase.Pending(context.TODO(), 1, users.RoleEmployee)
+
+		assert.Equal(t, response.Error(response.StatusForbiddend, exception.ErrForbidden), resp)
+		overtimeRepository.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,131 @@
+package payroll_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/internal/payroll"
+	"github.com/Risuii/models/payrolls"
+)
+
+var records = []payrolls.Record{
+	{Code: "EMP001", Name: "test", Period: "2023-01", WorkedDays: 20, OvertimeHours: 1.5, LateMinutes: 30, LateDeduction: 15000},
+}
+
+func TestCSVExporter(t *testing.T) {
+	t.Run("Export Default Mapping", func(t *testing.T) {
+		exporter, err := payroll.NewExporter(payroll.DefaultMapping())
+		assert.NoError(t, err)
+
+		var buf bytes.Buffer
+		err = exporter.Export(&buf, records)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "csv", exporter.Extension())
+		assert.Equal(t, "employee_code,period,worked_days,overtime_hours,late_deduction\nEMP001,2023-01,20,1.50,15000.00\n", buf.String())
+	})
+
+	t.Run("Export Custom Delimiter Without Header", func(t *testing.T) {
+		exporter, err := payroll.NewExporter(payrolls.Mapping{
+			Format:    payrolls.FormatCSV,
+			Delimiter: ";",
+			Columns: []payrolls.Column{
+				{Field: payrolls.FieldName},
+				{Field: payrolls.FieldLateMinutes},
+			},
+		})
+		assert.NoError(t, err)
+
+		var buf bytes.Buffer
+		err = exporter.Export(&buf, records)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test;30\n", buf.String())
+	})
+
+	t.Run("Export Escapes Formulas", func(t *testing.T) {
+		exporter, err := payroll.NewExporter(payrolls.Mapping{
+			Format: payrolls.FormatCSV,
+			Columns: []payrolls.Column{
+				{Field: payrolls.FieldCode},
+				{Field: payrolls.FieldName},
+				{Field: payrolls.FieldLateDeduction},
+			},
+		})
+		assert.NoError(t, err)
+
+		var buf bytes.Buffer
+		err = exporter.Export(&buf, []payrolls.Record{
+			{Code: "@SUM(A1)", Name: "=HYPERLINK(\"http://x\")", LateDeduction: -500},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "'@SUM(A1),\"'=HYPERLINK(\"\"http://x\"\")\",-500.00\n", buf.String())
+	})
+}
+
+func TestFixedWidthExporter(t *testing.T) {
+	t.Run("Export Success", func(t *testing.T) {
+		exporter, err := payroll.NewExporter(payrolls.Mapping{
+			Format: payrolls.FormatFixed,
+			Header: true,
+			Columns: []payrolls.Column{
+				{Field: payrolls.FieldCode, Header: "KODE", Width: 8},
+				{Field: payrolls.FieldPeriod, Header: "PERIODE", Width: 8},
+				{Field: payrolls.FieldWorkedDays, Header: "HARI", Width: 4, Align: payrolls.AlignRight},
+				{Field: payrolls.FieldLateDeduction, Header: "POTONGAN", Width: 10, Align: payrolls.AlignRight},
+			},
+		})
+		assert.NoError(t, err)
+
+		var buf bytes.Buffer
+		err = exporter.Export(&buf, records)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "txt", exporter.Extension())
+		assert.Equal(t, "KODE    PERIODE HARIPOTONGAN  \nEMP001  2023-01   20  15000.00\n", buf.String())
+	})
+
+	t.Run("NewExporter Error Missing Width", func(t *testing.T) {
+		_, err := payroll.NewExporter(payrolls.Mapping{
+			Format:  payrolls.FormatFixed,
+			Columns: []payrolls.Column{{Field: payrolls.FieldCode}},
+		})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("NewExporter Error Unknown Field", func(t *testing.T) {
+		_, err := payroll.NewExporter(payrolls.Mapping{
+			Format:  payrolls.FormatCSV,
+			Columns: []payrolls.Column{{Field: "salary"}},
+		})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestLoadMapping(t *testing.T) {
+	t.Run("LoadMapping File", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "mapping.json")
+		err := os.WriteFile(path, []byte(`{"format":"fixed","columns":[{"field":"employee_code","width":10}]}`), 0o600)
+		assert.NoError(t, err)
+
+		mapping, err := payroll.LoadMapping(path)
+
+		assert.NoError(t, err)
+		assert.Equal(t, payrolls.FormatFixed, mapping.Format)
+		assert.Equal(t, 10, mapping.Columns[0].Width)
+	})
+
+	t.Run("LoadMapping Default", func(t *testing.T) {
+		mapping, err := payroll.LoadMapping("")
+
+		assert.NoError(t, err)
+		assert.Equal(t, payroll.DefaultMapping(), mapping)
+	})
+}
@@ -0,0 +1,59 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	payrolls "github.com/Risuii/models/payrolls"
+)
+
+// Exporter is an autogenerated mock type for the Exporter type
+type Exporter struct {
+	mock.Mock
+}
+
+// Export provides a mock function with given fields: w, records
+func (_m *Exporter) Export(w io.Writer, records []payrolls.Record) error {
+	ret := _m.Called(w, records)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(io.Writer, []payrolls.Record) error); ok {
+		r0 = rf(w, records)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Extension provides a mock function with given fields:
+func (_m *Exporter) Extension() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewExporter interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewExporter creates a new instance of Exporter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewExporter(t mockConstructorTestingTNewExporter) *Exporter {
+	mock := &Exporter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
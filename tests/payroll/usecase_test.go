@@ -0,0 +1,78 @@
+package payroll_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/internal/payroll"
+	"github.com/Risuii/models/payrolls"
+	"github.com/Risuii/models/reports"
+	"github.com/Risuii/tests/payroll/mocks"
+	reportMocks "github.com/Risuii/tests/report/mocks"
+)
+
+var january = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestRecords(t *testing.T) {
+	t.Run("Records Success", func(t *testing.T) {
+		reportUseCase := new(reportMocks.ReportUseCase)
+		exporter := new(mocks.Exporter)
+
+		recap := []reports.Recap{
+			{UserID: 1, Code: "EMP001", Name: "test", Month: "2023-01", DaysPresent: 20, LateMinutes: 30, OvertimeHours: 1.5},
+			{UserID: 2, Name: "tanpa kode", Month: "2023-01", DaysPresent: 18},
+		}
+
+		reportUseCase.On("Calculate", mock.Anything, int64(0), january, time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)).Return(recap, nil)
+
+		payrollUseCase := payroll.NewPayrollUseCase(reportUseCase, exporter, 500)
+
+		result, err := payrollUseCase.Records(context.TODO(), january)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []payrolls.Record{
+			{Code: "EMP001", Name: "test", Period: "2023-01", WorkedDays: 20, OvertimeHours: 1.5, LateMinutes: 30, LateDeduction: 15000},
+			{Code: "2", Name: "tanpa kode", Period: "2023-01", WorkedDays: 18},
+		}, result)
+		reportUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Records Error Internal Server", func(t *testing.T) {
+		reportUseCase := new(reportMocks.ReportUseCase)
+		exporter := new(mocks.Exporter)
+
+		reportUseCase.On("Calculate", mock.Anything, int64(0), january, mock.Anything).Return([]reports.Recap{}, exception.ErrInternalServer)
+
+		payrollUseCase := payroll.NewPayrollUseCase(reportUseCase, exporter, 500)
+
+		result, err := payrollUseCase.Records(context.TODO(), january)
+
+		assert.Empty(t, result)
+		assert.Equal(t, exception.ErrInternalServer, err)
+		reportUseCase.AssertExpectations(t)
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	t.Run("Generate Success", func(t *testing.T) {
+		reportUseCase := new(reportMocks.ReportUseCase)
+		exporter := new(mocks.Exporter)
+
+		reportUseCase.On("Calculate", mock.Anything, int64(0), january, mock.Anything).Return([]reports.Recap{{UserID: 1, Code: "EMP001", Month: "2023-01"}}, nil)
+		exporter.On("Export", mock.Anything, []payrolls.Record{{Code: "EMP001", Period: "2023-01"}}).Return(nil)
+
+		payrollUseCase := payroll.NewPayrollUseCase(reportUseCase, exporter, 0)
+
+		err := payrollUseCase.Generate(context.TODO(), january, &bytes.Buffer{})
+
+		assert.NoError(t, err)
+		reportUseCase.AssertExpectations(t)
+		exporter.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,213 @@
+package project_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	newJWT "github.com/dgrijalva/jwt-go"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/project"
+	"github.com/Risuii/models/projects"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/project/mocks"
+)
+
+func newToken(t *testing.T, role string) string {
+	mockToken := &jwt.JWTclaim{
+		ID:    1,
+		Email: "test@test.com",
+		Role:  role,
+		StandardClaims: newJWT.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+		},
+	}
+
+	tokenAlgo := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken)
+
+	token, err := tokenAlgo.SignedString(jwt.JWT_KEY)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestHandler_Create(t *testing.T) {
+	t.Run("Create Success", func(t *testing.T) {
+		resp := response.Success(response.StatusCreated, projects.Project{})
+
+		projectUseCase := new(mocks.ProjectUseCase)
+		projectUseCase.On("Create", mock.Anything, users.RoleManager, projectReq).Return(resp)
+
+		projectHandler := project.ProjectHandler{
+			Validate: validator.New(),
+			UseCase:  projectUseCase,
+		}
+
+		newReq, err := json.Marshal(projectReq)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleManager),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(projectHandler.Create)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusCreated, rb.Status)
+		projectUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Create Error Validation", func(t *testing.T) {
+		projectUseCase := new(mocks.ProjectUseCase)
+
+		projectHandler := project.ProjectHandler{
+			Validate: validator.New(),
+			UseCase:  projectUseCase,
+		}
+
+		newReq, err := json.Marshal(projects.ProjectReq{Name: "test"})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleManager),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(projectHandler.Create)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusBadRequest, rb.Status)
+		projectUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Create Error Unauthorized", func(t *testing.T) {
+		projectUseCase := new(mocks.ProjectUseCase)
+
+		projectHandler := project.ProjectHandler{
+			Validate: validator.New(),
+			UseCase:  projectUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", nil)
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(projectHandler.Create)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusUnauthorized, rb.Status)
+	})
+}
+
+func TestHandler_Update(t *testing.T) {
+	t.Run("Update Success", func(t *testing.T) {
+		resp := response.Success(response.StatusOK, projects.Project{})
+
+		projectUseCase := new(mocks.ProjectUseCase)
+		projectUseCase.On("Update", mock.Anything, int64(1), users.RoleAdmin, projectReq).Return(resp)
+
+		projectHandler := project.ProjectHandler{
+			Validate: validator.New(),
+			UseCase:  projectUseCase,
+		}
+
+		newReq, err := json.Marshal(projectReq)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		r := httptest.NewRequest(http.MethodPatch, "/just/for/testing", bytes.NewReader(newReq))
+		r = mux.SetURLVars(r, map[string]string{"id": "1"})
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleAdmin),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(projectHandler.Update)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusOK, rb.Status)
+		projectUseCase.AssertExpectations(t)
+	})
+}
+
+func TestHandler_List(t *testing.T) {
+	t.Run("List Success", func(t *testing.T) {
+		resp := response.Success(response.StatusOK, []projects.Project{})
+
+		projectUseCase := new(mocks.ProjectUseCase)
+		projectUseCase.On("List", mock.Anything, users.RoleManager, true).Return(resp)
+
+		projectHandler := project.ProjectHandler{
+			Validate: validator.New(),
+			UseCase:  projectUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing?all=true", nil)
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleManager),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(projectHandler.List)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusOK, rb.Status)
+		projectUseCase.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,131 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	projects "github.com/Risuii/models/projects"
+)
+
+// ProjectRepository is an autogenerated mock type for the ProjectRepository type
+type ProjectRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, params
+func (_m *ProjectRepository) Create(ctx context.Context, params projects.Project) (int64, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, projects.Project) int64); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, projects.Project) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAll provides a mock function with given fields: ctx, activeOnly
+func (_m *ProjectRepository) FindAll(ctx context.Context, activeOnly bool) ([]projects.Project, error) {
+	ret := _m.Called(ctx, activeOnly)
+
+	var r0 []projects.Project
+	if rf, ok := ret.Get(0).(func(context.Context, bool) []projects.Project); ok {
+		r0 = rf(ctx, activeOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]projects.Project)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, activeOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByCode provides a mock function with given fields: ctx, code
+func (_m *ProjectRepository) FindByCode(ctx context.Context, code string) (projects.Project, error) {
+	ret := _m.Called(ctx, code)
+
+	var r0 projects.Project
+	if rf, ok := ret.Get(0).(func(context.Context, string) projects.Project); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Get(0).(projects.Project)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *ProjectRepository) FindByID(ctx context.Context, id int64) (projects.Project, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 projects.Project
+	if rf, ok := ret.Get(0).(func(context.Context, int64) projects.Project); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(projects.Project)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, params
+func (_m *ProjectRepository) Update(ctx context.Context, id int64, params projects.Project) error {
+	ret := _m.Called(ctx, id, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, projects.Project) error); ok {
+		r0 = rf(ctx, id, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewProjectRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewProjectRepository creates a new instance of ProjectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewProjectRepository(t mockConstructorTestingTNewProjectRepository) *ProjectRepository {
+	mock := &ProjectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
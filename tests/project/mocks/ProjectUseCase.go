@@ -0,0 +1,80 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	response "github.com/Risuii/helpers/response"
+	projects "github.com/Risuii/models/projects"
+)
+
+// ProjectUseCase is an autogenerated mock type for the ProjectUseCase type
+type ProjectUseCase struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, role, params
+func (_m *ProjectUseCase) Create(ctx context.Context, role string, params projects.ProjectReq) response.Response {
+	ret := _m.Called(ctx, role, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, projects.ProjectReq) response.Response); ok {
+		r0 = rf(ctx, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: ctx, role, all
+func (_m *ProjectUseCase) List(ctx context.Context, role string, all bool) response.Response {
+	ret := _m.Called(ctx, role, all)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) response.Response); ok {
+		r0 = rf(ctx, role, all)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, id, role, params
+func (_m *ProjectUseCase) Update(ctx context.Context, id int64, role string, params projects.ProjectReq) response.Response {
+	ret := _m.Called(ctx, id, role, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, projects.ProjectReq) response.Response); ok {
+		r0 = rf(ctx, id, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewProjectUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewProjectUseCase creates a new instance of ProjectUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewProjectUseCase(t mockConstructorTestingTNewProjectUseCase) *ProjectUseCase {
+	mock := &ProjectUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,184 @@
+package project_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/project"
+	"github.com/Risuii/models/projects"
+	"github.com/Risuii/tests/mock"
+)
+
+var currentTime = time.Date(2021, 12, 12, 0, 0, 0, 0, &time.Location{})
+var projectStruct = projects.Project{
+	ID:        1,
+	Code:      "ACME",
+	Name:      "Acme Portal",
+	Client:    "Acme",
+	Active:    true,
+	CreatedAt: currentTime,
+}
+var companyCtx = tenant.WithID(context.TODO(), 2)
+var projectColumns = []string{"id", "code", "name", "client", "active", "created_at"}
+
+func TestCreateRepo(t *testing.T) {
+	t.Run("Create Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := project.NewProjectRepositoryImpl(db, constant.TableProject)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableProject)
+
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(projectStruct.Code, projectStruct.Name, projectStruct.Client, projectStruct.Active, int64(2), projectStruct.CreatedAt).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		ID, err := repo.Create(companyCtx, projectStruct)
+
+		assert.Equal(t, int64(1), ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := project.NewProjectRepositoryImpl(db, constant.TableProject)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableProject)
+
+		mock.ExpectPrepare(query).ExpectExec().WillReturnError(fmt.Errorf("error"))
+
+		ID, err := repo.Create(companyCtx, projectStruct)
+
+		assert.Equal(t, int64(0), ID)
+		assert.Error(t, err)
+	})
+}
+
+func TestUpdateRepo(t *testing.T) {
+	t.Run("Update Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := project.NewProjectRepositoryImpl(db, constant.TableProject)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`UPDATE %s SET code = ?, name = ?, client = ?, active = ? WHERE id = ? AND company_id = ?`, constant.TableProject)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectExec().WithArgs(projectStruct.Code, projectStruct.Name, projectStruct.Client, projectStruct.Active, projectStruct.ID, int64(2)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Update(companyCtx, projectStruct.ID, projectStruct)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Update Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := project.NewProjectRepositoryImpl(db, constant.TableProject)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`UPDATE %s SET code = ?, name = ?, client = ?, active = ? WHERE id = ? AND company_id = ?`, constant.TableProject)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectExec().WillReturnError(fmt.Errorf("error"))
+
+		err := repo.Update(companyCtx, projectStruct.ID, projectStruct)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestFindByIDRepo(t *testing.T) {
+	t.Run("FindByID Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := project.NewProjectRepositoryImpl(db, constant.TableProject)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, code, name, client, active, created_at FROM %s WHERE id = ? AND company_id = ?`, constant.TableProject)
+		rows := sqlmock.NewRows(projectColumns).AddRow(projectStruct.ID, projectStruct.Code, projectStruct.Name, projectStruct.Client, projectStruct.Active, projectStruct.CreatedAt)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(projectStruct.ID, int64(2)).WillReturnRows(rows)
+
+		project, err := repo.FindByID(companyCtx, projectStruct.ID)
+
+		assert.Equal(t, projectStruct, project)
+		assert.NoError(t, err)
+	})
+
+	t.Run("FindByID Not Found", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := project.NewProjectRepositoryImpl(db, constant.TableProject)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, code, name, client, active, created_at FROM %s WHERE id = ? AND company_id = ?`, constant.TableProject)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(projectStruct.ID, int64(2)).WillReturnRows(sqlmock.NewRows(projectColumns))
+
+		_, err := repo.FindByID(companyCtx, projectStruct.ID)
+
+		assert.Equal(t, exception.ErrNotFound, err)
+	})
+}
+
+func TestFindByCodeRepo(t *testing.T) {
+	t.Run("FindByCode Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := project.NewProjectRepositoryImpl(db, constant.TableProject)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, code, name, client, active, created_at FROM %s WHERE code = ? AND company_id = ?`, constant.TableProject)
+		rows := sqlmock.NewRows(projectColumns).AddRow(projectStruct.ID, projectStruct.Code, projectStruct.Name, nil, projectStruct.Active, projectStruct.CreatedAt)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(projectStruct.Code, int64(2)).WillReturnRows(rows)
+
+		project, err := repo.FindByCode(companyCtx, projectStruct.Code)
+
+		assert.Equal(t, "", project.Client)
+		assert.NoError(t, err)
+	})
+}
+
+func TestFindAllRepo(t *testing.T) {
+	t.Run("FindAll Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := project.NewProjectRepositoryImpl(db, constant.TableProject)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, code, name, client, active, created_at FROM %s WHERE company_id = ? AND (? = FALSE OR active = TRUE) ORDER BY code`, constant.TableProject)
+		rows := sqlmock.NewRows(projectColumns).AddRow(projectStruct.ID, projectStruct.Code, projectStruct.Name, projectStruct.Client, projectStruct.Active, projectStruct.CreatedAt)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(int64(2), true).WillReturnRows(rows)
+
+		project, err := repo.FindAll(companyCtx, true)
+
+		assert.Len(t, project, 1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("FindAll Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := project.NewProjectRepositoryImpl(db, constant.TableProject)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, code, name, client, active, created_at FROM %s`, constant.TableProject)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(fmt.Errorf("error"))
+
+		_, err := repo.FindAll(companyCtx, false)
+
+		assert.Error(t, err)
+	})
+}
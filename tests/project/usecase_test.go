@@ -0,0 +1,179 @@
+package project_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/internal/project"
+	"github.com/Risuii/models/projects"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/project/mocks"
+)
+
+var projectReq = projects.ProjectReq{
+	Code:   "ACME",
+	Name:   "Acme Portal",
+	Client: "Acme",
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Create Success", func(t *testing.T) {
+		projectRepository := new(mocks.ProjectRepository)
+
+		projectRepository.On("FindByCode", mock.Anything, "ACME").Return(projects.Project{}, exception.ErrNotFound)
+		projectRepository.On("Create", mock.Anything, mock.MatchedBy(func(p projects.Project) bool {
+			return p.Code == "ACME" && p.Active
+		})).Return(int64(1), nil)
+
+		projectUseCase := project.NewProjectUseCase(projectRepository)
+
+		resp := projectUseCase.Create(context.TODO(), users.RoleManager, projectReq)
+
+		assert.NoError(t, resp.Err())
+		projectRepository.AssertExpectations(t)
+	})
+
+	t.Run("Create Error Forbidden", func(t *testing.T) {
+		projectRepository := new(mocks.ProjectRepository)
+
+		projectUseCase := project.NewProjectUseCase(projectRepository)
+
+		resp := projectUseCase.Create(context.TODO(), users.RoleEmployee, projectReq)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		projectRepository.AssertExpectations(t)
+	})
+
+	t.Run("Create Error Duplicate Code", func(t *testing.T) {
+		projectRepository := new(mocks.ProjectRepository)
+
+		projectRepository.On("FindByCode", mock.Anything, "ACME").Return(projects.Project{ID: 1, Code: "ACME"}, nil)
+
+		projectUseCase := project.NewProjectUseCase(projectRepository)
+
+		resp := projectUseCase.Create(context.TODO(), users.RoleAdmin, projectReq)
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+		projectRepository.AssertExpectations(t)
+	})
+
+	t.Run("Create Error Internal Server", func(t *testing.T) {
+		projectRepository := new(mocks.ProjectRepository)
+
+		projectRepository.On("FindByCode", mock.Anything, "ACME").Return(projects.Project{}, exception.ErrNotFound)
+		projectRepository.On("Create", mock.Anything, mock.AnythingOfType("projects.Project")).Return(int64(0), exception.ErrInternalServer)
+
+		projectUseCase := project.NewProjectUseCase(projectRepository)
+
+		resp := projectUseCase.Create(context.TODO(), users.RoleAdmin, projectReq)
+
+		assert.Equal(t, exception.ErrInternalServer, resp.Err())
+		projectRepository.AssertExpectations(t)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("Update Deactivate Success", func(t *testing.T) {
+		projectRepository := new(mocks.ProjectRepository)
+
+		inactive := false
+		params := projectReq
+		params.Active = &inactive
+
+		projectRepository.On("FindByID", mock.Anything, int64(1)).Return(projects.Project{ID: 1, Code: "ACME", Active: true}, nil)
+		projectRepository.On("Update", mock.Anything, int64(1), mock.MatchedBy(func(p projects.Project) bool {
+			return !p.Active
+		})).Return(nil)
+
+		projectUseCase := project.NewProjectUseCase(projectRepository)
+
+		resp := projectUseCase.Update(context.TODO(), 1, users.RoleManager, params)
+
+		assert.NoError(t, resp.Err())
+		projectRepository.AssertExpectations(t)
+	})
+
+	t.Run("Update Error Code Taken", func(t *testing.T) {
+		projectRepository := new(mocks.ProjectRepository)
+
+		params := projectReq
+		params.Code = "BETA"
+
+		projectRepository.On("FindByID", mock.Anything, int64(1)).Return(projects.Project{ID: 1, Code: "ACME", Active: true}, nil)
+		projectRepository.On("FindByCode", mock.Anything, "BETA").Return(projects.Project{ID: 2, Code: "BETA"}, nil)
+
+		projectUseCase := project.NewProjectUseCase(projectRepository)
+
+		resp := projectUseCase.Update(context.TODO(), 1, users.RoleManager, params)
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+		projectRepository.AssertExpectations(t)
+	})
+
+	t.Run("Update Error Not Found", func(t *testing.T) {
+		projectRepository := new(mocks.ProjectRepository)
+
+		projectRepository.On("FindByID", mock.Anything, int64(1)).Return(projects.Project{}, exception.ErrNotFound)
+
+		projectUseCase := project.NewProjectUseCase(projectRepository)
+
+		resp := projectUseCase.Update(context.TODO(), 1, users.RoleAdmin, projectReq)
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+		projectRepository.AssertExpectations(t)
+	})
+
+	t.Run("Update Error Forbidden", func(t *testing.T) {
+		projectRepository := new(mocks.ProjectRepository)
+
+		projectUseCase := project.NewProjectUseCase(projectRepository)
+
+		resp := projectUseCase.Update(context.TODO(), 1, users.RoleEmployee, projectReq)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("List Employee Sees Active Only", func(t *testing.T) {
+		projectRepository := new(mocks.ProjectRepository)
+
+		projectRepository.On("FindAll", mock.Anything, true).Return([]projects.Project{}, nil)
+
+		projectUseCase := project.NewProjectUseCase(projectRepository)
+
+		resp := projectUseCase.List(context.TODO(), users.RoleEmployee, true)
+
+		assert.NoError(t, resp.Err())
+		projectRepository.AssertExpectations(t)
+	})
+
+	t.Run("List Manager All", func(t *testing.T) {
+		projectRepository := new(mocks.ProjectRepository)
+
+		projectRepository.On("FindAll", mock.Anything, false).Return([]projects.Project{}, nil)
+
+		projectUseCase := project.NewProjectUseCase(projectRepository)
+
+		resp := projectUseCase.List(context.TODO(), users.RoleManager, true)
+
+		assert.NoError(t, resp.Err())
+		projectRepository.AssertExpectations(t)
+	})
+
+	t.Run("List Error Internal Server", func(t *testing.T) {
+		projectRepository := new(mocks.ProjectRepository)
+
+		projectRepository.On("FindAll", mock.Anything, true).Return([]projects.Project{}, exception.ErrInternalServer)
+
+		projectUseCase := project.NewProjectUseCase(projectRepository)
+
+		resp := projectUseCase.List(context.TODO(), users.RoleEmployee, false)
+
+		assert.Error(t, resp.Err())
+	})
+}
@@ -0,0 +1,248 @@
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	newJWT "github.com/dgrijalva/jwt-go"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/report"
+	"github.com/Risuii/models/reports"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/report/mocks"
+)
+
+func newToken(t *testing.T, role string) string {
+	mockToken := &jwt.JWTclaim{
+		ID:    1,
+		Email: "test@test.com",
+		Role:  role,
+		StandardClaims: newJWT.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+		},
+	}
+
+	tokenAlgo := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken)
+
+	token, err := tokenAlgo.SignedString(jwt.JWT_KEY)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestHandler_MonthlyRecap(t *testing.T) {
+	t.Run("MonthlyRecap Success", func(t *testing.T) {
+		mockData := reports.RecapReq{
+			Month: "2023-01",
+		}
+
+		resp := response.Success(response.StatusOK, []reports.Recap{})
+
+		reportUseCase := new(mocks.ReportUseCase)
+		reportUseCase.On("MonthlyRecap", mock.Anything, int64(1), users.RoleManager, mockData).Return(resp)
+
+		reportHandler := report.ReportHandler{
+			Validate: validator.New(),
+			UseCase:  reportUseCase,
+		}
+
+		newReq, err := json.Marshal(mockData)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleManager),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(reportHandler.MonthlyRecap)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusOK, rb.Status)
+
+		reportUseCase.AssertExpectations(t)
+	})
+
+	t.Run("MonthlyRecap Error Unauthorized", func(t *testing.T) {
+		reportUseCase := new(mocks.ReportUseCase)
+
+		reportHandler := report.ReportHandler{
+			Validate: validator.New(),
+			UseCase:  reportUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", nil)
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(reportHandler.MonthlyRecap)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusUnauthorized, rb.Status)
+
+		reportUseCase.AssertExpectations(t)
+	})
+
+	t.Run("MonthlyRecap Error Bad Request", func(t *testing.T) {
+		mockData := reports.RecapReq{
+			Month: "01-2023",
+		}
+
+		reportUseCase := new(mocks.ReportUseCase)
+
+		reportHandler := report.ReportHandler{
+			Validate: validator.New(),
+			UseCase:  reportUseCase,
+		}
+
+		newReq, err := json.Marshal(mockData)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleEmployee),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(reportHandler.MonthlyRecap)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusBadRequest, rb.Status)
+
+		reportUseCase.AssertExpectations(t)
+	})
+}
+
+func TestHandler_ExportAbsensi(t *testing.T) {
+	t.Run("ExportAbsensi Success", func(t *testing.T) {
+		params := reports.ExportReq{From: "2023-01-01", To: "2023-01-31", Format: reports.FormatCSV}
+
+		reportUseCase := new(mocks.ReportUseCase)
+		reportUseCase.On("ExportAbsensi", mock.Anything, int64(1), users.RoleAdmin, params, mock.Anything).Run(func(args mock.Arguments) {
+			w := args.Get(4).(io.Writer)
+			w.Write([]byte("ID\n"))
+		}).Return(response.Success(response.StatusOK, nil))
+
+		reportHandler := report.ReportHandler{
+			Validate: validator.New(),
+			UseCase:  reportUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing?from=2023-01-01&to=2023-01-31&format=csv", nil)
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleAdmin),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(reportHandler.ExportAbsensi)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "text/csv", recorder.Header().Get("Content-Type"))
+		assert.Contains(t, recorder.Header().Get("Content-Disposition"), "absensi_2023-01-01_2023-01-31.csv")
+		assert.Equal(t, "ID\n", recorder.Body.String())
+
+		reportUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ExportAbsensi Error Forbidden", func(t *testing.T) {
+		params := reports.ExportReq{From: "2023-01-01", To: "2023-01-31", UserID: 2, Format: reports.FormatXLSX}
+
+		reportUseCase := new(mocks.ReportUseCase)
+		reportUseCase.On("ExportAbsensi", mock.Anything, int64(1), users.RoleEmployee, params, mock.Anything).Return(response.Error(response.StatusForbiddend, exception.ErrForbidden))
+
+		reportHandler := report.ReportHandler{
+			Validate: validator.New(),
+			UseCase:  reportUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing?from=2023-01-01&to=2023-01-31&format=xlsx&userID=2", nil)
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleEmployee),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(reportHandler.ExportAbsensi)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusForbiddend, rb.Status)
+
+		reportUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ExportAbsensi Error Bad Request", func(t *testing.T) {
+		reportUseCase := new(mocks.ReportUseCase)
+
+		reportHandler := report.ReportHandler{
+			Validate: validator.New(),
+			UseCase:  reportUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing?from=2023-01-01&to=2023-01-31&format=pdf", nil)
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleEmployee),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(reportHandler.ExportAbsensi)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusBadRequest, rb.Status)
+
+		reportUseCase.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,131 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	absensis "github.com/Risuii/models/absensis"
+	activitys "github.com/Risuii/models/activitys"
+	leaves "github.com/Risuii/models/leaves"
+	mock "github.com/stretchr/testify/mock"
+
+	reports "github.com/Risuii/models/reports"
+)
+
+// ReportRepository is an autogenerated mock type for the ReportRepository type
+type ReportRepository struct {
+	mock.Mock
+}
+
+// FindAttendance provides a mock function with given fields: ctx, userID, from, to
+func (_m *ReportRepository) FindAttendance(ctx context.Context, userID int64, from string, to string) ([]absensis.Absensi, error) {
+	ret := _m.Called(ctx, userID, from, to)
+
+	var r0 []absensis.Absensi
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) []absensis.Absensi); ok {
+		r0 = rf(ctx, userID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]absensis.Absensi)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string) error); ok {
+		r1 = rf(ctx, userID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindEmployees provides a mock function with given fields: ctx, userID
+func (_m *ReportRepository) FindEmployees(ctx context.Context, userID int64) ([]reports.Employee, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []reports.Employee
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []reports.Employee); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reports.Employee)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindLeaves provides a mock function with given fields: ctx, userID, from, to
+func (_m *ReportRepository) FindLeaves(ctx context.Context, userID int64, from string, to string) ([]leaves.Leave, error) {
+	ret := _m.Called(ctx, userID, from, to)
+
+	var r0 []leaves.Leave
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) []leaves.Leave); ok {
+		r0 = rf(ctx, userID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]leaves.Leave)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string) error); ok {
+		r1 = rf(ctx, userID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StreamAbsensi provides a mock function with given fields: ctx, params, fn
+func (_m *ReportRepository) StreamAbsensi(ctx context.Context, params reports.ExportReq, fn func(absensis.Absensi) error) error {
+	ret := _m.Called(ctx, params, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, reports.ExportReq, func(absensis.Absensi) error) error); ok {
+		r0 = rf(ctx, params, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamActivity provides a mock function with given fields: ctx, params, fn
+func (_m *ReportRepository) StreamActivity(ctx context.Context, params reports.ExportReq, fn func(activitys.Activity) error) error {
+	ret := _m.Called(ctx, params, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, reports.ExportReq, func(activitys.Activity) error) error); ok {
+		r0 = rf(ctx, params, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewReportRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewReportRepository creates a new instance of ReportRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewReportRepository(t mockConstructorTestingTNewReportRepository) *ReportRepository {
+	mock := &ReportRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
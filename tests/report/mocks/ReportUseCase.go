@@ -0,0 +1,106 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	response "github.com/Risuii/helpers/response"
+	reports "github.com/Risuii/models/reports"
+)
+
+// ReportUseCase is an autogenerated mock type for the ReportUseCase type
+type ReportUseCase struct {
+	mock.Mock
+}
+
+// Calculate provides a mock function with given fields: ctx, userID, from, to
+func (_m *ReportUseCase) Calculate(ctx context.Context, userID int64, from time.Time, to time.Time) ([]reports.Recap, error) {
+	ret := _m.Called(ctx, userID, from, to)
+
+	var r0 []reports.Recap
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) []reports.Recap); ok {
+		r0 = rf(ctx, userID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reports.Recap)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, userID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportAbsensi provides a mock function with given fields: ctx, userID, role, params, w
+func (_m *ReportUseCase) ExportAbsensi(ctx context.Context, userID int64, role string, params reports.ExportReq, w io.Writer) response.Response {
+	ret := _m.Called(ctx, userID, role, params, w)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, reports.ExportReq, io.Writer) response.Response); ok {
+		r0 = rf(ctx, userID, role, params, w)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// ExportActivity provides a mock function with given fields: ctx, userID, role, params, w
+func (_m *ReportUseCase) ExportActivity(ctx context.Context, userID int64, role string, params reports.ExportReq, w io.Writer) response.Response {
+	ret := _m.Called(ctx, userID, role, params, w)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, reports.ExportReq, io.Writer) response.Response); ok {
+		r0 = rf(ctx, userID, role, params, w)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// MonthlyRecap provides a mock function with given fields: ctx, userID, role, params
+func (_m *ReportUseCase) MonthlyRecap(ctx context.Context, userID int64, role string, params reports.RecapReq) response.Response {
+	ret := _m.Called(ctx, userID, role, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, reports.RecapReq) response.Response); ok {
+		r0 = rf(ctx, userID, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewReportUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewReportUseCase creates a new instance of ReportUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewReportUseCase(t mockConstructorTestingTNewReportUseCase) *ReportUseCase {
+	mock := &ReportUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,243 @@
+package report_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/report"
+	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/reports"
+	"github.com/Risuii/tests/mock"
+)
+
+var monthStart = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestFindEmployeesRepo(t *testing.T) {
+	query := regexp.QuoteMeta(fmt.Sprintf(`SELECT e.id, e.code, e.name, s.id, s.name, s.start_time, s.end_time, s.grace_minutes, s.work_days FROM %s e LEFT JOIN %s s ON s.id = e.shift_id WHERE e.company_id = ? AND (? = 0 OR e.id = ?) ORDER BY e.id`, constant.TableEmployee, constant.TableShift))
+	columns := []string{"id", "code", "name", "id", "name", "start_time", "end_time", "grace_minutes", "work_days"}
+
+	t.Run("FindEmployees Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := report.NewReportRepositoryImpl(db)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows(columns).
+			AddRow(1, "EMP001", "test", 1, "pagi", "08:00:00", "17:00:00", 15, "1,2,3,4,5").
+			AddRow(2, nil, "tanpa shift", nil, nil, nil, nil, nil, nil)
+
+		mock.ExpectQuery(query).WithArgs(int64(5), int64(0), int64(0)).WillReturnRows(rows)
+
+		result, err := repo.FindEmployees(tenant.WithID(context.TODO(), 5), 0)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "EMP001", result[0].Code)
+		assert.Equal(t, "08:00:00", result[0].Shift.StartTime)
+		assert.Empty(t, result[1].Shift.StartTime)
+	})
+
+	t.Run("FindEmployees Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := report.NewReportRepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectQuery(query).WithArgs(int64(5), int64(1), int64(1)).WillReturnError(fmt.Errorf("error"))
+
+		result, err := repo.FindEmployees(tenant.WithID(context.TODO(), 5), 1)
+
+		assert.Empty(t, result)
+		assert.Error(t, err)
+	})
+}
+
+func TestFindAttendanceRepo(t *testing.T) {
+	query := regexp.QuoteMeta(fmt.Sprintf(`SELECT id, userID, name, checkin, checkout, date, status, approved_overtime_minutes FROM %s WHERE company_id = ? AND (? = 0 OR userID = ?) AND date BETWEEN ? AND ? ORDER BY userID, date, id`, constant.TableAbsensi))
+
+	t.Run("FindAttendance Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := report.NewReportRepositoryImpl(db)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "userID", "name", "checkin", "checkout", "date", "status", "approved_overtime_minutes"}).
+			AddRow(1, 1, "test", monthStart, nil, monthStart, absensis.StatusAbsent, 0)
+
+		mock.ExpectQuery(query).WithArgs(int64(5), int64(1), int64(1), "2023-01-01", "2023-01-31").WillReturnRows(rows)
+
+		result, err := repo.FindAttendance(tenant.WithID(context.TODO(), 5), 1, "2023-01-01", "2023-01-31")
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.True(t, result[0].Checkout.IsZero())
+	})
+
+	t.Run("FindAttendance Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := report.NewReportRepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectQuery(query).WithArgs(int64(5), int64(1), int64(1), "2023-01-01", "2023-01-31").WillReturnError(fmt.Errorf("error"))
+
+		result, err := repo.FindAttendance(tenant.WithID(context.TODO(), 5), 1, "2023-01-01", "2023-01-31")
+
+		assert.Empty(t, result)
+		assert.Error(t, err)
+	})
+}
+
+func TestFindLeavesRepo(t *testing.T) {
+	query := regexp.QuoteMeta(fmt.Sprintf(`SELECT id, userID, start_date, end_date, type FROM %s WHERE userID IN (SELECT id FROM %s WHERE company_id = ?) AND (? = 0 OR userID = ?) AND start_date <= ? AND end_date >= ?`, constant.TableLeave, constant.TableEmployee))
+
+	t.Run("FindLeaves Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := report.NewReportRepositoryImpl(db)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "userID", "start_date", "end_date", "type"}).
+			AddRow(1, 1, monthStart, monthStart.AddDate(0, 0, 2), "annual")
+
+		mock.ExpectQuery(query).WithArgs(int64(5), int64(1), int64(1), "2023-01-31", "2023-01-01").WillReturnRows(rows)
+
+		result, err := repo.FindLeaves(tenant.WithID(context.TODO(), 5), 1, "2023-01-01", "2023-01-31")
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("FindLeaves Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := report.NewReportRepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectQuery(query).WithArgs(int64(5), int64(1), int64(1), "2023-01-31", "2023-01-01").WillReturnError(fmt.Errorf("error"))
+
+		result, err := repo.FindLeaves(tenant.WithID(context.TODO(), 5), 1, "2023-01-01", "2023-01-31")
+
+		assert.Empty(t, result)
+		assert.Error(t, err)
+	})
+}
+
+func TestStreamAbsensiRepo(t *testing.T) {
+	query := regexp.QuoteMeta(fmt.Sprintf(`SELECT id, userID, name, checkin, checkout, date, status, overtime_minutes, approved_overtime_minutes FROM %s WHERE company_id = ? AND (? = 0 OR userID = ?) AND date BETWEEN ? AND ? ORDER BY date, id`, constant.TableAbsensi))
+	params := reports.ExportReq{From: "2023-01-01", To: "2023-01-31", Format: reports.FormatCSV}
+
+	t.Run("StreamAbsensi Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := report.NewReportRepositoryImpl(db)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "userID", "name", "checkin", "checkout", "date", "status", "overtime_minutes", "approved_overtime_minutes"}).
+			AddRow(1, 1, "test", monthStart, monthStart, monthStart, absensis.StatusPresent, 0, 0).
+			AddRow(2, 2, "test", nil, nil, monthStart, absensis.StatusAbsent, 0, 0)
+
+		mock.ExpectQuery(query).WithArgs(int64(5), int64(0), int64(0), params.From, params.To).WillReturnRows(rows)
+
+		var result []absensis.Absensi
+		err := repo.StreamAbsensi(tenant.WithID(context.TODO(), 5), params, func(a absensis.Absensi) error {
+			result = append(result, a)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+
+	t.Run("StreamAbsensi Success Members", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := report.NewReportRepositoryImpl(db)
+
+		defer db.Close()
+
+		scoped := params
+		scoped.UserIDs = []int64{2, 3}
+
+		rows := sqlmock.NewRows([]string{"id", "userID", "name", "checkin", "checkout", "date", "status", "overtime_minutes", "approved_overtime_minutes"}).
+			AddRow(1, 2, "test", monthStart, monthStart, monthStart, absensis.StatusPresent, 0, 0)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`date BETWEEN ? AND ? AND userID IN (?, ?) ORDER BY date, id`)).WithArgs(int64(5), int64(0), int64(0), params.From, params.To, int64(2), int64(3)).WillReturnRows(rows)
+
+		var result []absensis.Absensi
+		err := repo.StreamAbsensi(tenant.WithID(context.TODO(), 5), scoped, func(a absensis.Absensi) error {
+			result = append(result, a)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("StreamAbsensi Error Callback", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := report.NewReportRepositoryImpl(db)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "userID", "name", "checkin", "checkout", "date", "status", "overtime_minutes", "approved_overtime_minutes"}).
+			AddRow(1, 1, "test", monthStart, monthStart, monthStart, absensis.StatusPresent, 0, 0)
+
+		mock.ExpectQuery(query).WithArgs(int64(5), int64(0), int64(0), params.From, params.To).WillReturnRows(rows)
+
+		err := repo.StreamAbsensi(tenant.WithID(context.TODO(), 5), params, func(a absensis.Absensi) error {
+			return fmt.Errorf("error")
+		})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestStreamActivityRepo(t *testing.T) {
+	query := regexp.QuoteMeta(fmt.Sprintf(`SELECT id, userID, deskripsi, created_at, update_at FROM %s WHERE company_id = ? AND (? = 0 OR userID = ?) AND DATE(created_at) BETWEEN ? AND ? AND deleted_at IS NULL ORDER BY created_at, id`, constant.TableActivity))
+	params := reports.ExportReq{From: "2023-01-01", To: "2023-01-31", UserID: 1, Format: reports.FormatCSV}
+
+	t.Run("StreamActivity Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := report.NewReportRepositoryImpl(db)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "userID", "deskripsi", "created_at", "update_at"}).
+			AddRow(1, 1, "test", monthStart, monthStart)
+
+		mock.ExpectQuery(query).WithArgs(int64(5), int64(1), int64(1), params.From, params.To).WillReturnRows(rows)
+
+		var result []activitys.Activity
+		err := repo.StreamActivity(tenant.WithID(context.TODO(), 5), params, func(a activitys.Activity) error {
+			result = append(result, a)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("StreamActivity Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := report.NewReportRepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectQuery(query).WithArgs(int64(5), int64(1), int64(1), params.From, params.To).WillReturnError(fmt.Errorf("error"))
+
+		err := repo.StreamActivity(tenant.WithID(context.TODO(), 5), params, func(a activitys.Activity) error {
+			return nil
+		})
+
+		assert.Error(t, err)
+	})
+}
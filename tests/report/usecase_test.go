@@ -0,0 +1,359 @@
+package report_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/xuri/excelize/v2"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/report"
+	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/leaves"
+	"github.com/Risuii/models/orgs"
+	"github.com/Risuii/models/reports"
+	"github.com/Risuii/models/shifts"
+	"github.com/Risuii/models/users"
+	orgMocks "github.com/Risuii/tests/org/mocks"
+	"github.com/Risuii/tests/report/mocks"
+)
+
+var employeeStruct = reports.Employee{
+	UserID: 1,
+	Name:   "test",
+	Shift: shifts.Shift{
+		ID:           1,
+		StartTime:    "08:00:00",
+		EndTime:      "17:00:00",
+		GraceMinutes: 15,
+		WorkDays:     "1,2,3,4,5",
+	},
+}
+
+func day(d int, hour int, minute int) time.Time {
+	return time.Date(2023, 1, d, hour, minute, 0, 0, time.UTC)
+}
+
+func TestCalculate(t *testing.T) {
+	t.Run("Calculate Success", func(t *testing.T) {
+		reportRepository := new(mocks.ReportRepository)
+
+		attendance := []absensis.Absensi{
+			// on time, 9 hours with 90 approved overtime minutes
+			{ID: 1, UserID: 1, Checkin: day(2, 8, 0), Checkout: day(2, 17, 0), Date: day(2, 0, 0), Status: absensis.StatusPresent, ApprovedOvertimeMinutes: 90},
+			// inside grace, wfh
+			{ID: 2, UserID: 1, Checkin: day(3, 8, 10), Checkout: day(3, 17, 10), Date: day(3, 0, 0), Status: absensis.StatusWFH},
+			// 30 minutes late
+			{ID: 3, UserID: 1, Checkin: day(4, 8, 30), Checkout: day(4, 17, 0), Date: day(4, 0, 0), Status: absensis.StatusPresent},
+			{ID: 4, UserID: 1, Date: day(5, 0, 0), Status: absensis.StatusAbsent},
+		}
+		// Friday 6th to Monday 9th covers two work days
+		leave := []leaves.Leave{
+			{ID: 1, UserID: 1, StartDate: day(6, 0, 0), EndDate: day(9, 0, 0)},
+		}
+
+		reportRepository.On("FindEmployees", mock.Anything, int64(1)).Return([]reports.Employee{employeeStruct}, nil)
+		reportRepository.On("FindAttendance", mock.Anything, int64(1), "2023-01-01", "2023-01-31").Return(attendance, nil)
+		reportRepository.On("FindLeaves", mock.Anything, int64(1), "2023-01-01", "2023-01-31").Return(leave, nil)
+
+		reportUseCase := report.NewReportUseCase(reportRepository, new(orgMocks.Chain))
+
+		result, err := reportUseCase.Calculate(context.TODO(), 1, day(1, 0, 0), day(31, 0, 0))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []reports.Recap{{
+			UserID:        1,
+			Name:          "test",
+			Month:         "2023-01",
+			DaysPresent:   3,
+			DaysLate:      1,
+			LateMinutes:   30,
+			DaysAbsent:    1,
+			LeaveDays:     2,
+			WFHDays:       1,
+			WorkedHours:   26.5,
+			OvertimeHours: 1.5,
+		}}, result)
+		reportRepository.AssertExpectations(t)
+	})
+
+	t.Run("Calculate Success Company Timezone", func(t *testing.T) {
+		reportRepository := new(mocks.ReportRepository)
+
+		jakarta, err := time.LoadLocation("Asia/Jakarta")
+		assert.NoError(t, err)
+
+		// 08:10 and 08:30 WIB as read back from the database
+		attendance := []absensis.Absensi{
+			{ID: 1, UserID: 1, Checkin: day(2, 1, 10), Checkout: day(2, 10, 0), Date: day(2, 0, 0), Status: absensis.StatusPresent},
+			{ID: 2, UserID: 1, Checkin: day(3, 1, 30), Checkout: day(3, 10, 0), Date: day(3, 0, 0), Status: absensis.StatusPresent},
+		}
+
+		reportRepository.On("FindEmployees", mock.Anything, int64(1)).Return([]reports.Employee{employeeStruct}, nil)
+		reportRepository.On("FindAttendance", mock.Anything, int64(1), "2023-01-01", "2023-01-31").Return(attendance, nil)
+		reportRepository.On("FindLeaves", mock.Anything, int64(1), "2023-01-01", "2023-01-31").Return([]leaves.Leave{}, nil)
+
+		reportUseCase := report.NewReportUseCase(reportRepository, new(orgMocks.Chain))
+
+		ctx := tenant.WithLocation(context.TODO(), jakarta)
+		result, err := reportUseCase.Calculate(ctx, 1, day(1, 0, 0), day(31, 0, 0))
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, 1, result[0].DaysLate)
+		assert.Equal(t, 30, result[0].LateMinutes)
+		reportRepository.AssertExpectations(t)
+	})
+
+	t.Run("Calculate Error Internal Server", func(t *testing.T) {
+		reportRepository := new(mocks.ReportRepository)
+
+		reportRepository.On("FindEmployees", mock.Anything, int64(1)).Return([]reports.Employee{}, exception.ErrInternalServer)
+
+		reportUseCase := report.NewReportUseCase(reportRepository, new(orgMocks.Chain))
+
+		result, err := reportUseCase.Calculate(context.TODO(), 1, day(1, 0, 0), day(31, 0, 0))
+
+		assert.Empty(t, result)
+		assert.Equal(t, exception.ErrInternalServer, err)
+		reportRepository.AssertExpectations(t)
+	})
+}
+
+func TestMonthlyRecap(t *testing.T) {
+	t.Run("MonthlyRecap Success Own", func(t *testing.T) {
+		reportRepository := new(mocks.ReportRepository)
+
+		reportRepository.On("FindEmployees", mock.Anything, int64(1)).Return([]reports.Employee{employeeStruct}, nil)
+		reportRepository.On("FindAttendance", mock.Anything, int64(1), "2023-02-01", "2023-02-28").Return([]absensis.Absensi{}, nil)
+		reportRepository.On("FindLeaves", mock.Anything, int64(1), "2023-02-01", "2023-02-28").Return([]leaves.Leave{}, nil)
+
+		reportUseCase := report.NewReportUseCase(reportRepository, new(orgMocks.Chain))
+
+		resp := reportUseCase.MonthlyRecap(context.TODO(), 1, users.RoleEmployee, reports.RecapReq{Month: "2023-02"})
+
+		assert.NoError(t, resp.Err())
+		reportRepository.AssertExpectations(t)
+	})
+
+	t.Run("MonthlyRecap Success Manager All", func(t *testing.T) {
+		reportRepository := new(mocks.ReportRepository)
+
+		outside := reports.Employee{UserID: 3, Name: "other"}
+
+		chain := new(orgMocks.Chain)
+		chain.On("Reports", mock.Anything, int64(2)).Return([]orgs.Member{{UserID: 1}}, nil)
+
+		reportRepository.On("FindEmployees", mock.Anything, int64(0)).Return([]reports.Employee{employeeStruct, outside}, nil)
+		reportRepository.On("FindAttendance", mock.Anything, int64(0), "2023-01-01", "2023-01-31").Return([]absensis.Absensi{}, nil)
+		reportRepository.On("FindLeaves", mock.Anything, int64(0), "2023-01-01", "2023-01-31").Return([]leaves.Leave{}, nil)
+
+		reportUseCase := report.NewReportUseCase(reportRepository, chain)
+
+		resp := reportUseCase.MonthlyRecap(context.TODO(), 2, users.RoleManager, reports.RecapReq{Month: "2023-01"})
+
+		assert.NoError(t, resp.Err())
+		recap := resp.(*response.ResponseImpl).Data.([]reports.Recap)
+		assert.Len(t, recap, 1)
+		assert.Equal(t, int64(1), recap[0].UserID)
+		reportRepository.AssertExpectations(t)
+		chain.AssertExpectations(t)
+	})
+
+	t.Run("MonthlyRecap Success Manager Report", func(t *testing.T) {
+		reportRepository := new(mocks.ReportRepository)
+
+		chain := new(orgMocks.Chain)
+		chain.On("InChain", mock.Anything, int64(2), int64(1)).Return(true, nil)
+
+		reportRepository.On("FindEmployees", mock.Anything, int64(1)).Return([]reports.Employee{employeeStruct}, nil)
+		reportRepository.On("FindAttendance", mock.Anything, int64(1), "2023-01-01", "2023-01-31").Return([]absensis.Absensi{}, nil)
+		reportRepository.On("FindLeaves", mock.Anything, int64(1), "2023-01-01", "2023-01-31").Return([]leaves.Leave{}, nil)
+
+		reportUseCase := report.NewReportUseCase(reportRepository, chain)
+
+		resp := reportUseCase.MonthlyRecap(context.TODO(), 2, users.RoleManager, reports.RecapReq{Month: "2023-01", UserID: 1})
+
+		assert.NoError(t, resp.Err())
+		reportRepository.AssertExpectations(t)
+		chain.AssertExpectations(t)
+	})
+
+	t.Run("MonthlyRecap Error Forbidden Outside Chain", func(t *testing.T) {
+		reportRepository := new(mocks.ReportRepository)
+
+		chain := new(orgMocks.Chain)
+		chain.On("InChain", mock.Anything, int64(2), int64(3)).Return(false, nil)
+
+		reportUseCase := report.NewReportUseCase(reportRepository, chain)
+
+		resp := reportUseCase.MonthlyRecap(context.TODO(), 2, users.RoleManager, reports.RecapReq{Month: "2023-01", UserID: 3})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		reportRepository.AssertExpectations(t)
+		chain.AssertExpectations(t)
+	})
+
+	t.Run("MonthlyRecap Error Forbidden", func(t *testing.T) {
+		reportRepository := new(mocks.ReportRepository)
+
+		reportUseCase := report.NewReportUseCase(reportRepository, new(orgMocks.Chain))
+
+		resp := reportUseCase.MonthlyRecap(context.TODO(), 1, users.RoleEmployee, reports.RecapReq{Month: "2023-01", UserID: 2})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		reportRepository.AssertExpectations(t)
+	})
+
+	t.Run("MonthlyRecap Error Not Found", func(t *testing.T) {
+		reportRepository := new(mocks.ReportRepository)
+
+		reportRepository.On("FindEmployees", mock.Anything, int64(9)).Return([]reports.Employee{}, nil)
+		reportRepository.On("FindAttendance", mock.Anything, int64(9), "2023-01-01", "2023-01-31").Return([]absensis.Absensi{}, nil)
+		reportRepository.On("FindLeaves", mock.Anything, int64(9), "2023-01-01", "2023-01-31").Return([]leaves.Leave{}, nil)
+
+		reportUseCase := report.NewReportUseCase(reportRepository, new(orgMocks.Chain))
+
+		resp := reportUseCase.MonthlyRecap(context.TODO(), 1, users.RoleAdmin, reports.RecapReq{Month: "2023-01", UserID: 9})
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+		reportRepository.AssertExpectations(t)
+	})
+}
+
+func TestExportAbsensi(t *testing.T) {
+	row := absensis.Absensi{ID: 1, UserID: 1, Name: "test", Checkin: day(2, 8, 0), Checkout: day(2, 17, 0), Date: day(2, 0, 0), Status: absensis.StatusPresent}
+
+	t.Run("ExportAbsensi Success CSV", func(t *testing.T) {
+		reportRepository := new(mocks.ReportRepository)
+
+		params := reports.ExportReq{From: "2023-01-01", To: "2023-01-31", UserID: 1, Format: reports.FormatCSV, Lang: reports.LangEN}
+
+		reportRepository.On("StreamAbsensi", mock.Anything, params, mock.AnythingOfType("func(absensis.Absensi) error")).Run(func(args mock.Arguments) {
+			fn := args.Get(2).(func(absensis.Absensi) error)
+			fn(row)
+		}).Return(nil)
+
+		reportUseCase := report.NewReportUseCase(reportRepository, new(orgMocks.Chain))
+
+		var buf bytes.Buffer
+		resp := reportUseCase.ExportAbsensi(context.TODO(), 1, users.RoleEmployee, params, &buf)
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, "ID,Employee ID,Name,Date,Check In,Check Out,Status,Overtime (Minutes),Approved Overtime (Minutes)\n"+
+			"1,1,test,2023-01-02,2023-01-02 08:00:00,2023-01-02 17:00:00,present,0,0\n", buf.String())
+		reportRepository.AssertExpectations(t)
+	})
+
+	t.Run("ExportAbsensi Success XLSX", func(t *testing.T) {
+		reportRepository := new(mocks.ReportRepository)
+
+		params := reports.ExportReq{From: "2023-01-01", To: "2023-01-31", Format: reports.FormatXLSX}
+
+		reportRepository.On("StreamAbsensi", mock.Anything, params, mock.AnythingOfType("func(absensis.Absensi) error")).Run(func(args mock.Arguments) {
+			fn := args.Get(2).(func(absensis.Absensi) error)
+			fn(row)
+		}).Return(nil)
+
+		reportUseCase := report.NewReportUseCase(reportRepository, new(orgMocks.Chain))
+
+		var buf bytes.Buffer
+		resp := reportUseCase.ExportAbsensi(context.TODO(), 2, users.RoleAdmin, params, &buf)
+
+		assert.NoError(t, resp.Err())
+
+		file, err := excelize.OpenReader(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer file.Close()
+
+		rows, err := file.GetRows("Sheet1")
+		assert.NoError(t, err)
+		assert.Len(t, rows, 2)
+		assert.Equal(t, "Nama", rows[0][2])
+		assert.Equal(t, "test", rows[1][2])
+		reportRepository.AssertExpectations(t)
+	})
+
+	t.Run("ExportAbsensi Success Manager Chain", func(t *testing.T) {
+		reportRepository := new(mocks.ReportRepository)
+
+		chain := new(orgMocks.Chain)
+		chain.On("Reports", mock.Anything, int64(2)).Return([]orgs.Member{{UserID: 1}, {UserID: 4}}, nil)
+
+		params := reports.ExportReq{From: "2023-01-01", To: "2023-01-31", Format: reports.FormatCSV}
+		scoped := params
+		scoped.UserIDs = []int64{2, 1, 4}
+
+		reportRepository.On("StreamAbsensi", mock.Anything, scoped, mock.AnythingOfType("func(absensis.Absensi) error")).Return(nil)
+
+		reportUseCase := report.NewReportUseCase(reportRepository, chain)
+
+		var buf bytes.Buffer
+		resp := reportUseCase.ExportAbsensi(context.TODO(), 2, users.RoleManager, params, &buf)
+
+		assert.NoError(t, resp.Err())
+		reportRepository.AssertExpectations(t)
+		chain.AssertExpectations(t)
+	})
+
+	t.Run("ExportAbsensi Error Forbidden", func(t *testing.T) {
+		reportRepository := new(mocks.ReportRepository)
+
+		reportUseCase := report.NewReportUseCase(reportRepository, new(orgMocks.Chain))
+
+		var buf bytes.Buffer
+		resp := reportUseCase.ExportAbsensi(context.TODO(), 1, users.RoleEmployee, reports.ExportReq{UserID: 2, Format: reports.FormatCSV}, &buf)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		assert.Empty(t, buf.String())
+		reportRepository.AssertExpectations(t)
+	})
+
+	t.Run("ExportAbsensi Error Internal Server", func(t *testing.T) {
+		reportRepository := new(mocks.ReportRepository)
+
+		params := reports.ExportReq{From: "2023-01-01", To: "2023-01-31", UserID: 1, Format: reports.FormatCSV}
+
+		reportRepository.On("StreamAbsensi", mock.Anything, params, mock.AnythingOfType("func(absensis.Absensi) error")).Return(exception.ErrInternalServer)
+
+		reportUseCase := report.NewReportUseCase(reportRepository, new(orgMocks.Chain))
+
+		var buf bytes.Buffer
+		resp := reportUseCase.ExportAbsensi(context.TODO(), 1, users.RoleEmployee, params, &buf)
+
+		assert.Equal(t, exception.ErrInternalServer, resp.Err())
+		assert.Empty(t, buf.String())
+		reportRepository.AssertExpectations(t)
+	})
+}
+
+func TestExportActivity(t *testing.T) {
+	t.Run("ExportActivity Success CSV", func(t *testing.T) {
+		reportRepository := new(mocks.ReportRepository)
+
+		params := reports.ExportReq{From: "2023-01-01", To: "2023-01-31", UserID: 1, Format: reports.FormatCSV}
+
+		reportRepository.On("StreamActivity", mock.Anything, params, mock.AnythingOfType("func(activitys.Activity) error")).Run(func(args mock.Arguments) {
+			fn := args.Get(2).(func(activitys.Activity) error)
+			fn(activitys.Activity{ID: 1, UserID: 1, Description: "rapat, review", CreatedAt: day(2, 0, 0), UpdateAt: day(2, 0, 0)})
+		}).Return(nil)
+
+		reportUseCase := report.NewReportUseCase(reportRepository, new(orgMocks.Chain))
+
+		var buf bytes.Buffer
+		resp := reportUseCase.ExportActivity(context.TODO(), 1, users.RoleEmployee, params, &buf)
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, "ID,ID Karyawan,Deskripsi,Dibuat,Diubah\n1,1,\"rapat, review\",2023-01-02,2023-01-02\n", buf.String())
+		reportRepository.AssertExpectations(t)
+	})
+}
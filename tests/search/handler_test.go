@@ -0,0 +1,141 @@
+package search_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	newJWT "github.com/dgrijalva/jwt-go"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/search"
+	"github.com/Risuii/models/searches"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/search/mocks"
+)
+
+func newToken(t *testing.T, role string) string {
+	mockToken := &jwt.JWTclaim{
+		ID:    1,
+		Email: "test@test.com",
+		Role:  role,
+		StandardClaims: newJWT.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+		},
+	}
+
+	tokenAlgo := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken)
+
+	token, err := tokenAlgo.SignedString(jwt.JWT_KEY)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestHandler_Activity(t *testing.T) {
+	t.Run("Activity Success", func(t *testing.T) {
+		mockData := searches.SearchReq{Query: "invoice migration"}
+
+		resp := response.Success(response.StatusOK, []searches.Result{})
+
+		searchUseCase := new(mocks.SearchUseCase)
+		searchUseCase.On("Activity", mock.Anything, int64(1), users.RoleManager, mockData).Return(resp)
+
+		searchHandler := search.SearchHandler{
+			Validate: validator.New(),
+			UseCase:  searchUseCase,
+		}
+
+		newReq, err := json.Marshal(mockData)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleManager),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(searchHandler.Activity)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusOK, rb.Status)
+		searchUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Activity Error Validation", func(t *testing.T) {
+		searchUseCase := new(mocks.SearchUseCase)
+
+		searchHandler := search.SearchHandler{
+			Validate: validator.New(),
+			UseCase:  searchUseCase,
+		}
+
+		newReq, err := json.Marshal(searches.SearchReq{Query: "a"})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleEmployee),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(searchHandler.Activity)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusBadRequest, rb.Status)
+		searchUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Activity Error Unauthorized", func(t *testing.T) {
+		searchUseCase := new(mocks.SearchUseCase)
+
+		searchHandler := search.SearchHandler{
+			Validate: validator.New(),
+			UseCase:  searchUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", nil)
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(searchHandler.Activity)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusUnauthorized, rb.Status)
+	})
+}
@@ -0,0 +1,75 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	searches "github.com/Risuii/models/searches"
+)
+
+// SearchRepository is an autogenerated mock type for the SearchRepository type
+type SearchRepository struct {
+	mock.Mock
+}
+
+// Count provides a mock function with given fields: ctx, terms, params
+func (_m *SearchRepository) Count(ctx context.Context, terms []string, params searches.SearchReq) (int64, error) {
+	ret := _m.Called(ctx, terms, params)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, []string, searches.SearchReq) int64); ok {
+		r0 = rf(ctx, terms, params)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string, searches.SearchReq) error); ok {
+		r1 = rf(ctx, terms, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: ctx, terms, params
+func (_m *SearchRepository) Search(ctx context.Context, terms []string, params searches.SearchReq) ([]searches.Result, error) {
+	ret := _m.Called(ctx, terms, params)
+
+	var r0 []searches.Result
+	if rf, ok := ret.Get(0).(func(context.Context, []string, searches.SearchReq) []searches.Result); ok {
+		r0 = rf(ctx, terms, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]searches.Result)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string, searches.SearchReq) error); ok {
+		r1 = rf(ctx, terms, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewSearchRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSearchRepository creates a new instance of SearchRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSearchRepository(t mockConstructorTestingTNewSearchRepository) *SearchRepository {
+	mock := &SearchRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
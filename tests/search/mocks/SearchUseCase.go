@@ -0,0 +1,48 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	response "github.com/Risuii/helpers/response"
+	mock "github.com/stretchr/testify/mock"
+
+	searches "github.com/Risuii/models/searches"
+)
+
+// SearchUseCase is an autogenerated mock type for the SearchUseCase type
+type SearchUseCase struct {
+	mock.Mock
+}
+
+// Activity provides a mock function with given fields: ctx, userID, role, params
+func (_m *SearchUseCase) Activity(ctx context.Context, userID int64, role string, params searches.SearchReq) response.Response {
+	ret := _m.Called(ctx, userID, role, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, searches.SearchReq) response.Response); ok {
+		r0 = rf(ctx, userID, role, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewSearchUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSearchUseCase creates a new instance of SearchUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSearchUseCase(t mockConstructorTestingTNewSearchUseCase) *SearchUseCase {
+	mock := &SearchUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,137 @@
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/search"
+	"github.com/Risuii/models/paginations"
+	"github.com/Risuii/models/searches"
+	"github.com/Risuii/tests/mock"
+)
+
+var currentTime = time.Date(2021, 12, 12, 0, 0, 0, 0, &time.Location{})
+var resultColumns = []string{"id", "userID", "name", "projectID", "deskripsi", "created_at"}
+var searchParams = searches.SearchReq{
+	Query:  "invoice migration",
+	From:   "2021-12-01",
+	To:     "2021-12-31",
+	UserID: 1,
+	Pagination: paginations.Pagination{
+		Limit:  20,
+		Cursor: 9,
+		Sort:   paginations.SortDesc,
+	},
+}
+
+func TestSearchRepo(t *testing.T) {
+	t.Run("Search FullText Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := search.NewSearchRepositoryImpl(db, true)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT a.id, a.userID, e.name, a.projectID, a.deskripsi, a.created_at FROM %s a JOIN %s e ON e.id = a.userID WHERE a.company_id = ? AND a.deleted_at IS NULL AND MATCH(a.deskripsi) AGAINST (? IN BOOLEAN MODE) AND a.userID = ? AND DATE(a.created_at) >= ? AND DATE(a.created_at) <= ? AND a.id < ? ORDER BY a.id DESC LIMIT ?`, constant.TableActivity, constant.TableEmployee)
+		rows := sqlmock.NewRows(resultColumns).AddRow(3, 1, "test", nil, "invoice migration", currentTime)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(int64(2), "+invoice* +migration*", int64(1), "2021-12-01", "2021-12-31", int64(9), 20).WillReturnRows(rows)
+
+		result, err := repo.Search(tenant.WithID(context.TODO(), 2), []string{"invoice", "migration"}, searchParams)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "test", result[0].Name)
+	})
+
+	t.Run("Search Like Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := search.NewSearchRepositoryImpl(db, false)
+
+		defer db.Close()
+
+		params := searches.SearchReq{
+			Query:      "invoice",
+			ProjectID:  2,
+			Pagination: paginations.Pagination{Limit: 20, Sort: paginations.SortAsc},
+		}
+
+		query := fmt.Sprintf(`SELECT a.id, a.userID, e.name, a.projectID, a.deskripsi, a.created_at FROM %s a JOIN %s e ON e.id = a.userID WHERE a.company_id = ? AND a.deleted_at IS NULL AND a.deskripsi LIKE ? AND a.projectID = ? ORDER BY a.id ASC LIMIT ?`, constant.TableActivity, constant.TableEmployee)
+		rows := sqlmock.NewRows(resultColumns).AddRow(3, 1, "test", 2, "invoice", currentTime)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(int64(2), "%invoice%", int64(2), 20).WillReturnRows(rows)
+
+		result, err := repo.Search(tenant.WithID(context.TODO(), 2), []string{"invoice"}, params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), result[0].ProjectID)
+	})
+
+	t.Run("Search Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := search.NewSearchRepositoryImpl(db, true)
+
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT a.id`)).WillReturnError(fmt.Errorf("error"))
+
+		_, err := repo.Search(tenant.WithID(context.TODO(), 2), []string{"invoice"}, searchParams)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCountRepo(t *testing.T) {
+	t.Run("Count Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := search.NewSearchRepositoryImpl(db, true)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT COUNT(*) FROM %s a WHERE a.company_id = ? AND a.deleted_at IS NULL AND MATCH(a.deskripsi) AGAINST (? IN BOOLEAN MODE) AND a.userID = ? AND DATE(a.created_at) >= ? AND DATE(a.created_at) <= ?`, constant.TableActivity)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(int64(2), "+invoice*", int64(1), "2021-12-01", "2021-12-31").WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(4))
+
+		total, err := repo.Count(tenant.WithID(context.TODO(), 2), []string{"invoice"}, searchParams)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), total)
+	})
+
+	t.Run("Count Success Members", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := search.NewSearchRepositoryImpl(db, true)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT COUNT(*) FROM %s a WHERE a.company_id = ? AND a.deleted_at IS NULL AND MATCH(a.deskripsi) AGAINST (? IN BOOLEAN MODE) AND a.userID IN (?, ?)`, constant.TableActivity)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(int64(2), "+invoice*", int64(1), int64(3)).WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(2))
+
+		total, err := repo.Count(tenant.WithID(context.TODO(), 2), []string{"invoice"}, searches.SearchReq{UserIDs: []int64{1, 3}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+	})
+
+	t.Run("Count Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := search.NewSearchRepositoryImpl(db, true)
+
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*)`)).WillReturnError(fmt.Errorf("error"))
+
+		total, err := repo.Count(tenant.WithID(context.TODO(), 2), []string{"invoice"}, searchParams)
+
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), total)
+	})
+}
@@ -0,0 +1,153 @@
+package search_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/search"
+	"github.com/Risuii/models/orgs"
+	"github.com/Risuii/models/paginations"
+	"github.com/Risuii/models/searches"
+	"github.com/Risuii/models/users"
+	orgMocks "github.com/Risuii/tests/org/mocks"
+	"github.com/Risuii/tests/search/mocks"
+)
+
+func TestActivity(t *testing.T) {
+	t.Run("Activity Employee Scoped To Self", func(t *testing.T) {
+		searchRepository := new(mocks.SearchRepository)
+
+		scoped := mock.MatchedBy(func(p searches.SearchReq) bool {
+			return p.UserID == 1 && p.Sort == paginations.SortDesc && p.Limit == paginations.DefaultLimit
+		})
+		searchRepository.On("Search", mock.Anything, []string{"invoice", "migration"}, scoped).Return([]searches.Result{{ID: 3, Description: "Invoice migration <v2>"}}, nil)
+		searchRepository.On("Count", mock.Anything, []string{"invoice", "migration"}, scoped).Return(int64(1), nil)
+
+		searchUseCase := search.NewSearchUseCase(searchRepository, new(orgMocks.Chain))
+
+		resp := searchUseCase.Activity(context.TODO(), 1, users.RoleEmployee, searches.SearchReq{Query: "Invoice +migration"})
+
+		assert.NoError(t, resp.Err())
+		result := resp.(*response.ResponseImpl).Data.([]searches.Result)
+		assert.Equal(t, "<mark>Invoice</mark> <mark>migration</mark> &lt;v2&gt;", result[0].Snippet)
+		searchRepository.AssertExpectations(t)
+	})
+
+	t.Run("Activity Error Employee Other User", func(t *testing.T) {
+		searchRepository := new(mocks.SearchRepository)
+
+		searchUseCase := search.NewSearchUseCase(searchRepository, new(orgMocks.Chain))
+
+		resp := searchUseCase.Activity(context.TODO(), 1, users.RoleEmployee, searches.SearchReq{Query: "invoice", UserID: 2})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		searchRepository.AssertExpectations(t)
+	})
+
+	t.Run("Activity Manager Searches Their Chain", func(t *testing.T) {
+		searchRepository := new(mocks.SearchRepository)
+
+		chain := new(orgMocks.Chain)
+		chain.On("Reports", mock.Anything, int64(1)).Return([]orgs.Member{{UserID: 2}, {UserID: 3}}, nil)
+
+		team := mock.MatchedBy(func(p searches.SearchReq) bool {
+			return p.UserID == 0 && assert.ObjectsAreEqual([]int64{1, 2, 3}, p.UserIDs)
+		})
+		searchRepository.On("Search", mock.Anything, []string{"invoice"}, team).Return([]searches.Result{}, nil)
+		searchRepository.On("Count", mock.Anything, []string{"invoice"}, team).Return(int64(0), nil)
+
+		searchUseCase := search.NewSearchUseCase(searchRepository, chain)
+
+		resp := searchUseCase.Activity(context.TODO(), 1, users.RoleManager, searches.SearchReq{Query: "invoice"})
+
+		assert.NoError(t, resp.Err())
+		searchRepository.AssertExpectations(t)
+		chain.AssertExpectations(t)
+	})
+
+	t.Run("Activity Error Manager Outside Chain", func(t *testing.T) {
+		searchRepository := new(mocks.SearchRepository)
+
+		chain := new(orgMocks.Chain)
+		chain.On("InChain", mock.Anything, int64(1), int64(9)).Return(false, nil)
+
+		searchUseCase := search.NewSearchUseCase(searchRepository, chain)
+
+		resp := searchUseCase.Activity(context.TODO(), 1, users.RoleManager, searches.SearchReq{Query: "invoice", UserID: 9})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		searchRepository.AssertExpectations(t)
+		chain.AssertExpectations(t)
+	})
+
+	t.Run("Activity Admin Searches Everyone", func(t *testing.T) {
+		searchRepository := new(mocks.SearchRepository)
+
+		everyone := mock.MatchedBy(func(p searches.SearchReq) bool {
+			return p.UserID == 0 && len(p.UserIDs) == 0
+		})
+		searchRepository.On("Search", mock.Anything, []string{"invoice"}, everyone).Return([]searches.Result{}, nil)
+		searchRepository.On("Count", mock.Anything, []string{"invoice"}, everyone).Return(int64(0), nil)
+
+		searchUseCase := search.NewSearchUseCase(searchRepository, new(orgMocks.Chain))
+
+		resp := searchUseCase.Activity(context.TODO(), 1, users.RoleAdmin, searches.SearchReq{Query: "invoice"})
+
+		assert.NoError(t, resp.Err())
+		searchRepository.AssertExpectations(t)
+	})
+
+	t.Run("Activity Error Only Operators", func(t *testing.T) {
+		searchRepository := new(mocks.SearchRepository)
+
+		searchUseCase := search.NewSearchUseCase(searchRepository, new(orgMocks.Chain))
+
+		resp := searchUseCase.Activity(context.TODO(), 1, users.RoleAdmin, searches.SearchReq{Query: "+-*"})
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+	})
+
+	t.Run("Activity Error Internal Server", func(t *testing.T) {
+		searchRepository := new(mocks.SearchRepository)
+
+		searchRepository.On("Search", mock.Anything, []string{"invoice"}, mock.AnythingOfType("searches.SearchReq")).Return([]searches.Result{}, exception.ErrInternalServer)
+
+		searchUseCase := search.NewSearchUseCase(searchRepository, new(orgMocks.Chain))
+
+		resp := searchUseCase.Activity(context.TODO(), 1, users.RoleAdmin, searches.SearchReq{Query: "invoice"})
+
+		assert.Equal(t, exception.ErrInternalServer, resp.Err())
+	})
+}
+
+func TestHighlight(t *testing.T) {
+	t.Run("Highlight Long Description", func(t *testing.T) {
+		text := strings.Repeat("lorem ipsum ", 30) + "invoice migration done " + strings.Repeat("dolor sit ", 30)
+
+		snippet := search.Highlight(text, []string{"invoice"})
+
+		assert.True(t, strings.HasPrefix(snippet, "…"))
+		assert.True(t, strings.HasSuffix(snippet, "…"))
+		assert.Contains(t, snippet, "<mark>invoice</mark> migration")
+		assert.Less(t, len(snippet), len(text))
+	})
+
+	t.Run("Highlight Keeps Runes Whole", func(t *testing.T) {
+		text := strings.Repeat("é", 200)
+
+		snippet := search.Highlight(text, []string{"x"})
+
+		assert.True(t, strings.HasSuffix(snippet, "…"))
+		assert.NotContains(t, snippet, "�")
+	})
+
+	t.Run("Terms Strip Operators", func(t *testing.T) {
+		assert.Equal(t, []string{"invoice", "migration"}, search.Terms(`+Invoice "migration" invoice*`))
+	})
+}
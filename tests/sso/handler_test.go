@@ -0,0 +1,150 @@
+package sso_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	newJWT "github.com/dgrijalva/jwt-go"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/internal/sso"
+	"github.com/Risuii/models/companies"
+	"github.com/Risuii/models/users"
+	companymocks "github.com/Risuii/tests/company/mocks"
+	"github.com/Risuii/tests/mock"
+	"github.com/Risuii/tests/sso/mocks"
+	usermocks "github.com/Risuii/tests/user/mocks"
+)
+
+// newApp serves the sso routes backed by the local provider idp.
+func newApp(idp *mock.OIDCProvider, ssoRepository *mocks.SSORepository) *httptest.Server {
+	router := mux.NewRouter()
+	app := httptest.NewServer(router)
+
+	s := settings(idp)
+	s.RedirectURL = app.URL + "/sso/callback"
+
+	companyRepository := new(companymocks.CompanyRepository)
+	companyRepository.On("FindByID", testifymock.Anything, int64(2)).Return(companies.Company{ID: 2, Timezone: "Asia/Jakarta"}, nil)
+
+	ssoUseCase := sso.NewSSOUseCase(ssoRepository, new(usermocks.UserRepository), companyRepository, sso.NewProvider(s), s)
+	sso.NewSSOHandler(router, validator.New(), ssoUseCase)
+
+	return app
+}
+
+func tokenCookie(jar http.CookieJar, app *httptest.Server) string {
+	appURL, _ := url.Parse(app.URL)
+	for _, c := range jar.Cookies(appURL) {
+		if c.Name == "token" {
+			return c.Value
+		}
+	}
+
+	return ""
+}
+
+func TestHandler_Flow(t *testing.T) {
+	t.Run("Login Through Provider", func(t *testing.T) {
+		idp := mock.NewOIDCProvider()
+		defer idp.Close()
+
+		ssoRepository := new(mocks.SSORepository)
+		ssoRepository.On("FindBySubject", testifymock.Anything, idp.URL(), "user-1").Return(users.Employee{ID: 5, Email: "test@test.com", Role: users.RoleManager, Status: users.StatusActive, CompanyID: 2}, nil)
+
+		app := newApp(idp, ssoRepository)
+		defer app.Close()
+
+		jar, _ := cookiejar.New(nil)
+		client := &http.Client{Jar: jar}
+
+		resp, err := client.Get(app.URL + "/sso/login")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "/sso/callback", resp.Request.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.Equal(t, "OK", body["status"])
+
+		claims := &jwt.JWTclaim{}
+		_, err = newJWT.ParseWithClaims(tokenCookie(jar, app), claims, func(t *newJWT.Token) (interface{}, error) {
+			return jwt.JWT_KEY, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), claims.ID)
+		assert.Equal(t, users.RoleManager, claims.Role)
+
+		ssoRepository.AssertExpectations(t)
+	})
+
+	t.Run("Callback Without Login", func(t *testing.T) {
+		idp := mock.NewOIDCProvider()
+		defer idp.Close()
+
+		ssoRepository := new(mocks.SSORepository)
+
+		app := newApp(idp, ssoRepository)
+		defer app.Close()
+
+		jar, _ := cookiejar.New(nil)
+		client := &http.Client{Jar: jar}
+
+		resp, err := client.Get(app.URL + "/sso/callback?code=" + idp.Code("nonce") + "&state=state")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Empty(t, tokenCookie(jar, app))
+
+		ssoRepository.AssertNotCalled(t, "FindBySubject", testifymock.Anything, testifymock.Anything, testifymock.Anything)
+	})
+
+	t.Run("Provider Denied", func(t *testing.T) {
+		idp := mock.NewOIDCProvider()
+		defer idp.Close()
+
+		app := newApp(idp, new(mocks.SSORepository))
+		defer app.Close()
+
+		resp, err := http.Get(app.URL + "/sso/callback?error=access_denied&state=state")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("Unknown Employee", func(t *testing.T) {
+		idp := mock.NewOIDCProvider()
+		defer idp.Close()
+
+		idp.Claims["email_verified"] = false
+
+		ssoRepository := new(mocks.SSORepository)
+		ssoRepository.On("FindBySubject", testifymock.Anything, idp.URL(), "user-1").Return(users.Employee{}, exception.ErrNotFound)
+
+		app := newApp(idp, ssoRepository)
+		defer app.Close()
+
+		jar, _ := cookiejar.New(nil)
+		client := &http.Client{Jar: jar}
+
+		resp, err := client.Get(app.URL + "/sso/login")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Empty(t, tokenCookie(jar, app))
+	})
+}
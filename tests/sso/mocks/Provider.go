@@ -0,0 +1,72 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	identities "github.com/Risuii/models/identities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Provider is an autogenerated mock type for the Provider type
+type Provider struct {
+	mock.Mock
+}
+
+// AuthURL provides a mock function with given fields: ctx, state, nonce
+func (_m *Provider) AuthURL(ctx context.Context, state string, nonce string) (string, error) {
+	ret := _m.Called(ctx, state, nonce)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, state, nonce)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, state, nonce)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Exchange provides a mock function with given fields: ctx, code, nonce
+func (_m *Provider) Exchange(ctx context.Context, code string, nonce string) (identities.Identity, error) {
+	ret := _m.Called(ctx, code, nonce)
+
+	var r0 identities.Identity
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) identities.Identity); ok {
+		r0 = rf(ctx, code, nonce)
+	} else {
+		r0 = ret.Get(0).(identities.Identity)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, code, nonce)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewProvider interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewProvider creates a new instance of Provider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewProvider(t mockConstructorTestingTNewProvider) *Provider {
+	mock := &Provider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
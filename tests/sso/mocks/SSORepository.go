@@ -0,0 +1,104 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	identities "github.com/Risuii/models/identities"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	users "github.com/Risuii/models/users"
+)
+
+// SSORepository is an autogenerated mock type for the SSORepository type
+type SSORepository struct {
+	mock.Mock
+}
+
+// Claim provides a mock function with given fields: ctx, userID, params, claimedAt
+func (_m *SSORepository) Claim(ctx context.Context, userID int64, params identities.Identity, claimedAt time.Time) error {
+	ret := _m.Called(ctx, userID, params, claimedAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, identities.Identity, time.Time) error); ok {
+		r0 = rf(ctx, userID, params, claimedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindBySubject provides a mock function with given fields: ctx, issuer, subject
+func (_m *SSORepository) FindBySubject(ctx context.Context, issuer string, subject string) (users.Employee, error) {
+	ret := _m.Called(ctx, issuer, subject)
+
+	var r0 users.Employee
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) users.Employee); ok {
+		r0 = rf(ctx, issuer, subject)
+	} else {
+		r0 = ret.Get(0).(users.Employee)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, issuer, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Link provides a mock function with given fields: ctx, userID, params
+func (_m *SSORepository) Link(ctx context.Context, userID int64, params identities.Identity) error {
+	ret := _m.Called(ctx, userID, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, identities.Identity) error); ok {
+		r0 = rf(ctx, userID, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Provision provides a mock function with given fields: ctx, employee, params
+func (_m *SSORepository) Provision(ctx context.Context, employee users.Employee, params identities.Identity) (int64, error) {
+	ret := _m.Called(ctx, employee, params)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, users.Employee, identities.Identity) int64); ok {
+		r0 = rf(ctx, employee, params)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, users.Employee, identities.Identity) error); ok {
+		r1 = rf(ctx, employee, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewSSORepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSSORepository creates a new instance of SSORepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSSORepository(t mockConstructorTestingTNewSSORepository) *SSORepository {
+	mock := &SSORepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,79 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	response "github.com/Risuii/helpers/response"
+	identities "github.com/Risuii/models/identities"
+	mock "github.com/stretchr/testify/mock"
+
+	token "github.com/Risuii/models/token"
+)
+
+// SSOUseCase is an autogenerated mock type for the SSOUseCase type
+type SSOUseCase struct {
+	mock.Mock
+}
+
+// Callback provides a mock function with given fields: ctx, params, login
+func (_m *SSOUseCase) Callback(ctx context.Context, params identities.CallbackReq, login identities.Login) (response.Response, token.Token) {
+	ret := _m.Called(ctx, params, login)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, identities.CallbackReq, identities.Login) response.Response); ok {
+		r0 = rf(ctx, params, login)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	var r1 token.Token
+	if rf, ok := ret.Get(1).(func(context.Context, identities.CallbackReq, identities.Login) token.Token); ok {
+		r1 = rf(ctx, params, login)
+	} else {
+		r1 = ret.Get(1).(token.Token)
+	}
+
+	return r0, r1
+}
+
+// Start provides a mock function with given fields: ctx
+func (_m *SSOUseCase) Start(ctx context.Context) (response.Response, identities.Login) {
+	ret := _m.Called(ctx)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context) response.Response); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	var r1 identities.Login
+	if rf, ok := ret.Get(1).(func(context.Context) identities.Login); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Get(1).(identities.Login)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewSSOUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSSOUseCase creates a new instance of SSOUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSSOUseCase(t mockConstructorTestingTNewSSOUseCase) *SSOUseCase {
+	mock := &SSOUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,152 @@
+package sso_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/internal/sso"
+	"github.com/Risuii/models/identities"
+	"github.com/Risuii/tests/mock"
+)
+
+func settings(idp *mock.OIDCProvider) identities.Settings {
+	return identities.Settings{
+		Issuer:       idp.URL(),
+		ClientID:     idp.ClientID,
+		ClientSecret: idp.ClientSecret,
+		RedirectURL:  "https://absensi.test/sso/callback",
+	}
+}
+
+func TestAuthURL(t *testing.T) {
+	t.Run("AuthURL Success", func(t *testing.T) {
+		idp := mock.NewOIDCProvider()
+		defer idp.Close()
+
+		provider := sso.NewProvider(settings(idp))
+
+		URL, err := provider.AuthURL(context.TODO(), "state", "nonce")
+
+		assert.NoError(t, err)
+
+		parsed, err := url.Parse(URL)
+		assert.NoError(t, err)
+		assert.Equal(t, idp.URL()+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+
+		query := parsed.Query()
+		assert.Equal(t, "code", query.Get("response_type"))
+		assert.Equal(t, "absensi", query.Get("client_id"))
+		assert.Equal(t, "https://absensi.test/sso/callback", query.Get("redirect_uri"))
+		assert.Equal(t, "openid email profile", query.Get("scope"))
+		assert.Equal(t, "state", query.Get("state"))
+		assert.Equal(t, "nonce", query.Get("nonce"))
+	})
+
+	t.Run("AuthURL Error Issuer Mismatch", func(t *testing.T) {
+		idp := mock.NewOIDCProvider()
+		defer idp.Close()
+
+		s := settings(idp)
+		s.Issuer = idp.URL() + "/other"
+
+		_, err := sso.NewProvider(s).AuthURL(context.TODO(), "state", "nonce")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestExchange(t *testing.T) {
+	t.Run("Exchange Success", func(t *testing.T) {
+		idp := mock.NewOIDCProvider()
+		defer idp.Close()
+
+		identity, err := sso.NewProvider(settings(idp)).Exchange(context.TODO(), idp.Code("nonce"), "nonce")
+
+		assert.NoError(t, err)
+		assert.Equal(t, identities.Identity{
+			Issuer:        idp.URL(),
+			Subject:       "user-1",
+			Email:         "test@test.com",
+			EmailVerified: true,
+			Name:          "Test",
+		}, identity)
+	})
+
+	t.Run("Exchange Audience List", func(t *testing.T) {
+		idp := mock.NewOIDCProvider()
+		defer idp.Close()
+
+		idp.Claims["aud"] = []string{"absensi", "other"}
+		idp.Claims["azp"] = "absensi"
+
+		_, err := sso.NewProvider(settings(idp)).Exchange(context.TODO(), idp.Code("nonce"), "nonce")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Exchange Key Rotated", func(t *testing.T) {
+		idp := mock.NewOIDCProvider()
+		defer idp.Close()
+
+		provider := sso.NewProvider(settings(idp))
+
+		_, err := provider.Exchange(context.TODO(), idp.Code("nonce"), "nonce")
+		assert.NoError(t, err)
+
+		idp.Rotate()
+
+		_, err = provider.Exchange(context.TODO(), idp.Code("nonce"), "nonce")
+		assert.NoError(t, err)
+	})
+
+	invalid := map[string]func(idp *mock.OIDCProvider){
+		"Nonce":    func(idp *mock.OIDCProvider) { idp.Claims["nonce"] = "other" },
+		"Audience": func(idp *mock.OIDCProvider) { idp.Claims["aud"] = "other" },
+		"Party": func(idp *mock.OIDCProvider) {
+			idp.Claims["aud"] = []string{"absensi", "other"}
+			idp.Claims["azp"] = "other"
+		},
+		"Issuer":  func(idp *mock.OIDCProvider) { idp.Claims["iss"] = "https://evil.test" },
+		"Expired": func(idp *mock.OIDCProvider) { idp.Claims["exp"] = time.Now().Add(-time.Minute).Unix() },
+		"Subject": func(idp *mock.OIDCProvider) { idp.Claims["sub"] = "" },
+		"Signature": func(idp *mock.OIDCProvider) {
+			idp.Signer, _ = rsa.GenerateKey(rand.Reader, 2048)
+		},
+		"Client Secret": func(idp *mock.OIDCProvider) { idp.ClientSecret = "changed" },
+	}
+
+	for name, tamper := range invalid {
+		tamper := tamper
+		t.Run("Exchange Error "+name, func(t *testing.T) {
+			idp := mock.NewOIDCProvider()
+			defer idp.Close()
+
+			provider := sso.NewProvider(settings(idp))
+			tamper(idp)
+
+			_, err := provider.Exchange(context.TODO(), idp.Code("nonce"), "nonce")
+
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("Exchange Error Code Reused", func(t *testing.T) {
+		idp := mock.NewOIDCProvider()
+		defer idp.Close()
+
+		provider := sso.NewProvider(settings(idp))
+		code := idp.Code("nonce")
+
+		_, err := provider.Exchange(context.TODO(), code, "nonce")
+		assert.NoError(t, err)
+
+		_, err = provider.Exchange(context.TODO(), code, "nonce")
+		assert.Error(t, err)
+	})
+}
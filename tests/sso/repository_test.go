@@ -0,0 +1,169 @@
+package sso_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/internal/sso"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/mock"
+)
+
+var currentTime = time.Date(2023, 1, 2, 8, 0, 0, 0, time.UTC)
+
+func TestFindBySubject(t *testing.T) {
+	query := regexp.QuoteMeta(fmt.Sprintf(`FROM %s i JOIN %s e ON e.id = i.userID WHERE i.issuer = ? AND i.subject = ?`, constant.TableIdentity, constant.TableEmployee))
+
+	t.Run("FindBySubject Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := sso.NewSSORepositoryImpl(db)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "name", "email", "role", "status", "company_id", "created_at", "update_at"}).
+			AddRow(5, "Test", "test@test.com", users.RoleEmployee, users.StatusActive, 2, currentTime, currentTime)
+
+		mock.ExpectPrepare(query).ExpectQuery().WithArgs("https://idp.test", "user-1").WillReturnRows(rows)
+
+		employee, err := repo.FindBySubject(context.TODO(), "https://idp.test", "user-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, users.Employee{
+			ID:        5,
+			Name:      "Test",
+			Email:     "test@test.com",
+			Role:      users.RoleEmployee,
+			Status:    users.StatusActive,
+			CompanyID: 2,
+			CreatedAt: currentTime,
+			UpdateAt:  currentTime,
+		}, employee)
+	})
+
+	t.Run("FindBySubject Not Found", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := sso.NewSSORepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectPrepare(query).ExpectQuery().WithArgs("https://idp.test", "user-2").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.FindBySubject(context.TODO(), "https://idp.test", "user-2")
+
+		assert.Equal(t, exception.ErrNotFound, err)
+	})
+}
+
+func TestLink(t *testing.T) {
+	t.Run("Link Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := sso.NewSSORepositoryImpl(db)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableIdentity)
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(int64(5), "https://idp.test", "user-1", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := repo.Link(context.TODO(), 5, identityStruct)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestClaim(t *testing.T) {
+	update := regexp.QuoteMeta(fmt.Sprintf(`UPDATE %s SET password = '', status = ?, update_at = ? WHERE id = ? AND status = ?`, constant.TableEmployee))
+	identityInsert := fmt.Sprintf(`INSERT INTO %s`, constant.TableIdentity)
+
+	t.Run("Claim Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := sso.NewSSORepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(update).WithArgs(users.StatusActive, currentTime, int64(5), users.StatusPending).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(identityInsert).WithArgs(int64(5), "https://idp.test", "user-1", currentTime).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		err := repo.Claim(context.TODO(), 5, identityStruct, currentTime)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Claim Error No Longer Pending", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := sso.NewSSORepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(update).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err := repo.Claim(context.TODO(), 5, identityStruct, currentTime)
+
+		assert.Equal(t, exception.ErrConflicted, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProvision(t *testing.T) {
+	employeeInsert := fmt.Sprintf(`INSERT INTO %s`, constant.TableEmployee)
+	identityInsert := fmt.Sprintf(`INSERT INTO %s`, constant.TableIdentity)
+
+	employee := users.Employee{
+		Name:      "Test",
+		Email:     "test@test.com",
+		Role:      users.RoleEmployee,
+		Status:    users.StatusActive,
+		CompanyID: 2,
+		CreatedAt: currentTime,
+		UpdateAt:  currentTime,
+	}
+
+	t.Run("Provision Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := sso.NewSSORepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(employeeInsert).WithArgs("Test", "test@test.com", users.RoleEmployee, users.StatusActive, int64(2), currentTime, currentTime).WillReturnResult(sqlmock.NewResult(9, 1))
+		mock.ExpectExec(identityInsert).WithArgs(int64(9), "https://idp.test", "user-1", currentTime).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		ID, err := repo.Provision(context.TODO(), employee, identityStruct)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9), ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Provision Error Rolls Back", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := sso.NewSSORepositoryImpl(db)
+
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(employeeInsert).WillReturnResult(sqlmock.NewResult(9, 1))
+		mock.ExpectExec(identityInsert).WillReturnError(fmt.Errorf("duplicate entry"))
+		mock.ExpectRollback()
+
+		ID, err := repo.Provision(context.TODO(), employee, identityStruct)
+
+		assert.Equal(t, exception.ErrInternalServer, err)
+		assert.Equal(t, int64(0), ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,279 @@
+package sso_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	newJWT "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/sso"
+	"github.com/Risuii/models/companies"
+	"github.com/Risuii/models/identities"
+	"github.com/Risuii/models/users"
+	companymocks "github.com/Risuii/tests/company/mocks"
+	"github.com/Risuii/tests/sso/mocks"
+	usermocks "github.com/Risuii/tests/user/mocks"
+)
+
+var identityStruct = identities.Identity{
+	Issuer:        "https://idp.test",
+	Subject:       "user-1",
+	Email:         "test@test.com",
+	EmailVerified: true,
+	Name:          "Test",
+}
+
+var login = identities.Login{
+	State: "state",
+	Nonce: "nonce",
+}
+
+var callback = identities.CallbackReq{
+	Code:  "code",
+	State: "state",
+}
+
+func TestStart(t *testing.T) {
+	t.Run("Start Success", func(t *testing.T) {
+		provider := new(mocks.Provider)
+
+		var state, nonce string
+		provider.On("AuthURL", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Run(func(args mock.Arguments) {
+			state = args.String(1)
+			nonce = args.String(2)
+		}).Return("https://idp.test/authorize?state=x", nil)
+
+		ssoUseCase := sso.NewSSOUseCase(new(mocks.SSORepository), new(usermocks.UserRepository), new(companymocks.CompanyRepository), provider, identities.Settings{})
+
+		resp, started := ssoUseCase.Start(context.TODO())
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, "https://idp.test/authorize?state=x", started.URL)
+		assert.Equal(t, state, started.State)
+		assert.Equal(t, nonce, started.Nonce)
+		assert.NotEmpty(t, started.State)
+		assert.NotEqual(t, started.State, started.Nonce)
+	})
+
+	t.Run("Start Error Provider", func(t *testing.T) {
+		provider := new(mocks.Provider)
+		provider.On("AuthURL", mock.Anything, mock.Anything, mock.Anything).Return("", errors.New("connection refused"))
+
+		ssoUseCase := sso.NewSSOUseCase(new(mocks.SSORepository), new(usermocks.UserRepository), new(companymocks.CompanyRepository), provider, identities.Settings{})
+
+		resp, started := ssoUseCase.Start(context.TODO())
+
+		assert.Equal(t, exception.ErrInternalServer, resp.Err())
+		assert.Empty(t, started.State)
+	})
+}
+
+func TestCallback(t *testing.T) {
+	setup := func() (*mocks.SSORepository, *usermocks.UserRepository, *companymocks.CompanyRepository, *mocks.Provider) {
+		provider := new(mocks.Provider)
+		provider.On("Exchange", mock.Anything, "code", "nonce").Return(identityStruct, nil)
+
+		companyRepository := new(companymocks.CompanyRepository)
+		companyRepository.On("FindByID", mock.Anything, int64(2)).Return(companies.Company{ID: 2, Timezone: "Asia/Jakarta"}, nil)
+
+		return new(mocks.SSORepository), new(usermocks.UserRepository), companyRepository, provider
+	}
+
+	t.Run("Callback Linked Identity", func(t *testing.T) {
+		ssoRepository, userRepository, companyRepository, provider := setup()
+
+		ssoRepository.On("FindBySubject", mock.Anything, "https://idp.test", "user-1").Return(users.Employee{ID: 5, Email: "test@test.com", Role: users.RoleEmployee, Status: users.StatusActive, CompanyID: 2}, nil)
+
+		ssoUseCase := sso.NewSSOUseCase(ssoRepository, userRepository, companyRepository, provider, identities.Settings{})
+
+		resp, tokens := ssoUseCase.Callback(context.TODO(), callback, login)
+
+		assert.NoError(t, resp.Err())
+
+		claims := &jwt.JWTclaim{}
+		_, err := newJWT.ParseWithClaims(tokens.Token, claims, func(t *newJWT.Token) (interface{}, error) {
+			return jwt.JWT_KEY, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), claims.ID)
+		assert.Equal(t, int64(2), claims.CompanyID)
+		assert.Equal(t, "Asia/Jakarta", claims.Timezone)
+
+		ssoRepository.AssertExpectations(t)
+		companyRepository.AssertExpectations(t)
+		userRepository.AssertNotCalled(t, "FindByEmail", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Callback Links Verified Email", func(t *testing.T) {
+		ssoRepository, userRepository, companyRepository, provider := setup()
+
+		ssoRepository.On("FindBySubject", mock.Anything, "https://idp.test", "user-1").Return(users.Employee{}, exception.ErrNotFound)
+		userRepository.On("FindByEmail", mock.Anything, "test@test.com").Return(users.Employee{ID: 5, Password: "hashed", Status: users.StatusActive, CompanyID: 2}, nil)
+		ssoRepository.On("Link", mock.Anything, int64(5), identityStruct).Return(nil)
+
+		ssoUseCase := sso.NewSSOUseCase(ssoRepository, userRepository, companyRepository, provider, identities.Settings{})
+
+		resp, tokens := ssoUseCase.Callback(context.TODO(), callback, login)
+
+		assert.NoError(t, resp.Err())
+		assert.NotEmpty(t, tokens.Token)
+		assert.Empty(t, resp.(*response.ResponseImpl).Data.(users.Employee).Password)
+
+		ssoRepository.AssertExpectations(t)
+		userRepository.AssertExpectations(t)
+	})
+
+	t.Run("Callback Claims Pending Account With Password", func(t *testing.T) {
+		ssoRepository, userRepository, companyRepository, provider := setup()
+
+		// registered through /register by someone who chose the password
+		ssoRepository.On("FindBySubject", mock.Anything, "https://idp.test", "user-1").Return(users.Employee{}, exception.ErrNotFound)
+		userRepository.On("FindByEmail", mock.Anything, "test@test.com").Return(users.Employee{ID: 5, Password: "attacker", Status: users.StatusPending, CompanyID: 2}, nil)
+		ssoRepository.On("Claim", mock.Anything, int64(5), identityStruct, mock.AnythingOfType("time.Time")).Return(nil)
+
+		ssoUseCase := sso.NewSSOUseCase(ssoRepository, userRepository, companyRepository, provider, identities.Settings{})
+
+		resp, tokens := ssoUseCase.Callback(context.TODO(), callback, login)
+
+		assert.NoError(t, resp.Err())
+		assert.NotEmpty(t, tokens.Token)
+		assert.Equal(t, users.StatusActive, resp.(*response.ResponseImpl).Data.(users.Employee).Status)
+
+		ssoRepository.AssertExpectations(t)
+		ssoRepository.AssertNotCalled(t, "Link", mock.Anything, mock.Anything, mock.Anything)
+		userRepository.AssertNotCalled(t, "Activate", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Callback Claim Conflict", func(t *testing.T) {
+		ssoRepository, userRepository, companyRepository, provider := setup()
+
+		ssoRepository.On("FindBySubject", mock.Anything, "https://idp.test", "user-1").Return(users.Employee{}, exception.ErrNotFound)
+		userRepository.On("FindByEmail", mock.Anything, "test@test.com").Return(users.Employee{ID: 5, Password: "attacker", Status: users.StatusPending, CompanyID: 2}, nil)
+		ssoRepository.On("Claim", mock.Anything, int64(5), identityStruct, mock.AnythingOfType("time.Time")).Return(exception.ErrConflicted)
+
+		ssoUseCase := sso.NewSSOUseCase(ssoRepository, userRepository, companyRepository, provider, identities.Settings{})
+
+		resp, tokens := ssoUseCase.Callback(context.TODO(), callback, login)
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+		assert.Empty(t, tokens.Token)
+	})
+
+	t.Run("Callback Activates Pending", func(t *testing.T) {
+		ssoRepository, userRepository, companyRepository, provider := setup()
+
+		ssoRepository.On("FindBySubject", mock.Anything, "https://idp.test", "user-1").Return(users.Employee{ID: 5, Status: users.StatusPending, CompanyID: 2}, nil)
+		userRepository.On("Activate", mock.Anything, int64(5), mock.AnythingOfType("time.Time")).Return(nil)
+
+		ssoUseCase := sso.NewSSOUseCase(ssoRepository, userRepository, companyRepository, provider, identities.Settings{})
+
+		resp, tokens := ssoUseCase.Callback(context.TODO(), callback, login)
+
+		assert.NoError(t, resp.Err())
+		assert.NotEmpty(t, tokens.Token)
+
+		userRepository.AssertExpectations(t)
+	})
+
+	t.Run("Callback Auto Provision", func(t *testing.T) {
+		ssoRepository, userRepository, companyRepository, provider := setup()
+
+		ssoRepository.On("FindBySubject", mock.Anything, "https://idp.test", "user-1").Return(users.Employee{}, exception.ErrNotFound)
+		userRepository.On("FindByEmail", mock.Anything, "test@test.com").Return(users.Employee{}, exception.ErrNotFound)
+		companyRepository.On("FindByCode", mock.Anything, "acme").Return(companies.Company{ID: 2, Code: "acme"}, nil)
+		ssoRepository.On("Provision", mock.Anything, mock.MatchedBy(func(e users.Employee) bool {
+			return e.Name == "Test" && e.Email == "test@test.com" && e.Role == users.RoleEmployee && e.Status == users.StatusActive && e.CompanyID == 2
+		}), identityStruct).Return(int64(9), nil)
+
+		ssoUseCase := sso.NewSSOUseCase(ssoRepository, userRepository, companyRepository, provider, identities.Settings{AutoProvision: true, Company: "acme"})
+
+		resp, tokens := ssoUseCase.Callback(context.TODO(), callback, login)
+
+		assert.NoError(t, resp.Err())
+		assert.NotEmpty(t, tokens.Token)
+		assert.Equal(t, int64(9), resp.(*response.ResponseImpl).Data.(users.Employee).ID)
+
+		ssoRepository.AssertExpectations(t)
+		companyRepository.AssertExpectations(t)
+	})
+
+	t.Run("Callback Error Unknown Without Provisioning", func(t *testing.T) {
+		ssoRepository, userRepository, companyRepository, provider := setup()
+
+		ssoRepository.On("FindBySubject", mock.Anything, "https://idp.test", "user-1").Return(users.Employee{}, exception.ErrNotFound)
+		userRepository.On("FindByEmail", mock.Anything, "test@test.com").Return(users.Employee{}, exception.ErrNotFound)
+
+		ssoUseCase := sso.NewSSOUseCase(ssoRepository, userRepository, companyRepository, provider, identities.Settings{})
+
+		resp, tokens := ssoUseCase.Callback(context.TODO(), callback, login)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		assert.Empty(t, tokens.Token)
+
+		ssoRepository.AssertNotCalled(t, "Provision", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Callback Error Unverified Email", func(t *testing.T) {
+		ssoRepository, userRepository, companyRepository, _ := setup()
+
+		unverified := identityStruct
+		unverified.EmailVerified = false
+
+		provider := new(mocks.Provider)
+		provider.On("Exchange", mock.Anything, "code", "nonce").Return(unverified, nil)
+		ssoRepository.On("FindBySubject", mock.Anything, "https://idp.test", "user-1").Return(users.Employee{}, exception.ErrNotFound)
+
+		ssoUseCase := sso.NewSSOUseCase(ssoRepository, userRepository, companyRepository, provider, identities.Settings{AutoProvision: true})
+
+		resp, _ := ssoUseCase.Callback(context.TODO(), callback, login)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+
+		userRepository.AssertNotCalled(t, "FindByEmail", mock.Anything, mock.Anything)
+		ssoRepository.AssertNotCalled(t, "Link", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Callback Error Suspended", func(t *testing.T) {
+		ssoRepository, userRepository, companyRepository, provider := setup()
+
+		ssoRepository.On("FindBySubject", mock.Anything, "https://idp.test", "user-1").Return(users.Employee{ID: 5, Status: users.StatusSuspended, CompanyID: 2}, nil)
+
+		ssoUseCase := sso.NewSSOUseCase(ssoRepository, userRepository, companyRepository, provider, identities.Settings{})
+
+		resp, tokens := ssoUseCase.Callback(context.TODO(), callback, login)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		assert.Empty(t, tokens.Token)
+	})
+
+	t.Run("Callback Error State Mismatch", func(t *testing.T) {
+		provider := new(mocks.Provider)
+
+		ssoUseCase := sso.NewSSOUseCase(new(mocks.SSORepository), new(usermocks.UserRepository), new(companymocks.CompanyRepository), provider, identities.Settings{})
+
+		resp, _ := ssoUseCase.Callback(context.TODO(), identities.CallbackReq{Code: "code", State: "forged"}, login)
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+
+		resp, _ = ssoUseCase.Callback(context.TODO(), identities.CallbackReq{Code: "code", State: ""}, identities.Login{})
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+
+		provider.AssertNotCalled(t, "Exchange", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Callback Error Exchange", func(t *testing.T) {
+		provider := new(mocks.Provider)
+		provider.On("Exchange", mock.Anything, "code", "nonce").Return(identities.Identity{}, errors.New("id_token expired"))
+
+		ssoUseCase := sso.NewSSOUseCase(new(mocks.SSORepository), new(usermocks.UserRepository), new(companymocks.CompanyRepository), provider, identities.Settings{})
+
+		resp, _ := ssoUseCase.Callback(context.TODO(), callback, login)
+
+		assert.Equal(t, exception.ErrUnauthorized, resp.Err())
+	})
+}
@@ -0,0 +1,198 @@
+package template_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	newJWT "github.com/dgrijalva/jwt-go"
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/template"
+	"github.com/Risuii/models/templates"
+	"github.com/Risuii/tests/template/mocks"
+)
+
+func newToken(t *testing.T) string {
+	mockToken := &jwt.JWTclaim{
+		ID:        1,
+		CheckinID: 7,
+		Email:     "test@test.com",
+		StandardClaims: newJWT.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+		},
+	}
+
+	token, err := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken).SignedString(jwt.JWT_KEY)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestHandler_Create(t *testing.T) {
+	t.Run("Create Success", func(t *testing.T) {
+		newReq, _ := json.Marshal(templateReq)
+
+		templateUseCase := new(mocks.TemplateUseCase)
+		templateUseCase.On("Create", mock.Anything, int64(1), templateReq).Return(response.Success(response.StatusCreated, templates.Template{ID: 1}))
+
+		templateHandler := template.TemplateHandler{
+			Validate: validator.New(),
+			UseCase:  templateUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t)})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(templateHandler.Create)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+		templateUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Create Weekly Without Days", func(t *testing.T) {
+		newReq, _ := json.Marshal(templates.TemplateReq{
+			Name:        "Planning",
+			Description: "sprint planning",
+			Recurrence:  templates.RecurWeekly,
+		})
+
+		templateHandler := template.TemplateHandler{
+			Validate: validator.New(),
+			UseCase:  new(mocks.TemplateUseCase),
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t)})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(templateHandler.Create)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("Create Unknown Recurrence", func(t *testing.T) {
+		newReq, _ := json.Marshal(templates.TemplateReq{
+			Name:        "Planning",
+			Description: "sprint planning",
+			Recurrence:  "monthly",
+		})
+
+		templateHandler := template.TemplateHandler{
+			Validate: validator.New(),
+			UseCase:  new(mocks.TemplateUseCase),
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t)})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(templateHandler.Create)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestHandler_Update(t *testing.T) {
+	t.Run("Update Success", func(t *testing.T) {
+		newReq, _ := json.Marshal(templateReq)
+
+		templateUseCase := new(mocks.TemplateUseCase)
+		templateUseCase.On("Update", mock.Anything, int64(3), int64(1), templateReq).Return(response.Success(response.StatusOK, templates.Template{ID: 3}))
+
+		templateHandler := template.TemplateHandler{
+			Validate: validator.New(),
+			UseCase:  templateUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPatch, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t)})
+		r = mux.SetURLVars(r, map[string]string{"id": "3"})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(templateHandler.Update)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		templateUseCase.AssertExpectations(t)
+	})
+}
+
+func TestHandler_Apply(t *testing.T) {
+	t.Run("Apply Success", func(t *testing.T) {
+		newReq, _ := json.Marshal(templates.ApplyReq{IDs: []int64{1, 2}})
+
+		templateUseCase := new(mocks.TemplateUseCase)
+		templateUseCase.On("Apply", mock.Anything, int64(1), int64(7), templates.ApplyReq{IDs: []int64{1, 2}}).Return(response.Success(response.StatusOK, nil))
+
+		templateHandler := template.TemplateHandler{
+			Validate: validator.New(),
+			UseCase:  templateUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{Name: "checkin-token", Value: newToken(t)})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(templateHandler.Apply)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		templateUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Apply Without Checkin", func(t *testing.T) {
+		newReq, _ := json.Marshal(templates.ApplyReq{IDs: []int64{1}})
+
+		templateHandler := template.TemplateHandler{
+			Validate: validator.New(),
+			UseCase:  new(mocks.TemplateUseCase),
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/just/for/testing", bytes.NewReader(newReq))
+		r.AddCookie(&http.Cookie{Name: "token", Value: newToken(t)})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(templateHandler.Apply)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+}
+
+func TestHandler_Suggest(t *testing.T) {
+	t.Run("Suggest Success", func(t *testing.T) {
+		templateUseCase := new(mocks.TemplateUseCase)
+		templateUseCase.On("Suggest", mock.Anything, int64(1), int64(7)).Return(response.Success(response.StatusOK, []templates.Template{}))
+
+		templateHandler := template.TemplateHandler{
+			Validate: validator.New(),
+			UseCase:  templateUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", nil)
+		r.AddCookie(&http.Cookie{Name: "checkin-token", Value: newToken(t)})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(templateHandler.Suggest)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		templateUseCase.AssertExpectations(t)
+	})
+}
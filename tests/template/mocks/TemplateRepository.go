@@ -0,0 +1,124 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	templates "github.com/Risuii/models/templates"
+)
+
+// TemplateRepository is an autogenerated mock type for the TemplateRepository type
+type TemplateRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, params
+func (_m *TemplateRepository) Create(ctx context.Context, params templates.Template) (int64, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, templates.Template) int64); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, templates.Template) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *TemplateRepository) Delete(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *TemplateRepository) FindByID(ctx context.Context, id int64) (templates.Template, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 templates.Template
+	if rf, ok := ret.Get(0).(func(context.Context, int64) templates.Template); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(templates.Template)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByUser provides a mock function with given fields: ctx, userID
+func (_m *TemplateRepository) FindByUser(ctx context.Context, userID int64) ([]templates.Template, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []templates.Template
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []templates.Template); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]templates.Template)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, params
+func (_m *TemplateRepository) Update(ctx context.Context, id int64, params templates.Template) error {
+	ret := _m.Called(ctx, id, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, templates.Template) error); ok {
+		r0 = rf(ctx, id, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewTemplateRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewTemplateRepository creates a new instance of TemplateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewTemplateRepository(t mockConstructorTestingTNewTemplateRepository) *TemplateRepository {
+	mock := &TemplateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
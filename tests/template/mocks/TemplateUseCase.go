@@ -0,0 +1,128 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	response "github.com/Risuii/helpers/response"
+	mock "github.com/stretchr/testify/mock"
+
+	templates "github.com/Risuii/models/templates"
+)
+
+// TemplateUseCase is an autogenerated mock type for the TemplateUseCase type
+type TemplateUseCase struct {
+	mock.Mock
+}
+
+// Apply provides a mock function with given fields: ctx, userID, checkinID, params
+func (_m *TemplateUseCase) Apply(ctx context.Context, userID int64, checkinID int64, params templates.ApplyReq) response.Response {
+	ret := _m.Called(ctx, userID, checkinID, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, templates.ApplyReq) response.Response); ok {
+		r0 = rf(ctx, userID, checkinID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Create provides a mock function with given fields: ctx, userID, params
+func (_m *TemplateUseCase) Create(ctx context.Context, userID int64, params templates.TemplateReq) response.Response {
+	ret := _m.Called(ctx, userID, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, templates.TemplateReq) response.Response); ok {
+		r0 = rf(ctx, userID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, id, userID
+func (_m *TemplateUseCase) Delete(ctx context.Context, id int64, userID int64) response.Response {
+	ret := _m.Called(ctx, id, userID)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) response.Response); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: ctx, userID
+func (_m *TemplateUseCase) List(ctx context.Context, userID int64) response.Response {
+	ret := _m.Called(ctx, userID)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64) response.Response); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Suggest provides a mock function with given fields: ctx, userID, checkinID
+func (_m *TemplateUseCase) Suggest(ctx context.Context, userID int64, checkinID int64) response.Response {
+	ret := _m.Called(ctx, userID, checkinID)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) response.Response); ok {
+		r0 = rf(ctx, userID, checkinID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, id, userID, params
+func (_m *TemplateUseCase) Update(ctx context.Context, id int64, userID int64, params templates.TemplateReq) response.Response {
+	ret := _m.Called(ctx, id, userID, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, templates.TemplateReq) response.Response); ok {
+		r0 = rf(ctx, id, userID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewTemplateUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewTemplateUseCase creates a new instance of TemplateUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewTemplateUseCase(t mockConstructorTestingTNewTemplateUseCase) *TemplateUseCase {
+	mock := &TemplateUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
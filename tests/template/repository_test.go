@@ -0,0 +1,249 @@
+package template_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Risuii/helpers/constant"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/template"
+	"github.com/Risuii/models/templates"
+	"github.com/Risuii/tests/mock"
+)
+
+var currentTime = time.Date(2021, 12, 13, 0, 0, 0, 0, &time.Location{})
+var templateStruct = templates.Template{
+	ID:          1,
+	UserID:      1,
+	Name:        "Daily sync",
+	ProjectID:   2,
+	Description: "daily sync",
+	Tags:        []string{"meeting"},
+	Duration:    15,
+	Recurrence:  templates.RecurWeekly,
+	Days:        []time.Weekday{time.Monday, time.Thursday},
+	CreatedAt:   currentTime,
+	UpdateAt:    currentTime,
+}
+var templateColumns = []string{"id", "userID", "name", "projectID", "deskripsi", "tags", "duration_minutes", "recurrence", "days", "created_at", "update_at"}
+
+func TestCreateRepo(t *testing.T) {
+	t.Run("Create Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := template.NewTemplateRepositoryImpl(db, constant.TableTemplate)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableTemplate)
+
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(
+			templateStruct.UserID,
+			templateStruct.Name,
+			templateStruct.ProjectID,
+			templateStruct.Description,
+			`["meeting"]`,
+			templateStruct.Duration,
+			templateStruct.Recurrence,
+			uint8(1<<time.Monday|1<<time.Thursday),
+			templateStruct.CreatedAt,
+			templateStruct.UpdateAt,
+			int64(2),
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		ID, err := repo.Create(tenant.WithID(context.TODO(), 2), templateStruct)
+
+		assert.Equal(t, int64(1), ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create Without Project", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := template.NewTemplateRepositoryImpl(db, constant.TableTemplate)
+
+		defer db.Close()
+
+		params := templateStruct
+		params.ProjectID = 0
+
+		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableTemplate)
+
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			nil,
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+		).WillReturnResult(sqlmock.NewResult(2, 1))
+
+		ID, err := repo.Create(tenant.WithID(context.TODO(), 2), params)
+
+		assert.Equal(t, int64(2), ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := template.NewTemplateRepositoryImpl(db, constant.TableTemplate)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableTemplate)
+
+		mock.ExpectPrepare(query).ExpectExec().WillReturnError(fmt.Errorf("error"))
+
+		ID, err := repo.Create(tenant.WithID(context.TODO(), 2), templateStruct)
+
+		assert.Equal(t, int64(0), ID)
+		assert.Equal(t, exception.ErrInternalServer, err)
+	})
+}
+
+func TestUpdateRepo(t *testing.T) {
+	t.Run("Update Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := template.NewTemplateRepositoryImpl(db, constant.TableTemplate)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`UPDATE %s SET name = ?, projectID = ?, deskripsi = ?, tags = ?, duration_minutes = ?, recurrence = ?, days = ?, update_at = ? WHERE id = ? AND company_id = ?`, constant.TableTemplate)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectExec().WithArgs(
+			templateStruct.Name,
+			templateStruct.ProjectID,
+			templateStruct.Description,
+			`["meeting"]`,
+			templateStruct.Duration,
+			templateStruct.Recurrence,
+			uint8(1<<time.Monday|1<<time.Thursday),
+			templateStruct.UpdateAt,
+			templateStruct.ID,
+			int64(2),
+		).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Update(tenant.WithID(context.TODO(), 2), templateStruct.ID, templateStruct)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestDeleteRepo(t *testing.T) {
+	t.Run("Delete Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := template.NewTemplateRepositoryImpl(db, constant.TableTemplate)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`DELETE FROM %s WHERE id = ? AND company_id = ?`, constant.TableTemplate)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectExec().WithArgs(int64(1), int64(2)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Delete(tenant.WithID(context.TODO(), 2), 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Delete Not Found", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := template.NewTemplateRepositoryImpl(db, constant.TableTemplate)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`DELETE FROM %s WHERE id = ? AND company_id = ?`, constant.TableTemplate)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectExec().WithArgs(int64(1), int64(2)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Delete(tenant.WithID(context.TODO(), 2), 1)
+
+		assert.Equal(t, exception.ErrNotFound, err)
+	})
+}
+
+func TestFindByIDRepo(t *testing.T) {
+	t.Run("Find Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := template.NewTemplateRepositoryImpl(db, constant.TableTemplate)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows(templateColumns).
+			AddRow(1, 1, "Daily sync", 2, "daily sync", `["meeting"]`, 15, templates.RecurWeekly, 1<<time.Monday|1<<time.Thursday, currentTime, currentTime)
+
+		query := fmt.Sprintf(`SELECT id, userID, name, projectID, deskripsi, tags, duration_minutes, recurrence, days, created_at, update_at FROM %s WHERE id = ? AND company_id = ?`, constant.TableTemplate)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(int64(1), int64(2)).WillReturnRows(rows)
+
+		res, err := repo.FindByID(tenant.WithID(context.TODO(), 2), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, templateStruct, res)
+	})
+
+	t.Run("Find Not Found", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := template.NewTemplateRepositoryImpl(db, constant.TableTemplate)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, userID, name, projectID, deskripsi, tags, duration_minutes, recurrence, days, created_at, update_at FROM %s WHERE id = ? AND company_id = ?`, constant.TableTemplate)
+
+		mock.ExpectPrepare(regexp.QuoteMeta(query)).ExpectQuery().WithArgs(int64(1), int64(2)).WillReturnRows(sqlmock.NewRows(templateColumns))
+
+		_, err := repo.FindByID(tenant.WithID(context.TODO(), 2), 1)
+
+		assert.Equal(t, exception.ErrNotFound, err)
+	})
+}
+
+func TestFindByUserRepo(t *testing.T) {
+	t.Run("Find Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := template.NewTemplateRepositoryImpl(db, constant.TableTemplate)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows(templateColumns).
+			AddRow(1, 1, "Daily sync", 2, "daily sync", `["meeting"]`, 15, templates.RecurWeekly, 1<<time.Monday|1<<time.Thursday, currentTime, currentTime).
+			AddRow(2, 1, "Inbox", nil, "email", "", 0, "", 0, currentTime, currentTime)
+
+		query := fmt.Sprintf(`SELECT id, userID, name, projectID, deskripsi, tags, duration_minutes, recurrence, days, created_at, update_at FROM %s WHERE userID = ? AND company_id = ? ORDER BY name, id`, constant.TableTemplate)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(int64(1), int64(2)).WillReturnRows(rows)
+
+		res, err := repo.FindByUser(tenant.WithID(context.TODO(), 2), 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.Equal(t, templateStruct, res[0])
+		assert.Equal(t, int64(0), res[1].ProjectID)
+		assert.Equal(t, []string{}, res[1].Tags)
+		assert.Equal(t, []time.Weekday{}, res[1].Days)
+	})
+
+	t.Run("Find Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := template.NewTemplateRepositoryImpl(db, constant.TableTemplate)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`SELECT id, userID, name, projectID, deskripsi, tags, duration_minutes, recurrence, days, created_at, update_at FROM %s WHERE userID = ? AND company_id = ?`, constant.TableTemplate)
+
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(fmt.Errorf("error"))
+
+		_, err := repo.FindByUser(tenant.WithID(context.TODO(), 2), 1)
+
+		assert.Equal(t, exception.ErrInternalServer, err)
+	})
+}
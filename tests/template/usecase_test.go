@@ -0,0 +1,270 @@
+package template_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/template"
+	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/templates"
+	absensiMocks "github.com/Risuii/tests/absensi/mocks"
+	activityMocks "github.com/Risuii/tests/activity/mocks"
+	"github.com/Risuii/tests/template/mocks"
+)
+
+type deps struct {
+	templateRepository *mocks.TemplateRepository
+	activityUseCase    *activityMocks.ActivityUseCase
+	activityRepository *activityMocks.ActivityRepository
+	absensiRepository  *absensiMocks.AbsensiRepository
+}
+
+func newDeps() (deps, template.TemplateUseCase) {
+	d := deps{
+		templateRepository: new(mocks.TemplateRepository),
+		activityUseCase:    new(activityMocks.ActivityUseCase),
+		activityRepository: new(activityMocks.ActivityRepository),
+		absensiRepository:  new(absensiMocks.AbsensiRepository),
+	}
+
+	return d, template.NewTemplateUseCase(d.templateRepository, d.activityUseCase, d.activityRepository, d.absensiRepository)
+}
+
+func (d deps) assertExpectations(t *testing.T) {
+	d.templateRepository.AssertExpectations(t)
+	d.activityUseCase.AssertExpectations(t)
+	d.activityRepository.AssertExpectations(t)
+	d.absensiRepository.AssertExpectations(t)
+}
+
+var templateReq = templates.TemplateReq{
+	Name:        "Daily sync",
+	Description: "daily sync",
+	Tags:        []string{"meeting"},
+	Duration:    15,
+	Recurrence:  templates.RecurWeekly,
+	Days:        []time.Weekday{time.Monday, time.Monday, time.Thursday},
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Create Success", func(t *testing.T) {
+		d, templateUseCase := newDeps()
+
+		d.templateRepository.On("Create", mock.Anything, mock.MatchedBy(func(tp templates.Template) bool {
+			return tp.UserID == 1 && tp.Name == "Daily sync" && len(tp.Days) == 2
+		})).Return(int64(1), nil)
+
+		resp := templateUseCase.Create(context.TODO(), 1, templateReq)
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, int64(1), resp.(*response.ResponseImpl).Data.(templates.Template).ID)
+		d.assertExpectations(t)
+	})
+
+	t.Run("Create Drops Days Of Other Recurrence", func(t *testing.T) {
+		d, templateUseCase := newDeps()
+
+		params := templateReq
+		params.Recurrence = templates.RecurWeekdays
+
+		d.templateRepository.On("Create", mock.Anything, mock.MatchedBy(func(tp templates.Template) bool {
+			return len(tp.Days) == 0
+		})).Return(int64(1), nil)
+
+		resp := templateUseCase.Create(context.TODO(), 1, params)
+
+		assert.NoError(t, resp.Err())
+		d.assertExpectations(t)
+	})
+
+	t.Run("Create Weekly Without Days", func(t *testing.T) {
+		d, templateUseCase := newDeps()
+
+		params := templateReq
+		params.Days = []time.Weekday{}
+
+		resp := templateUseCase.Create(context.TODO(), 1, params)
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+		d.assertExpectations(t)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("Update Success", func(t *testing.T) {
+		d, templateUseCase := newDeps()
+
+		d.templateRepository.On("FindByID", mock.Anything, int64(1)).Return(templates.Template{ID: 1, UserID: 1, CreatedAt: currentTime}, nil)
+		d.templateRepository.On("Update", mock.Anything, int64(1), mock.MatchedBy(func(tp templates.Template) bool {
+			return tp.UserID == 1 && tp.CreatedAt.Equal(currentTime) && tp.Description == "daily sync"
+		})).Return(nil)
+
+		resp := templateUseCase.Update(context.TODO(), 1, 1, templateReq)
+
+		assert.NoError(t, resp.Err())
+		d.assertExpectations(t)
+	})
+
+	t.Run("Update Template Of Other User", func(t *testing.T) {
+		d, templateUseCase := newDeps()
+
+		d.templateRepository.On("FindByID", mock.Anything, int64(1)).Return(templates.Template{ID: 1, UserID: 2}, nil)
+
+		resp := templateUseCase.Update(context.TODO(), 1, 1, templateReq)
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+		d.assertExpectations(t)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("Delete Success", func(t *testing.T) {
+		d, templateUseCase := newDeps()
+
+		d.templateRepository.On("FindByID", mock.Anything, int64(1)).Return(templates.Template{ID: 1, UserID: 1}, nil)
+		d.templateRepository.On("Delete", mock.Anything, int64(1)).Return(nil)
+
+		resp := templateUseCase.Delete(context.TODO(), 1, 1)
+
+		assert.NoError(t, resp.Err())
+		d.assertExpectations(t)
+	})
+
+	t.Run("Delete Not Found", func(t *testing.T) {
+		d, templateUseCase := newDeps()
+
+		d.templateRepository.On("FindByID", mock.Anything, int64(1)).Return(templates.Template{}, exception.ErrNotFound)
+
+		resp := templateUseCase.Delete(context.TODO(), 1, 1)
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+		d.assertExpectations(t)
+	})
+}
+
+func TestApply(t *testing.T) {
+	owned := []templates.Template{
+		{ID: 1, UserID: 1, Description: "daily sync", Tags: []string{"meeting"}, Duration: 15},
+		{ID: 2, UserID: 1, ProjectID: 3, Description: "code review", Tags: []string{}},
+	}
+
+	t.Run("Apply Success", func(t *testing.T) {
+		d, templateUseCase := newDeps()
+
+		d.templateRepository.On("FindByUser", mock.Anything, int64(1)).Return(owned, nil)
+		d.activityUseCase.On("AddActivities", mock.Anything, int64(1), int64(7), []activitys.Activity{
+			{ProjectID: 3, Description: "code review", Tags: []string{}},
+			{Description: "daily sync", Tags: []string{"meeting"}, Duration: 15},
+		}).Return(response.Success(response.StatusOK, activitys.BulkResult{}))
+
+		resp := templateUseCase.Apply(context.TODO(), 1, 7, templates.ApplyReq{IDs: []int64{2, 1}})
+
+		assert.NoError(t, resp.Err())
+		d.assertExpectations(t)
+	})
+
+	t.Run("Apply Unknown Template", func(t *testing.T) {
+		d, templateUseCase := newDeps()
+
+		d.templateRepository.On("FindByUser", mock.Anything, int64(1)).Return(owned, nil)
+
+		resp := templateUseCase.Apply(context.TODO(), 1, 7, templates.ApplyReq{IDs: []int64{1, 9}})
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+		d.assertExpectations(t)
+	})
+
+	t.Run("Apply Rejected Activity", func(t *testing.T) {
+		d, templateUseCase := newDeps()
+
+		rejected := response.ErrorWithData(response.StatusBadRequest, exception.ErrBadRequest, activitys.BulkResult{})
+
+		d.templateRepository.On("FindByUser", mock.Anything, int64(1)).Return(owned, nil)
+		d.activityUseCase.On("AddActivities", mock.Anything, int64(1), int64(7), mock.Anything).Return(rejected)
+
+		resp := templateUseCase.Apply(context.TODO(), 1, 7, templates.ApplyReq{IDs: []int64{1}})
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+		d.assertExpectations(t)
+	})
+}
+
+func TestSuggest(t *testing.T) {
+	monday := time.Date(2023, 1, 2, 8, 0, 0, 0, time.UTC)
+	session := absensis.Absensi{ID: 7, UserID: 1, Checkin: monday}
+	owned := []templates.Template{
+		{ID: 1, Description: "Daily sync", Recurrence: templates.RecurDaily},
+		{ID: 2, Description: "code review", Recurrence: templates.RecurWeekdays},
+		{ID: 3, Description: "planning", Recurrence: templates.RecurWeekly, Days: []time.Weekday{time.Monday}},
+		{ID: 4, Description: "retro", Recurrence: templates.RecurWeekly, Days: []time.Weekday{time.Friday}},
+		{ID: 5, Description: "inbox"},
+	}
+
+	t.Run("Suggest Success", func(t *testing.T) {
+		d, templateUseCase := newDeps()
+
+		d.absensiRepository.On("FindByID", mock.Anything, int64(7)).Return(session, nil)
+		d.templateRepository.On("FindByUser", mock.Anything, int64(1)).Return(owned, nil)
+		d.activityRepository.On("FindBySession", mock.Anything, int64(7)).Return([]activitys.Activity{{Description: " daily SYNC"}}, nil)
+
+		resp := templateUseCase.Suggest(context.TODO(), 1, 7)
+
+		assert.NoError(t, resp.Err())
+
+		suggested := resp.(*response.ResponseImpl).Data.([]templates.Template)
+		assert.Len(t, suggested, 2)
+		assert.Equal(t, int64(2), suggested[0].ID)
+		assert.Equal(t, int64(3), suggested[1].ID)
+		d.assertExpectations(t)
+	})
+
+	t.Run("Suggest On Weekend", func(t *testing.T) {
+		d, templateUseCase := newDeps()
+
+		saturday := session
+		saturday.Checkin = monday.AddDate(0, 0, 5)
+
+		d.absensiRepository.On("FindByID", mock.Anything, int64(7)).Return(saturday, nil)
+		d.templateRepository.On("FindByUser", mock.Anything, int64(1)).Return(owned, nil)
+		d.activityRepository.On("FindBySession", mock.Anything, int64(7)).Return([]activitys.Activity{}, nil)
+
+		resp := templateUseCase.Suggest(context.TODO(), 1, 7)
+
+		suggested := resp.(*response.ResponseImpl).Data.([]templates.Template)
+		assert.Len(t, suggested, 1)
+		assert.Equal(t, int64(1), suggested[0].ID)
+		d.assertExpectations(t)
+	})
+
+	t.Run("Suggest Session Of Other User", func(t *testing.T) {
+		d, templateUseCase := newDeps()
+
+		d.absensiRepository.On("FindByID", mock.Anything, int64(7)).Return(absensis.Absensi{ID: 7, UserID: 2}, nil)
+
+		resp := templateUseCase.Suggest(context.TODO(), 1, 7)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		d.assertExpectations(t)
+	})
+
+	t.Run("Suggest Checked Out Session", func(t *testing.T) {
+		d, templateUseCase := newDeps()
+
+		closed := session
+		closed.Checkout = monday.Add(8 * time.Hour)
+
+		d.absensiRepository.On("FindByID", mock.Anything, int64(7)).Return(closed, nil)
+
+		resp := templateUseCase.Suggest(context.TODO(), 1, 7)
+
+		assert.Equal(t, exception.ErrConflicted, resp.Err())
+		d.assertExpectations(t)
+	})
+}
@@ -0,0 +1,134 @@
+package timesheet_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	newJWT "github.com/dgrijalva/jwt-go"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/config/jwt"
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
+	"github.com/Risuii/internal/timesheet"
+	"github.com/Risuii/models/timesheets"
+	"github.com/Risuii/models/users"
+	"github.com/Risuii/tests/timesheet/mocks"
+)
+
+func newToken(t *testing.T, role string) string {
+	mockToken := &jwt.JWTclaim{
+		ID:    1,
+		Email: "test@test.com",
+		Role:  role,
+		StandardClaims: newJWT.StandardClaims{
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: time.Now().Add(time.Hour * 24 * 1).Unix(),
+		},
+	}
+
+	tokenAlgo := newJWT.NewWithClaims(newJWT.SigningMethodHS256, mockToken)
+
+	token, err := tokenAlgo.SignedString(jwt.JWT_KEY)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestHandler_Generate(t *testing.T) {
+	t.Run("Generate Success", func(t *testing.T) {
+		params := timesheets.TimesheetReq{From: "2023-01-01", To: "2023-01-31"}
+
+		timesheetUseCase := new(mocks.TimesheetUseCase)
+		timesheetUseCase.On("Generate", mock.Anything, int64(1), users.RoleEmployee, params, mock.Anything).Run(func(args mock.Arguments) {
+			w := args.Get(4).(io.Writer)
+			w.Write([]byte("%PDF-1.3"))
+		}).Return(response.Success(response.StatusOK, nil))
+
+		timesheetHandler := timesheet.TimesheetHandler{
+			Validate: validator.New(),
+			UseCase:  timesheetUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing?from=2023-01-01&to=2023-01-31", nil)
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleEmployee),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(timesheetHandler.Generate)
+		handler.ServeHTTP(recorder, r)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "application/pdf", recorder.Header().Get("Content-Type"))
+		assert.Contains(t, recorder.Header().Get("Content-Disposition"), "timesheet_1_2023-01-01_2023-01-31.pdf")
+
+		timesheetUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Generate Error Forbidden", func(t *testing.T) {
+		params := timesheets.TimesheetReq{From: "2023-01-01", To: "2023-01-31", UserID: 2}
+
+		timesheetUseCase := new(mocks.TimesheetUseCase)
+		timesheetUseCase.On("Generate", mock.Anything, int64(1), users.RoleEmployee, params, mock.Anything).Return(response.Error(response.StatusForbiddend, exception.ErrForbidden))
+
+		timesheetHandler := timesheet.TimesheetHandler{
+			Validate: validator.New(),
+			UseCase:  timesheetUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing?from=2023-01-01&to=2023-01-31&userID=2", nil)
+		r.AddCookie(&http.Cookie{
+			Name:  "token",
+			Value: newToken(t, users.RoleEmployee),
+		})
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(timesheetHandler.Generate)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusForbiddend, rb.Status)
+
+		timesheetUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Generate Error Unauthorized", func(t *testing.T) {
+		timesheetUseCase := new(mocks.TimesheetUseCase)
+
+		timesheetHandler := timesheet.TimesheetHandler{
+			Validate: validator.New(),
+			UseCase:  timesheetUseCase,
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/just/for/testing", nil)
+		recorder := httptest.NewRecorder()
+
+		handler := http.HandlerFunc(timesheetHandler.Generate)
+		handler.ServeHTTP(recorder, r)
+
+		rb := response.ResponseImpl{}
+		if err := json.NewDecoder(recorder.Body).Decode(&rb); err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, response.StatusUnauthorized, rb.Status)
+
+		timesheetUseCase.AssertExpectations(t)
+	})
+}
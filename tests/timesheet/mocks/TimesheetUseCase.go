@@ -0,0 +1,71 @@
+// Code generated by mockery v2.16.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+	time "time"
+
+	response "github.com/Risuii/helpers/response"
+	mock "github.com/stretchr/testify/mock"
+
+	timesheets "github.com/Risuii/models/timesheets"
+)
+
+// TimesheetUseCase is an autogenerated mock type for the TimesheetUseCase type
+type TimesheetUseCase struct {
+	mock.Mock
+}
+
+// Build provides a mock function with given fields: ctx, userID, from, to
+func (_m *TimesheetUseCase) Build(ctx context.Context, userID int64, from time.Time, to time.Time) (timesheets.Timesheet, error) {
+	ret := _m.Called(ctx, userID, from, to)
+
+	var r0 timesheets.Timesheet
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) timesheets.Timesheet); ok {
+		r0 = rf(ctx, userID, from, to)
+	} else {
+		r0 = ret.Get(0).(timesheets.Timesheet)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, userID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Generate provides a mock function with given fields: ctx, userID, role, params, w
+func (_m *TimesheetUseCase) Generate(ctx context.Context, userID int64, role string, params timesheets.TimesheetReq, w io.Writer) response.Response {
+	ret := _m.Called(ctx, userID, role, params, w)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, timesheets.TimesheetReq, io.Writer) response.Response); ok {
+		r0 = rf(ctx, userID, role, params, w)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewTimesheetUseCase interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewTimesheetUseCase creates a new instance of TimesheetUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewTimesheetUseCase(t mockConstructorTestingTNewTimesheetUseCase) *TimesheetUseCase {
+	mock := &TimesheetUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,220 @@
+package timesheet_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/tenant"
+	"github.com/Risuii/internal/timesheet"
+	"github.com/Risuii/models/absensis"
+	"github.com/Risuii/models/activitys"
+	"github.com/Risuii/models/paginations"
+	"github.com/Risuii/models/timesheets"
+	"github.com/Risuii/models/users"
+	absensiMocks "github.com/Risuii/tests/absensi/mocks"
+	activityMocks "github.com/Risuii/tests/activity/mocks"
+	orgMocks "github.com/Risuii/tests/org/mocks"
+)
+
+func at(day int, hour int, minute int) time.Time {
+	return time.Date(2023, 1, day, hour, minute, 0, 0, time.UTC)
+}
+
+var riwayatParams = absensis.Riwayat{
+	UserID:     1,
+	From:       "2023-01-01",
+	To:         "2023-01-31",
+	Pagination: paginations.Pagination{Limit: 100, Sort: paginations.SortAsc},
+}
+
+var activityParams = activitys.DateReq{
+	From:       "2023-01-01",
+	To:         "2023-01-31",
+	Pagination: paginations.Pagination{Limit: 100, Sort: paginations.SortAsc},
+}
+
+func TestBuild(t *testing.T) {
+	t.Run("Build Success", func(t *testing.T) {
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		sessions := []absensis.Absensi{
+			{ID: 1, UserID: 1, Name: "test", Checkin: at(2, 8, 0), Checkout: at(2, 12, 0), Date: at(2, 0, 0), Status: absensis.StatusPresent},
+			{ID: 2, UserID: 1, Name: "test", Checkin: at(2, 13, 0), Checkout: at(2, 17, 30), Date: at(2, 0, 0), Status: absensis.StatusPresent},
+			{ID: 3, UserID: 1, Name: "test", Date: at(3, 0, 0), Status: absensis.StatusAbsent},
+		}
+		activities := []activitys.Activity{
+			{ID: 1, UserID: 1, AbsenID: 2, Description: "rapat", CreatedAt: at(2, 0, 0)},
+			{ID: 2, UserID: 1, Description: "review", CreatedAt: at(4, 0, 0)},
+		}
+
+		absensiRepository.On("Riwayat", mock.Anything, riwayatParams).Return(sessions, nil)
+		activityRepository.On("Riwayat", mock.Anything, int64(1), activityParams).Return(activities, nil)
+
+		timesheetUseCase := timesheet.NewTimesheetUseCase(absensiRepository, activityRepository, new(orgMocks.Chain))
+
+		sheet, err := timesheetUseCase.Build(context.TODO(), 1, at(1, 0, 0), at(31, 0, 0))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test", sheet.Name)
+		assert.Len(t, sheet.Days, 3)
+		assert.Equal(t, 60, sheet.Days[0].BreakMinutes)
+		assert.Equal(t, 8.5, sheet.Days[0].WorkedHours)
+		assert.Equal(t, []string{"rapat"}, sheet.Days[0].Activities)
+		assert.Empty(t, sheet.Days[0].Sessions[0].Activities)
+		assert.Equal(t, []string{"rapat"}, sheet.Days[0].Sessions[1].Activities)
+		assert.Equal(t, 8.5, sheet.WorkedHours)
+		assert.Equal(t, 60, sheet.BreakMinutes)
+		assert.Equal(t, 2, sheet.Activities)
+		absensiRepository.AssertExpectations(t)
+		activityRepository.AssertExpectations(t)
+	})
+
+	t.Run("Build Success Company Timezone", func(t *testing.T) {
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		jakarta, err := time.LoadLocation("Asia/Jakarta")
+		assert.NoError(t, err)
+
+		// 08:00 to 17:00 WIB as read back from the database
+		sessions := []absensis.Absensi{
+			{ID: 1, UserID: 1, Name: "test", Checkin: at(2, 1, 0), Checkout: at(2, 10, 0), Date: at(2, 0, 0), Status: absensis.StatusPresent},
+		}
+		// 06:30 WIB on the 2nd is still the 1st in UTC
+		activities := []activitys.Activity{
+			{ID: 1, UserID: 1, Description: "persiapan", CreatedAt: at(1, 23, 30)},
+		}
+
+		absensiRepository.On("Riwayat", mock.Anything, riwayatParams).Return(sessions, nil)
+		activityRepository.On("Riwayat", mock.Anything, int64(1), activityParams).Return(activities, nil)
+
+		timesheetUseCase := timesheet.NewTimesheetUseCase(absensiRepository, activityRepository, new(orgMocks.Chain))
+
+		ctx := tenant.WithLocation(context.TODO(), jakarta)
+		sheet, err := timesheetUseCase.Build(ctx, 1, at(1, 0, 0), at(31, 0, 0))
+
+		assert.NoError(t, err)
+		assert.Len(t, sheet.Days, 1)
+		assert.Equal(t, at(2, 0, 0), sheet.Days[0].Date)
+		assert.Equal(t, []string{"persiapan"}, sheet.Days[0].Activities)
+		assert.Equal(t, "08:00", sheet.Days[0].Sessions[0].Checkin.Format("15:04"))
+		assert.Equal(t, "17:00", sheet.Days[0].Sessions[0].Checkout.Format("15:04"))
+		absensiRepository.AssertExpectations(t)
+		activityRepository.AssertExpectations(t)
+	})
+
+	t.Run("Build Success Paging", func(t *testing.T) {
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		page := make([]absensis.Absensi, 100)
+		for i := range page {
+			page[i] = absensis.Absensi{ID: int64(i + 1), UserID: 1, Checkin: at(2, 8, 0), Checkout: at(2, 8, 1), Date: at(2, 0, 0)}
+		}
+
+		next := riwayatParams
+		next.Cursor = 100
+
+		absensiRepository.On("Riwayat", mock.Anything, riwayatParams).Return(page, nil)
+		absensiRepository.On("Riwayat", mock.Anything, next).Return([]absensis.Absensi{}, nil)
+		activityRepository.On("Riwayat", mock.Anything, int64(1), activityParams).Return([]activitys.Activity{}, nil)
+
+		timesheetUseCase := timesheet.NewTimesheetUseCase(absensiRepository, activityRepository, new(orgMocks.Chain))
+
+		sheet, err := timesheetUseCase.Build(context.TODO(), 1, at(1, 0, 0), at(31, 0, 0))
+
+		assert.NoError(t, err)
+		assert.Len(t, sheet.Days[0].Sessions, 100)
+		absensiRepository.AssertExpectations(t)
+		activityRepository.AssertExpectations(t)
+	})
+
+	t.Run("Build Error Internal Server", func(t *testing.T) {
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		absensiRepository.On("Riwayat", mock.Anything, riwayatParams).Return([]absensis.Absensi{}, exception.ErrInternalServer)
+
+		timesheetUseCase := timesheet.NewTimesheetUseCase(absensiRepository, activityRepository, new(orgMocks.Chain))
+
+		_, err := timesheetUseCase.Build(context.TODO(), 1, at(1, 0, 0), at(31, 0, 0))
+
+		assert.Equal(t, exception.ErrInternalServer, err)
+		absensiRepository.AssertExpectations(t)
+		activityRepository.AssertExpectations(t)
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	t.Run("Generate Success", func(t *testing.T) {
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		sessions := []absensis.Absensi{
+			{ID: 1, UserID: 1, Name: "test", Checkin: at(2, 8, 0), Checkout: at(2, 17, 0), Date: at(2, 0, 0), Status: absensis.StatusWFH},
+		}
+
+		absensiRepository.On("Riwayat", mock.Anything, riwayatParams).Return(sessions, nil)
+		activityRepository.On("Riwayat", mock.Anything, int64(1), activityParams).Return([]activitys.Activity{{ID: 1, UserID: 1, Description: "rapat klien", CreatedAt: at(2, 0, 0)}}, nil)
+
+		timesheetUseCase := timesheet.NewTimesheetUseCase(absensiRepository, activityRepository, new(orgMocks.Chain))
+
+		var buf bytes.Buffer
+		resp := timesheetUseCase.Generate(context.TODO(), 1, users.RoleEmployee, timesheets.TimesheetReq{From: "2023-01-01", To: "2023-01-31"}, &buf)
+
+		assert.NoError(t, resp.Err())
+		assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+		absensiRepository.AssertExpectations(t)
+		activityRepository.AssertExpectations(t)
+	})
+
+	t.Run("Generate Error Forbidden", func(t *testing.T) {
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		timesheetUseCase := timesheet.NewTimesheetUseCase(absensiRepository, activityRepository, new(orgMocks.Chain))
+
+		var buf bytes.Buffer
+		resp := timesheetUseCase.Generate(context.TODO(), 1, users.RoleEmployee, timesheets.TimesheetReq{From: "2023-01-01", To: "2023-01-31", UserID: 2}, &buf)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		assert.Zero(t, buf.Len())
+	})
+
+	t.Run("Generate Error Manager Outside Chain", func(t *testing.T) {
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+		chain := new(orgMocks.Chain)
+
+		chain.On("InChain", mock.Anything, int64(1), int64(2)).Return(false, nil)
+
+		timesheetUseCase := timesheet.NewTimesheetUseCase(absensiRepository, activityRepository, chain)
+
+		var buf bytes.Buffer
+		resp := timesheetUseCase.Generate(context.TODO(), 1, users.RoleManager, timesheets.TimesheetReq{From: "2023-01-01", To: "2023-01-31", UserID: 2}, &buf)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		assert.Zero(t, buf.Len())
+		absensiRepository.AssertNotCalled(t, "Riwayat", mock.Anything, mock.Anything)
+		chain.AssertExpectations(t)
+	})
+
+	t.Run("Generate Error Bad Request", func(t *testing.T) {
+		absensiRepository := new(absensiMocks.AbsensiRepository)
+		activityRepository := new(activityMocks.ActivityRepository)
+
+		timesheetUseCase := timesheet.NewTimesheetUseCase(absensiRepository, activityRepository, new(orgMocks.Chain))
+
+		var buf bytes.Buffer
+		resp := timesheetUseCase.Generate(context.TODO(), 1, users.RoleEmployee, timesheets.TimesheetReq{From: "2023-01-31", To: "2023-01-01"}, &buf)
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+	})
+}
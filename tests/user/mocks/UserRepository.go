@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -15,6 +16,20 @@ type UserRepository struct {
 	mock.Mock
 }
 
+// Activate provides a mock function with given fields: ctx, id, updateAt
+func (_m *UserRepository) Activate(ctx context.Context, id int64, updateAt time.Time) error {
+	ret := _m.Called(ctx, id, updateAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = rf(ctx, id, updateAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: ctx, params
 func (_m *UserRepository) Create(ctx context.Context, params users.Employee) (int64, error) {
 	ret := _m.Called(ctx, params)
@@ -6,9 +6,8 @@ import (
 	context "context"
 
 	response "github.com/Risuii/helpers/response"
-	mock "github.com/stretchr/testify/mock"
-
 	token "github.com/Risuii/models/token"
+	mock "github.com/stretchr/testify/mock"
 
 	users "github.com/Risuii/models/users"
 )
@@ -57,6 +56,38 @@ func (_m *UserUseCase) Register(ctx context.Context, params users.Employee) resp
 	return r0
 }
 
+// Resend provides a mock function with given fields: ctx, params
+func (_m *UserUseCase) Resend(ctx context.Context, params users.ResendReq) response.Response {
+	ret := _m.Called(ctx, params)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, users.ResendReq) response.Response); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
+// Verify provides a mock function with given fields: ctx, _a1
+func (_m *UserUseCase) Verify(ctx context.Context, _a1 string) response.Response {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 response.Response
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.Response); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(response.Response)
+		}
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewUserUseCase interface {
 	mock.TestingT
 	Cleanup(func())
@@ -21,6 +21,9 @@ var employeeStruct = users.Employee{
 	Name:      "test",
 	Password:  "test",
 	Email:     "test@test.com",
+	Role:      users.RoleEmployee,
+	Status:    users.StatusActive,
+	CompanyID: 2,
 	CreatedAt: currentTime,
 	UpdateAt:  currentTime,
 }
@@ -35,7 +38,7 @@ func TestCreate(t *testing.T) {
 		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableEmployee)
 		ctx := context.TODO()
 
-		mock.ExpectPrepare(query).ExpectExec().WithArgs(employeeStruct.Name, employeeStruct.Password, employeeStruct.Email, employeeStruct.CreatedAt).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(employeeStruct.Name, employeeStruct.Password, employeeStruct.Email, employeeStruct.Status, employeeStruct.CompanyID, employeeStruct.CreatedAt).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		ID, err := repo.Create(ctx, employeeStruct)
 
@@ -52,7 +55,7 @@ func TestCreate(t *testing.T) {
 		query := fmt.Sprintf(`INSERT INTO %s`, constant.TableEmployee)
 		ctx := context.TODO()
 
-		mock.ExpectPrepare(query).ExpectExec().WithArgs(employeeStruct.Name, employeeStruct.Password, employeeStruct.Email, employeeStruct.CreatedAt).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(employeeStruct.Name, employeeStruct.Password, employeeStruct.Email, employeeStruct.Status, employeeStruct.CompanyID, employeeStruct.CreatedAt).WillReturnResult(sqlmock.NewResult(0, 0))
 
 		ID, err := repo.Create(ctx, employeeStruct)
 
@@ -68,8 +71,8 @@ func TestFindByEmail(t *testing.T) {
 
 		defer db.Close()
 
-		query := fmt.Sprintf(`SELECT id, name, password, email, created_at, update_at FROM %s WHERE email = ?`, constant.TableEmployee)
-		rows := sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "update_at"}).AddRow(employeeStruct.ID, employeeStruct.Name, employeeStruct.Password, employeeStruct.Email, employeeStruct.CreatedAt, employeeStruct.UpdateAt)
+		query := fmt.Sprintf(`SELECT id, name, password, email, role, status, company_id, created_at, update_at FROM %s WHERE email = ?`, constant.TableEmployee)
+		rows := sqlmock.NewRows([]string{"id", "name", "password", "email", "role", "status", "company_id", "created_at", "update_at"}).AddRow(employeeStruct.ID, employeeStruct.Name, employeeStruct.Password, employeeStruct.Email, employeeStruct.Role, employeeStruct.Status, employeeStruct.CompanyID, employeeStruct.CreatedAt, employeeStruct.UpdateAt)
 
 		ctx := context.TODO()
 
@@ -87,8 +90,8 @@ func TestFindByEmail(t *testing.T) {
 
 		defer db.Close()
 
-		query := fmt.Sprintf(`SELECT id, name, password, email, created_at, update_at FROM %s WHERE email = ?`, constant.TableEmployee)
-		rows := sqlmock.NewRows([]string{"id", "name", "password", "email", "created_at", "update_at"})
+		query := fmt.Sprintf(`SELECT id, name, password, email, role, status, company_id, created_at, update_at FROM %s WHERE email = ?`, constant.TableEmployee)
+		rows := sqlmock.NewRows([]string{"id", "name", "password", "email", "role", "status", "company_id", "created_at", "update_at"})
 
 		ctx := context.TODO()
 
@@ -100,3 +103,36 @@ func TestFindByEmail(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestActivate(t *testing.T) {
+	t.Run("Activate Success", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := user.NewUserRepository(db, constant.TableEmployee)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`UPDATE %s SET status`, constant.TableEmployee)
+
+		mock.ExpectPrepare(query).ExpectExec().WithArgs(users.StatusActive, currentTime, int64(1), users.StatusPending).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Activate(context.TODO(), 1, currentTime)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Activate Error", func(t *testing.T) {
+		db, mock := mock.NewMock()
+		repo := user.NewUserRepository(db, constant.TableEmployee)
+
+		defer db.Close()
+
+		query := fmt.Sprintf(`UPDATE %s SET status`, constant.TableEmployee)
+
+		mock.ExpectPrepare(query).ExpectExec().WillReturnError(fmt.Errorf("connection reset"))
+
+		err := repo.Activate(context.TODO(), 1, currentTime)
+
+		assert.Error(t, err)
+	})
+}
@@ -2,16 +2,27 @@ package user_test
 
 import (
 	"context"
+	"errors"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	newJWT "github.com/dgrijalva/jwt-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	bcryptmocks "github.com/Risuii/config/bcrypt/mocks"
+	"github.com/Risuii/config/jwt"
+	mail "github.com/Risuii/config/mailer"
+	mailermocks "github.com/Risuii/config/mailer/mocks"
 	"github.com/Risuii/helpers/exception"
+	"github.com/Risuii/helpers/response"
 	"github.com/Risuii/internal/user"
+	"github.com/Risuii/models/companies"
 	"github.com/Risuii/models/token"
 	"github.com/Risuii/models/users"
+	companymocks "github.com/Risuii/tests/company/mocks"
 	"github.com/Risuii/tests/user/mocks"
 )
 
@@ -19,14 +30,21 @@ func TestRegister(t *testing.T) {
 	t.Run("Register Success", func(t *testing.T) {
 		employeeRepository := new(mocks.UserRepository)
 		bcrypt := new(bcryptmocks.Bcrypt)
+		mailer := new(mailermocks.Mailer)
 
 		employeeRepository.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(users.Employee{}, exception.ErrNotFound)
 		employeeRepository.On("Create", mock.Anything, mock.AnythingOfType("users.Employee")).Return(int64(1), nil)
 		bcrypt.On("HashPassword", mock.AnythingOfType("string")).Return("hashed password", nil)
+		mailer.On("Send", mock.Anything, mock.MatchedBy(func(m mail.Message) bool {
+			return m.To == "test@test.com" && strings.Contains(m.Body, "https://absensi.test/verify?token=")
+		})).Return(nil)
 
 		employeeUseCase := user.NewUserUseCase(
 			employeeRepository,
+			new(companymocks.CompanyRepository),
 			bcrypt,
+			mailer,
+			users.Registration{Enabled: true, VerifyURL: "https://absensi.test/verify", VerifyTTL: time.Hour},
 		)
 
 		ctx := context.TODO()
@@ -41,9 +59,11 @@ func TestRegister(t *testing.T) {
 		resp := employeeUseCase.Register(ctx, params)
 
 		assert.NoError(t, resp.Err())
+		assert.Equal(t, users.StatusPending, resp.(*response.ResponseImpl).Data.(users.Employee).Status)
 
 		employeeRepository.AssertExpectations(t)
 		bcrypt.AssertExpectations(t)
+		mailer.AssertExpectations(t)
 	})
 
 	t.Run("Register Error Conflict", func(t *testing.T) {
@@ -54,7 +74,10 @@ func TestRegister(t *testing.T) {
 
 		employeeUseCase := user.NewUserUseCase(
 			employeeRepository,
+			new(companymocks.CompanyRepository),
 			bcrypt,
+			new(mailermocks.Mailer),
+			users.Registration{Enabled: true},
 		)
 
 		ctx := context.TODO()
@@ -83,7 +106,10 @@ func TestRegister(t *testing.T) {
 
 		employeeUseCase := user.NewUserUseCase(
 			employeeRepository,
+			new(companymocks.CompanyRepository),
 			bcrypt,
+			new(mailermocks.Mailer),
+			users.Registration{Enabled: true},
 		)
 
 		ctx := context.TODO()
@@ -113,7 +139,10 @@ func TestRegister(t *testing.T) {
 
 		employeeUseCase := user.NewUserUseCase(
 			employeeRepository,
+			new(companymocks.CompanyRepository),
 			bcrypt,
+			new(mailermocks.Mailer),
+			users.Registration{Enabled: true},
 		)
 
 		ctx := context.TODO()
@@ -132,6 +161,83 @@ func TestRegister(t *testing.T) {
 		employeeRepository.AssertExpectations(t)
 		bcrypt.AssertExpectations(t)
 	})
+
+	t.Run("Register Success With Company Code", func(t *testing.T) {
+		employeeRepository := new(mocks.UserRepository)
+		companyRepository := new(companymocks.CompanyRepository)
+		bcrypt := new(bcryptmocks.Bcrypt)
+
+		employeeRepository.On("FindByEmail", mock.Anything, "test@acme.com").Return(users.Employee{}, exception.ErrNotFound)
+		companyRepository.On("FindByCode", mock.Anything, "acme").Return(companies.Company{ID: 2, Code: "acme"}, nil)
+		bcrypt.On("HashPassword", "test").Return("hashed password", nil)
+		employeeRepository.On("Create", mock.Anything, mock.MatchedBy(func(e users.Employee) bool {
+			return e.CompanyID == 2
+		})).Return(int64(1), nil)
+
+		mailer := new(mailermocks.Mailer)
+		mailer.On("Send", mock.Anything, mock.Anything).Return(nil)
+
+		employeeUseCase := user.NewUserUseCase(employeeRepository, companyRepository, bcrypt, mailer, users.Registration{Enabled: true})
+
+		params := users.Employee{
+			Name:     "test",
+			Password: "test",
+			Email:    "test@acme.com",
+			Company:  "acme",
+		}
+
+		resp := employeeUseCase.Register(context.TODO(), params)
+
+		assert.NoError(t, resp.Err())
+		assert.Equal(t, int64(2), resp.(*response.ResponseImpl).Data.(users.Employee).CompanyID)
+
+		employeeRepository.AssertExpectations(t)
+		companyRepository.AssertExpectations(t)
+	})
+
+	t.Run("Register Error Unknown Company", func(t *testing.T) {
+		employeeRepository := new(mocks.UserRepository)
+		companyRepository := new(companymocks.CompanyRepository)
+
+		employeeRepository.On("FindByEmail", mock.Anything, "test@acme.com").Return(users.Employee{}, exception.ErrNotFound)
+		companyRepository.On("FindByCode", mock.Anything, "nope").Return(companies.Company{}, exception.ErrNotFound)
+
+		employeeUseCase := user.NewUserUseCase(employeeRepository, companyRepository, new(bcryptmocks.Bcrypt), new(mailermocks.Mailer), users.Registration{Enabled: true})
+
+		params := users.Employee{
+			Name:     "test",
+			Password: "test",
+			Email:    "test@acme.com",
+			Company:  "nope",
+		}
+
+		resp := employeeUseCase.Register(context.TODO(), params)
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+
+		employeeRepository.AssertExpectations(t)
+		companyRepository.AssertExpectations(t)
+	})
+}
+
+func TestRegisterDisabled(t *testing.T) {
+	t.Run("Register Error Disabled", func(t *testing.T) {
+		employeeRepository := new(mocks.UserRepository)
+
+		employeeUseCase := user.NewUserUseCase(employeeRepository, new(companymocks.CompanyRepository), new(bcryptmocks.Bcrypt), new(mailermocks.Mailer), users.Registration{})
+
+		params := users.Employee{
+			Name:     "test",
+			Password: "test",
+			Email:    "test@test.com",
+		}
+
+		resp := employeeUseCase.Register(context.TODO(), params)
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+
+		employeeRepository.AssertExpectations(t)
+	})
 }
 
 func TestLogin(t *testing.T) {
@@ -142,15 +248,22 @@ func TestLogin(t *testing.T) {
 		password := "hashed"
 
 		mockAccount := users.Employee{
-			Password: password,
+			Password:  password,
+			Status:    users.StatusActive,
+			CompanyID: 2,
 		}
 
 		employeeRepository.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(mockAccount, nil)
 		bcrypt.On("ComparePasswordHash", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(true)
+		companyRepository := new(companymocks.CompanyRepository)
+		companyRepository.On("FindByID", mock.Anything, int64(2)).Return(companies.Company{ID: 2, Timezone: "Asia/Makassar"}, nil)
 
 		employeeUseCase := user.NewUserUseCase(
 			employeeRepository,
+			companyRepository,
 			bcrypt,
+			new(mailermocks.Mailer),
+			users.Registration{Enabled: true},
 		)
 
 		ctx := context.TODO()
@@ -159,6 +272,16 @@ func TestLogin(t *testing.T) {
 		}
 
 		resp, tokens := employeeUseCase.Login(ctx, params)
+
+		claims := &jwt.JWTclaim{}
+		_, err := newJWT.ParseWithClaims(tokens.Token, claims, func(t *newJWT.Token) (interface{}, error) {
+			return jwt.JWT_KEY, nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), claims.CompanyID)
+		assert.Equal(t, "Asia/Makassar", claims.Timezone)
+
 		tokens = token.Token{
 			Token: "jwt-token-test",
 		}
@@ -178,7 +301,10 @@ func TestLogin(t *testing.T) {
 
 		employeeUseCase := user.NewUserUseCase(
 			employeeRepository,
+			new(companymocks.CompanyRepository),
 			bcrypt,
+			new(mailermocks.Mailer),
+			users.Registration{Enabled: true},
 		)
 
 		ctx := context.TODO()
@@ -202,7 +328,10 @@ func TestLogin(t *testing.T) {
 
 		employeeUseCase := user.NewUserUseCase(
 			employeeRepository,
+			new(companymocks.CompanyRepository),
 			bcrypt,
+			new(mailermocks.Mailer),
+			users.Registration{Enabled: true},
 		)
 
 		ctx := context.TODO()
@@ -233,7 +362,10 @@ func TestLogin(t *testing.T) {
 
 		employeeUseCase := user.NewUserUseCase(
 			employeeRepository,
+			new(companymocks.CompanyRepository),
 			bcrypt,
+			new(mailermocks.Mailer),
+			users.Registration{Enabled: true},
 		)
 
 		ctx := context.TODO()
@@ -256,15 +388,22 @@ func TestLogin(t *testing.T) {
 		password := "hashed"
 
 		mockAccount := users.Employee{
-			Password: password,
+			Password:  password,
+			Status:    users.StatusActive,
+			CompanyID: 2,
 		}
 
 		employeeRepository.On("FindByEmail", mock.Anything, mock.AnythingOfType("string")).Return(mockAccount, nil)
 		bcrypt.On("ComparePasswordHash", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(true)
+		companyRepository := new(companymocks.CompanyRepository)
+		companyRepository.On("FindByID", mock.Anything, int64(2)).Return(companies.Company{ID: 2, Timezone: "Asia/Makassar"}, nil)
 
 		employeeUseCase := user.NewUserUseCase(
 			employeeRepository,
+			companyRepository,
 			bcrypt,
+			new(mailermocks.Mailer),
+			users.Registration{Enabled: true},
 		)
 
 		ctx := context.TODO()
@@ -283,4 +422,176 @@ func TestLogin(t *testing.T) {
 		employeeRepository.AssertExpectations(t)
 		bcrypt.AssertExpectations(t)
 	})
+
+	t.Run("Login Error Suspended", func(t *testing.T) {
+		bcrypt := new(bcryptmocks.Bcrypt)
+		employeeRepository := new(mocks.UserRepository)
+
+		mockAccount := users.Employee{
+			Password:  "hashed",
+			Status:    users.StatusSuspended,
+			CompanyID: 2,
+		}
+
+		employeeRepository.On("FindByEmail", mock.Anything, "test@test.com").Return(mockAccount, nil)
+		bcrypt.On("ComparePasswordHash", mock.AnythingOfType("string"), "hashed").Return(true)
+
+		employeeUseCase := user.NewUserUseCase(employeeRepository, new(companymocks.CompanyRepository), bcrypt, new(mailermocks.Mailer), users.Registration{Enabled: true})
+
+		resp, tokens := employeeUseCase.Login(context.TODO(), users.EmployeeLogin{Email: "test@test.com"})
+
+		assert.Equal(t, exception.ErrForbidden, resp.Err())
+		assert.Empty(t, tokens.Token)
+
+		employeeRepository.AssertExpectations(t)
+		bcrypt.AssertExpectations(t)
+	})
+}
+
+// verifyToken sends a verification mail for account through Resend and
+// returns the token from the link in its body.
+func verifyToken(t *testing.T, account users.Employee) string {
+	employeeRepository := new(mocks.UserRepository)
+	mailer := new(mailermocks.Mailer)
+
+	var sent mail.Message
+
+	employeeRepository.On("FindByEmail", mock.Anything, account.Email).Return(account, nil)
+	mailer.On("Send", mock.Anything, mock.AnythingOfType("mailer.Message")).Run(func(args mock.Arguments) {
+		sent = args.Get(1).(mail.Message)
+	}).Return(nil)
+
+	employeeUseCase := user.NewUserUseCase(employeeRepository, new(companymocks.CompanyRepository), new(bcryptmocks.Bcrypt), mailer, users.Registration{Enabled: true, VerifyURL: "https://absensi.test/verify", VerifyTTL: time.Hour})
+
+	resp := employeeUseCase.Resend(context.TODO(), users.ResendReq{Email: account.Email})
+	assert.NoError(t, resp.Err())
+
+	start := strings.Index(sent.Body, "?token=")
+	assert.NotEqual(t, -1, start)
+
+	raw := strings.Fields(sent.Body[start+len("?token="):])[0]
+	token, err := url.QueryUnescape(raw)
+	assert.NoError(t, err)
+
+	return token
+}
+
+func TestVerify(t *testing.T) {
+	pending := users.Employee{ID: 1, Name: "test", Email: "test@test.com", Status: users.StatusPending}
+
+	t.Run("Verify Success", func(t *testing.T) {
+		token := verifyToken(t, pending)
+
+		employeeRepository := new(mocks.UserRepository)
+		employeeRepository.On("FindByEmail", mock.Anything, "test@test.com").Return(pending, nil)
+		employeeRepository.On("Activate", mock.Anything, int64(1), mock.AnythingOfType("time.Time")).Return(nil)
+
+		employeeUseCase := user.NewUserUseCase(employeeRepository, new(companymocks.CompanyRepository), new(bcryptmocks.Bcrypt), new(mailermocks.Mailer), users.Registration{Enabled: true})
+
+		resp := employeeUseCase.Verify(context.TODO(), token)
+
+		assert.NoError(t, resp.Err())
+
+		employeeRepository.AssertExpectations(t)
+	})
+
+	t.Run("Verify Already Active", func(t *testing.T) {
+		token := verifyToken(t, pending)
+
+		active := pending
+		active.Status = users.StatusActive
+
+		employeeRepository := new(mocks.UserRepository)
+		employeeRepository.On("FindByEmail", mock.Anything, "test@test.com").Return(active, nil)
+
+		employeeUseCase := user.NewUserUseCase(employeeRepository, new(companymocks.CompanyRepository), new(bcryptmocks.Bcrypt), new(mailermocks.Mailer), users.Registration{Enabled: true})
+
+		resp := employeeUseCase.Verify(context.TODO(), token)
+
+		assert.NoError(t, resp.Err())
+
+		employeeRepository.AssertExpectations(t)
+		employeeRepository.AssertNotCalled(t, "Activate", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Verify Error Other Account", func(t *testing.T) {
+		token := verifyToken(t, pending)
+
+		other := pending
+		other.ID = 2
+
+		employeeRepository := new(mocks.UserRepository)
+		employeeRepository.On("FindByEmail", mock.Anything, "test@test.com").Return(other, nil)
+
+		employeeUseCase := user.NewUserUseCase(employeeRepository, new(companymocks.CompanyRepository), new(bcryptmocks.Bcrypt), new(mailermocks.Mailer), users.Registration{Enabled: true})
+
+		resp := employeeUseCase.Verify(context.TODO(), token)
+
+		assert.Equal(t, exception.ErrNotFound, resp.Err())
+	})
+
+	t.Run("Verify Error Session Token", func(t *testing.T) {
+		claims := &jwt.JWTclaim{
+			ID:    1,
+			Email: "test@test.com",
+		}
+		token, err := newJWT.NewWithClaims(newJWT.SigningMethodHS256, claims).SignedString(jwt.JWT_KEY)
+		assert.NoError(t, err)
+
+		employeeRepository := new(mocks.UserRepository)
+
+		employeeUseCase := user.NewUserUseCase(employeeRepository, new(companymocks.CompanyRepository), new(bcryptmocks.Bcrypt), new(mailermocks.Mailer), users.Registration{Enabled: true})
+
+		resp := employeeUseCase.Verify(context.TODO(), token)
+
+		assert.Equal(t, exception.ErrBadRequest, resp.Err())
+
+		employeeRepository.AssertNotCalled(t, "FindByEmail", mock.Anything, mock.Anything)
+	})
+}
+
+func TestResend(t *testing.T) {
+	t.Run("Resend Unknown Email", func(t *testing.T) {
+		employeeRepository := new(mocks.UserRepository)
+		mailer := new(mailermocks.Mailer)
+
+		employeeRepository.On("FindByEmail", mock.Anything, "nobody@test.com").Return(users.Employee{}, exception.ErrNotFound)
+
+		employeeUseCase := user.NewUserUseCase(employeeRepository, new(companymocks.CompanyRepository), new(bcryptmocks.Bcrypt), mailer, users.Registration{Enabled: true})
+
+		resp := employeeUseCase.Resend(context.TODO(), users.ResendReq{Email: "nobody@test.com"})
+
+		assert.NoError(t, resp.Err())
+
+		mailer.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Resend Active Account", func(t *testing.T) {
+		employeeRepository := new(mocks.UserRepository)
+		mailer := new(mailermocks.Mailer)
+
+		employeeRepository.On("FindByEmail", mock.Anything, "test@test.com").Return(users.Employee{ID: 1, Email: "test@test.com", Status: users.StatusActive}, nil)
+
+		employeeUseCase := user.NewUserUseCase(employeeRepository, new(companymocks.CompanyRepository), new(bcryptmocks.Bcrypt), mailer, users.Registration{Enabled: true})
+
+		resp := employeeUseCase.Resend(context.TODO(), users.ResendReq{Email: "test@test.com"})
+
+		assert.NoError(t, resp.Err())
+
+		mailer.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Resend Error Mailer", func(t *testing.T) {
+		employeeRepository := new(mocks.UserRepository)
+		mailer := new(mailermocks.Mailer)
+
+		employeeRepository.On("FindByEmail", mock.Anything, "test@test.com").Return(users.Employee{ID: 1, Email: "test@test.com", Status: users.StatusPending}, nil)
+		mailer.On("Send", mock.Anything, mock.Anything).Return(errors.New("smtp down"))
+
+		employeeUseCase := user.NewUserUseCase(employeeRepository, new(companymocks.CompanyRepository), new(bcryptmocks.Bcrypt), mailer, users.Registration{Enabled: true})
+
+		resp := employeeUseCase.Resend(context.TODO(), users.ResendReq{Email: "test@test.com"})
+
+		assert.Equal(t, exception.ErrInternalServer, resp.Err())
+	})
 }